@@ -38,6 +38,9 @@ import (
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	authzmod "github.com/cosmos/cosmos-sdk/x/authz/module"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -102,6 +105,8 @@ import (
 
 	gravityparams "github.com/althea-net/cosmos-gravity-bridge/module/app/params"
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity"
+	gravityante "github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/ante"
+	gravityclient "github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/client"
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/keeper"
 	gravitytypes "github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
 )
@@ -128,6 +133,11 @@ var (
 			distrclient.ProposalHandler,
 			upgradeclient.ProposalHandler,
 			upgradeclient.CancelProposalHandler,
+			gravityclient.ClearBridgeHijackProposalHandler,
+			gravityclient.ReleaseFailedDepositProposalHandler,
+			gravityclient.UpdateBlocklistProposalHandler,
+			gravityclient.ReleaseQuarantinedDepositProposalHandler,
+			gravityclient.SetERC20MetadataProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -141,6 +151,7 @@ var (
 		// bech32ibc.AppModuleBasic{},
 		// bech32ics20.AppModuleBasic{},
 		feegrantmod.AppModuleBasic{},
+		authzmod.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -211,6 +222,7 @@ type Gravity struct {
 	// bech32ICS20Keeper bech32ics20keeper.Keeper
 	gravityKeeper  keeper.Keeper
 	feegrantKeeper feegrantkeeper.Keeper
+	authzKeeper    authzkeeper.Keeper
 
 	// make scoped keepers public for test purposes
 	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper
@@ -219,6 +231,9 @@ type Gravity struct {
 	// Module Manager
 	mm *module.Manager
 
+	// configurator
+	configurator module.Configurator
+
 	// simulation manager
 	sm *module.SimulationManager
 }
@@ -254,6 +269,7 @@ func NewGravityApp(
 		gravitytypes.StoreKey,
 		// bech32ibctypes.StoreKey,
 		feegrant.StoreKey,
+		authzkeeper.StoreKey,
 	)
 	tKeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
@@ -351,6 +367,8 @@ func NewGravityApp(
 
 	app.feegrantKeeper = feegrantkeeper.NewKeeper(appCodec, keys[feegrant.StoreKey], app.accountKeeper)
 
+	app.authzKeeper = authzkeeper.NewKeeper(keys[authzkeeper.StoreKey], appCodec, app.BaseApp.MsgServiceRouter())
+
 	app.ibcKeeper = ibckeeper.NewKeeper(
 		appCodec,
 		keys[ibchost.StoreKey],
@@ -360,12 +378,32 @@ func NewGravityApp(
 		scopedIBCKeeper,
 	)
 
+	evidenceKeeper := evidencekeeper.NewKeeper(
+		appCodec,
+		keys[evidencetypes.StoreKey],
+		&stakingKeeper,
+		app.slashingKeeper,
+	)
+	app.evidenceKeeper = *evidenceKeeper
+
+	app.gravityKeeper = keeper.NewKeeper(
+		appCodec,
+		keys[gravitytypes.StoreKey],
+		app.GetSubspace(gravitytypes.ModuleName),
+		stakingKeeper,
+		app.bankKeeper,
+		app.slashingKeeper,
+		evidenceKeeper,
+		app.distrKeeper,
+	)
+
 	govRouter := govtypes.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
-		AddRoute(paramsproposal.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
+		AddRoute(paramsproposal.RouterKey, gravity.NewParamChangeProposalHandler(app.gravityKeeper, params.NewParamChangeProposalHandler(app.paramsKeeper))).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper)).
-		AddRoute(ibchost.RouterKey, ibcclient.NewClientProposalHandler(app.ibcKeeper.ClientKeeper))
+		AddRoute(ibchost.RouterKey, ibcclient.NewClientProposalHandler(app.ibcKeeper.ClientKeeper)).
+		AddRoute(gravitytypes.RouterKey, gravity.NewGravityProposalHandler(app.gravityKeeper))
 
 	app.govKeeper = govkeeper.NewKeeper(
 		appCodec,
@@ -398,28 +436,11 @@ func NewGravityApp(
 	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferModule)
 	app.ibcKeeper.SetRouter(ibcRouter)
 
-	evidenceKeeper := evidencekeeper.NewKeeper(
-		appCodec,
-		keys[evidencetypes.StoreKey],
-		&stakingKeeper,
-		app.slashingKeeper,
-	)
-	app.evidenceKeeper = *evidenceKeeper
-
 	// app.bech32IBCKeeper = *bech32ibckeeper.NewKeeper(
 	// 	app.ibcKeeper.ChannelKeeper, appCodec, keys[bech32ibctypes.StoreKey],
 	// 	app.transferKeeper,
 	// )
 
-	app.gravityKeeper = keeper.NewKeeper(
-		appCodec,
-		keys[gravitytypes.StoreKey],
-		app.GetSubspace(gravitytypes.ModuleName),
-		stakingKeeper,
-		app.bankKeeper,
-		app.slashingKeeper,
-	)
-
 	app.stakingKeeper = *stakingKeeper.SetHooks(
 		stakingtypes.NewMultiStakingHooks(
 			app.distrKeeper.Hooks(),
@@ -499,6 +520,7 @@ func NewGravityApp(
 			app.bankKeeper,
 		),
 		feegrantmod.NewAppModule(appCodec, app.accountKeeper, app.bankKeeper, app.feegrantKeeper, app.interfaceRegistry),
+		authzmod.NewAppModule(appCodec, app.authzKeeper, app.accountKeeper, app.bankKeeper, app.interfaceRegistry),
 		// bech32ibc.NewAppModule(appCodec, app.bech32IBCKeeper),
 		// bech32ics20.NewAppModule(appCodec, app.bech32ICS20Keeper),
 	)
@@ -533,6 +555,7 @@ func NewGravityApp(
 		evidencetypes.ModuleName,
 		ibctransfertypes.ModuleName,
 		gravitytypes.ModuleName,
+		authz.ModuleName,
 		// bech32ibctypes.ModuleName,
 		// bech32ics20types.ModuleName,
 	)
@@ -540,7 +563,8 @@ func NewGravityApp(
 	app.mm.RegisterInvariants(&app.crisisKeeper)
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter(), encodingConfig.Amino)
 	// app.mm.RegisterServices(module.NewConfigurator(app.MsgServiceRouter(), app.GRPCQueryRouter()))
-	app.mm.RegisterServices(module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter()))
+	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
+	app.mm.RegisterServices(app.configurator)
 
 	app.sm = module.NewSimulationManager(
 		auth.NewAppModule(appCodec, app.accountKeeper, authsims.RandomGenesisAccounts),
@@ -554,6 +578,7 @@ func NewGravityApp(
 		params.NewAppModule(app.paramsKeeper),
 		evidence.NewAppModule(app.evidenceKeeper),
 		feegrantmod.NewAppModule(appCodec, app.accountKeeper, app.bankKeeper, app.feegrantKeeper, app.interfaceRegistry),
+		authzmod.NewAppModule(appCodec, app.authzKeeper, app.accountKeeper, app.bankKeeper, app.interfaceRegistry),
 		ibc.NewAppModule(app.ibcKeeper),
 		transferModule,
 	)
@@ -566,13 +591,17 @@ func NewGravityApp(
 
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
-	anteHandler, err := ante.NewAnteHandler(
-		ante.HandlerOptions{
-			AccountKeeper:   app.accountKeeper,
-			BankKeeper:      app.bankKeeper,
-			FeegrantKeeper:  app.feegrantKeeper,
-			SignModeHandler: encodingConfig.TxConfig.SignModeHandler(),
-			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+	anteHandler, err := gravityante.NewAnteHandler(
+		gravityante.HandlerOptions{
+			HandlerOptions: ante.HandlerOptions{
+				AccountKeeper:   app.accountKeeper,
+				BankKeeper:      app.bankKeeper,
+				FeegrantKeeper:  app.feegrantKeeper,
+				SignModeHandler: encodingConfig.TxConfig.SignModeHandler(),
+				SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+			},
+			GravityKeeper: &app.gravityKeeper,
+			AuthzKeeper:   app.authzKeeper,
 		},
 	)
 	if err != nil {
@@ -582,6 +611,9 @@ func NewGravityApp(
 	app.SetAnteHandler(anteHandler)
 	app.SetEndBlocker(app.EndBlocker)
 
+	// the store loader has to be set before the latest version is loaded
+	app.setUpgradeHandlers()
+
 	if loadLatest {
 		if err := app.LoadLatestVersion(); err != nil {
 			tmos.Exit(err.Error())
@@ -656,6 +688,8 @@ func (app *Gravity) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci
 	if err := tmjson.Unmarshal(req.AppStateBytes, &genesisState); err != nil {
 		panic(err)
 	}
+	// record the module versions so future upgrades only run the migrations they need
+	app.upgradeKeeper.SetModuleVersionMap(ctx, app.mm.GetVersionMap())
 	return app.mm.InitGenesis(ctx, app.appCodec, genesisState)
 }
 
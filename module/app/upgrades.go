@@ -0,0 +1,48 @@
+package app
+
+import (
+	"fmt"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	gravitytypes "github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// UpgradeNameV2 is the name of the upgrade plan that migrates the gravity module to ConsensusVersion 2
+const UpgradeNameV2 = "gravity-v2"
+
+// setUpgradeHandlers registers the handlers for every software upgrade this chain knows about and,
+// if one of them is scheduled, the store loader that adds or removes its stores
+func (app *Gravity) setUpgradeHandlers() {
+	app.upgradeKeeper.SetUpgradeHandler(UpgradeNameV2, func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		// chains started before the module versions were recorded at genesis have an empty
+		// version map, every module other than gravity is already at its current version
+		if len(fromVM) == 0 {
+			fromVM = app.mm.GetVersionMap()
+			fromVM[gravitytypes.ModuleName] = 1
+		}
+		return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+	})
+
+	upgradeInfo, err := app.upgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		panic(fmt.Sprintf("failed to read upgrade info from disk: %s", err))
+	}
+	if app.upgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		return
+	}
+
+	var storeUpgrades *storetypes.StoreUpgrades
+	switch upgradeInfo.Name {
+	case UpgradeNameV2:
+		// v2 adds the authz store orchestrators grant their hot keys in
+		storeUpgrades = &storetypes.StoreUpgrades{Added: []string{authzkeeper.StoreKey}}
+	}
+	if storeUpgrades != nil {
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, storeUpgrades))
+	}
+}
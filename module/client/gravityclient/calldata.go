@@ -0,0 +1,117 @@
+package gravityclient
+
+import (
+	"math/big"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// LogicCallArgs is the LogicCallArgs struct of the Gravity contract describing a logic call
+type LogicCallArgs struct {
+	TransferAmounts        []*big.Int
+	TransferTokenContracts []gethcommon.Address
+	FeeAmounts             []*big.Int
+	FeeTokenContracts      []gethcommon.Address
+	LogicContractAddress   gethcommon.Address
+	Payload                []byte
+	TimeOut                *big.Int
+	InvalidationId         [32]byte
+	InvalidationNonce      *big.Int
+}
+
+// NewLogicCallArgs returns the contract arguments describing a logic call
+func NewLogicCallArgs(call types.OutgoingLogicCall) LogicCallArgs {
+	args := LogicCallArgs{
+		TransferAmounts:        make([]*big.Int, len(call.Transfers)),
+		TransferTokenContracts: make([]gethcommon.Address, len(call.Transfers)),
+		FeeAmounts:             make([]*big.Int, len(call.Fees)),
+		FeeTokenContracts:      make([]gethcommon.Address, len(call.Fees)),
+		LogicContractAddress:   gethcommon.HexToAddress(call.LogicContractAddress),
+		Payload:                call.Payload,
+		TimeOut:                new(big.Int).SetUint64(call.Timeout),
+		InvalidationNonce:      new(big.Int).SetUint64(call.InvalidationNonce),
+	}
+	for i, transfer := range call.Transfers {
+		args.TransferAmounts[i] = transfer.Amount.BigInt()
+		args.TransferTokenContracts[i] = gethcommon.HexToAddress(transfer.Contract)
+	}
+	for i, fee := range call.Fees {
+		args.FeeAmounts[i] = fee.Amount.BigInt()
+		args.FeeTokenContracts[i] = gethcommon.HexToAddress(fee.Contract)
+	}
+	copy(args.InvalidationId[:], call.InvalidationId)
+	return args
+}
+
+// GravityContractABI returns the ABI of the Gravity contract functions relayers call
+func GravityContractABI() (abi.ABI, error) {
+	return abi.JSON(strings.NewReader(types.GravityContractABIJSON))
+}
+
+// UpdateValsetCalldata returns the call of updateValset setting newValset, signed by the valset of sigs
+func UpdateValsetCalldata(newValset types.Valset, sigs *SignatureSet) ([]byte, error) {
+	contractAbi, err := GravityContractABI()
+	if err != nil {
+		return nil, err
+	}
+	calldata, err := contractAbi.Pack("updateValset", NewValsetArgs(newValset), sigs.Valset, sigs.V, sigs.R, sigs.S)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "pack updateValset: %s", err)
+	}
+	return calldata, nil
+}
+
+// SubmitBatchCalldata returns the call of submitBatch executing batch, signed by the valset of sigs
+func SubmitBatchCalldata(batch types.OutgoingTxBatch, sigs *SignatureSet) ([]byte, error) {
+	internal, err := batch.ToInternal()
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid batch")
+	}
+	amounts := make([]*big.Int, len(internal.Transactions))
+	destinations := make([]gethcommon.Address, len(internal.Transactions))
+	fees := make([]*big.Int, len(internal.Transactions))
+	for i, tx := range internal.Transactions {
+		amounts[i] = tx.Erc20Token.Amount.BigInt()
+		destinations[i] = gethcommon.HexToAddress(tx.DestAddress.GetAddress())
+		fees[i] = tx.Erc20Fee.Amount.BigInt()
+	}
+
+	contractAbi, err := GravityContractABI()
+	if err != nil {
+		return nil, err
+	}
+	calldata, err := contractAbi.Pack("submitBatch",
+		sigs.Valset,
+		sigs.V,
+		sigs.R,
+		sigs.S,
+		amounts,
+		destinations,
+		fees,
+		new(big.Int).SetUint64(internal.BatchNonce),
+		gethcommon.HexToAddress(internal.TokenContract.GetAddress()),
+		new(big.Int).SetUint64(internal.BatchTimeout),
+	)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "pack submitBatch: %s", err)
+	}
+	return calldata, nil
+}
+
+// SubmitLogicCallCalldata returns the call of submitLogicCall executing call, signed by the valset of sigs
+func SubmitLogicCallCalldata(call types.OutgoingLogicCall, sigs *SignatureSet) ([]byte, error) {
+	contractAbi, err := GravityContractABI()
+	if err != nil {
+		return nil, err
+	}
+	calldata, err := contractAbi.Pack("submitLogicCall", sigs.Valset, sigs.V, sigs.R, sigs.S, NewLogicCallArgs(call))
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "pack submitLogicCall: %s", err)
+	}
+	return calldata, nil
+}
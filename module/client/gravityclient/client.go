@@ -0,0 +1,304 @@
+// Package gravityclient is a Go client for the Gravity bridge. It wraps the gRPC queries of the module, builds and
+// signs the confirms orchestrators submit and assembles the calls relayers send to the Gravity contract on
+// Ethereum from the confirms of the validators.
+package gravityclient
+
+import (
+	"context"
+	"encoding/hex"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	grpc1 "github.com/gogo/protobuf/grpc"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// Client queries the Gravity module about one EVM chain, the zero chain id being the default chain
+type Client struct {
+	Query   types.QueryClient
+	ChainID uint64
+}
+
+// NewClient returns a client querying the module through conn about the EVM chain chainID
+func NewClient(conn grpc1.ClientConn, chainID uint64) *Client {
+	return &Client{Query: types.NewQueryClient(conn), ChainID: chainID}
+}
+
+// GravityID returns the gravity id checkpoints of the chain are salted with
+func (c *Client) GravityID(ctx context.Context) (string, error) {
+	res, err := c.Query.Params(ctx, &types.QueryParamsRequest{})
+	if err != nil {
+		return "", err
+	}
+	params := res.Params
+	if c.ChainID == 0 || c.ChainID == params.BridgeChainId {
+		return params.GravityId, nil
+	}
+	for _, chain := range params.EvmChains {
+		if chain.ChainId == c.ChainID {
+			return chain.GravityId, nil
+		}
+	}
+	return "", sdkerrors.Wrapf(types.ErrInvalid, "unknown evm chain %d", c.ChainID)
+}
+
+// CurrentValset returns the valset the bonded validators would form if a valset was requested now
+func (c *Client) CurrentValset(ctx context.Context) (*types.Valset, error) {
+	res, err := c.Query.CurrentValset(ctx, &types.QueryCurrentValsetRequest{ChainId: c.ChainID})
+	if err != nil {
+		return nil, err
+	}
+	return res.Valset, nil
+}
+
+// Valset returns the valset requested at a nonce, nil if there is none
+func (c *Client) Valset(ctx context.Context, nonce uint64) (*types.Valset, error) {
+	res, err := c.Query.ValsetRequest(ctx, &types.QueryValsetRequestRequest{Nonce: nonce, ChainId: c.ChainID})
+	if err != nil {
+		return nil, err
+	}
+	return res.Valset, nil
+}
+
+// Batch returns the batch of a token at a nonce
+func (c *Client) Batch(ctx context.Context, tokenContract string, nonce uint64) (*types.OutgoingTxBatch, error) {
+	res, err := c.Query.BatchRequestByNonce(ctx, &types.QueryBatchRequestByNonceRequest{
+		Nonce:           nonce,
+		ContractAddress: tokenContract,
+		ChainId:         c.ChainID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Batch, nil
+}
+
+// LogicCall returns the outgoing logic call of an invalidation id and nonce, nil if there is none
+func (c *Client) LogicCall(ctx context.Context, invalidationID []byte, invalidationNonce uint64) (*types.OutgoingLogicCall, error) {
+	res, err := c.Query.OutgoingLogicCall(ctx, &types.QueryOutgoingLogicCallRequest{
+		InvalidationId:    invalidationID,
+		InvalidationNonce: invalidationNonce,
+		ChainId:           c.ChainID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Call, nil
+}
+
+// PendingValsets returns the valsets the orchestrator has not signed yet
+func (c *Client) PendingValsets(ctx context.Context, orchestrator sdk.AccAddress) ([]*types.Valset, error) {
+	res, err := c.Query.LastPendingValsetRequestByAddr(ctx, &types.QueryLastPendingValsetRequestByAddrRequest{
+		Address: orchestrator.String(),
+		ChainId: c.ChainID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Valsets, nil
+}
+
+// PendingBatch returns the oldest batch the orchestrator has not signed yet, nil if there is none
+func (c *Client) PendingBatch(ctx context.Context, orchestrator sdk.AccAddress) (*types.OutgoingTxBatch, error) {
+	res, err := c.Query.LastPendingBatchRequestByAddr(ctx, &types.QueryLastPendingBatchRequestByAddrRequest{
+		Address: orchestrator.String(),
+		ChainId: c.ChainID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Batch, nil
+}
+
+// PendingLogicCall returns the oldest logic call the orchestrator has not signed yet, nil if there is none
+func (c *Client) PendingLogicCall(ctx context.Context, orchestrator sdk.AccAddress) (*types.OutgoingLogicCall, error) {
+	res, err := c.Query.LastPendingLogicCallByAddr(ctx, &types.QueryLastPendingLogicCallByAddrRequest{
+		Address: orchestrator.String(),
+		ChainId: c.ChainID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Call, nil
+}
+
+// ValsetConfirms returns the confirms of the valset at a nonce
+func (c *Client) ValsetConfirms(ctx context.Context, nonce uint64) ([]*types.MsgValsetConfirm, error) {
+	res, err := c.Query.ValsetConfirmsByNonce(ctx, &types.QueryValsetConfirmsByNonceRequest{Nonce: nonce, ChainId: c.ChainID})
+	if err != nil {
+		return nil, err
+	}
+	return res.Confirms, nil
+}
+
+// BatchConfirms returns the confirms of the batch of a token at a nonce
+func (c *Client) BatchConfirms(ctx context.Context, tokenContract string, nonce uint64) ([]*types.MsgConfirmBatch, error) {
+	res, err := c.Query.BatchConfirms(ctx, &types.QueryBatchConfirmsRequest{
+		Nonce:           nonce,
+		ContractAddress: tokenContract,
+		ChainId:         c.ChainID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Confirms, nil
+}
+
+// LogicCallConfirms returns the confirms of the logic call of an invalidation id and nonce
+func (c *Client) LogicCallConfirms(ctx context.Context, invalidationID []byte, invalidationNonce uint64) ([]*types.MsgConfirmLogicCall, error) {
+	res, err := c.Query.LogicConfirms(ctx, &types.QueryLogicConfirmsRequest{
+		InvalidationId:    invalidationID,
+		InvalidationNonce: invalidationNonce,
+		ChainId:           c.ChainID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Confirms, nil
+}
+
+// PendingConfirmations signs everything the orchestrator has yet to confirm: the pending valsets, as many as fit
+// in one message, the oldest pending batch and the oldest pending logic call. It returns nil when there is nothing
+// to sign.
+func (c *Client) PendingConfirmations(ctx context.Context, orchestrator sdk.AccAddress, signer EthSigner) (*types.MsgSubmitConfirmations, error) {
+	gravityID, err := c.GravityID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		valsetConfirms    []types.MsgValsetConfirm
+		batchConfirms     []types.MsgConfirmBatch
+		logicCallConfirms []types.MsgConfirmLogicCall
+	)
+	valsets, err := c.PendingValsets(ctx, orchestrator)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "pending valsets")
+	}
+	// leave room for the batch and logic call confirms, the valsets left out are signed in a later message
+	if max := types.MaxSubmissionEntries - 2; len(valsets) > max {
+		valsets = valsets[:max]
+	}
+	for _, valset := range valsets {
+		confirm, err := NewValsetConfirm(ctx, gravityID, *valset, orchestrator, signer, c.ChainID)
+		if err != nil {
+			return nil, err
+		}
+		valsetConfirms = append(valsetConfirms, *confirm)
+	}
+	batch, err := c.PendingBatch(ctx, orchestrator)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "pending batch")
+	}
+	if batch != nil {
+		confirm, err := NewBatchConfirm(ctx, gravityID, *batch, orchestrator, signer, c.ChainID)
+		if err != nil {
+			return nil, err
+		}
+		batchConfirms = append(batchConfirms, *confirm)
+	}
+	call, err := c.PendingLogicCall(ctx, orchestrator)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "pending logic call")
+	}
+	if call != nil {
+		confirm, err := NewLogicCallConfirm(ctx, gravityID, *call, orchestrator, signer, c.ChainID)
+		if err != nil {
+			return nil, err
+		}
+		logicCallConfirms = append(logicCallConfirms, *confirm)
+	}
+
+	if len(valsetConfirms)+len(batchConfirms)+len(logicCallConfirms) == 0 {
+		return nil, nil
+	}
+	return types.NewMsgSubmitConfirmations(orchestrator, valsetConfirms, batchConfirms, logicCallConfirms), nil
+}
+
+// UpdateValsetCalldata returns the call of updateValset moving the Gravity contract from the current valset, the
+// last one observed on Ethereum, to the valset at nonce with the confirms collected so far
+func (c *Client) UpdateValsetCalldata(ctx context.Context, current types.Valset, nonce uint64, powerThreshold uint64) ([]byte, error) {
+	gravityID, err := c.GravityID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	valset, err := c.Valset(ctx, nonce)
+	if err != nil {
+		return nil, err
+	}
+	if valset == nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "no valset at nonce %d", nonce)
+	}
+	confirms, err := c.ValsetConfirms(ctx, nonce)
+	if err != nil {
+		return nil, err
+	}
+	checkpoint, err := Checkpoint(valset, gravityID)
+	if err != nil {
+		return nil, err
+	}
+	sigs, err := NewSignatureSet(current, checkpoint, ValsetSignatures(confirms), powerThreshold)
+	if err != nil {
+		return nil, err
+	}
+	return UpdateValsetCalldata(*valset, sigs)
+}
+
+// SubmitBatchCalldata returns the call of submitBatch executing the batch of a token at nonce signed by the
+// current valset with the confirms collected so far
+func (c *Client) SubmitBatchCalldata(ctx context.Context, current types.Valset, tokenContract string, nonce uint64, powerThreshold uint64) ([]byte, error) {
+	gravityID, err := c.GravityID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	batch, err := c.Batch(ctx, tokenContract, nonce)
+	if err != nil {
+		return nil, err
+	}
+	if batch == nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "no batch of %s at nonce %d", tokenContract, nonce)
+	}
+	confirms, err := c.BatchConfirms(ctx, tokenContract, nonce)
+	if err != nil {
+		return nil, err
+	}
+	checkpoint, err := Checkpoint(batch, gravityID)
+	if err != nil {
+		return nil, err
+	}
+	sigs, err := NewSignatureSet(current, checkpoint, BatchSignatures(confirms), powerThreshold)
+	if err != nil {
+		return nil, err
+	}
+	return SubmitBatchCalldata(*batch, sigs)
+}
+
+// SubmitLogicCallCalldata returns the call of submitLogicCall executing the logic call of an invalidation id and
+// nonce signed by the current valset with the confirms collected so far
+func (c *Client) SubmitLogicCallCalldata(ctx context.Context, current types.Valset, invalidationID []byte, invalidationNonce uint64, powerThreshold uint64) ([]byte, error) {
+	gravityID, err := c.GravityID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	call, err := c.LogicCall(ctx, invalidationID, invalidationNonce)
+	if err != nil {
+		return nil, err
+	}
+	if call == nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "no logic call %s at nonce %d", hex.EncodeToString(invalidationID), invalidationNonce)
+	}
+	confirms, err := c.LogicCallConfirms(ctx, invalidationID, invalidationNonce)
+	if err != nil {
+		return nil, err
+	}
+	checkpoint, err := Checkpoint(call, gravityID)
+	if err != nil {
+		return nil, err
+	}
+	sigs, err := NewSignatureSet(current, checkpoint, LogicCallSignatures(confirms), powerThreshold)
+	if err != nil {
+		return nil, err
+	}
+	return SubmitLogicCallCalldata(*call, sigs)
+}
@@ -0,0 +1,82 @@
+package gravityclient
+
+import (
+	"context"
+	"encoding/hex"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// Checkpoint returns the checkpoint of a valset, batch or logic call validators sign, the GetCheckpoint methods
+// panic on values the chain would never produce which is turned into an error here
+func Checkpoint(signed types.EthereumSigned, gravityID string) (checkpoint []byte, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = sdkerrors.Wrapf(types.ErrInvalid, "checkpoint: %v", r)
+		}
+	}()
+	return signed.GetCheckpoint(gravityID), nil
+}
+
+// NewValsetConfirm signs a valset on behalf of orchestrator
+func NewValsetConfirm(ctx context.Context, gravityID string, valset types.Valset, orchestrator sdk.AccAddress, signer EthSigner, chainID uint64) (*types.MsgValsetConfirm, error) {
+	signature, err := sign(ctx, &valset, gravityID, signer, chainID)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "valset %d", valset.Nonce)
+	}
+	msg := types.NewMsgValsetConfirm(valset.Nonce, signer.Address(), orchestrator, signature)
+	msg.ChainId = chainID
+	return msg, nil
+}
+
+// NewBatchConfirm signs a batch on behalf of orchestrator
+func NewBatchConfirm(ctx context.Context, gravityID string, batch types.OutgoingTxBatch, orchestrator sdk.AccAddress, signer EthSigner, chainID uint64) (*types.MsgConfirmBatch, error) {
+	signature, err := sign(ctx, &batch, gravityID, signer, chainID)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "batch %d of %s", batch.BatchNonce, batch.TokenContract)
+	}
+	return &types.MsgConfirmBatch{
+		Nonce:         batch.BatchNonce,
+		TokenContract: batch.TokenContract,
+		EthSigner:     signer.Address().GetAddress(),
+		Orchestrator:  orchestrator.String(),
+		Signature:     signature,
+		ChainId:       chainID,
+	}, nil
+}
+
+// NewLogicCallConfirm signs a logic call on behalf of orchestrator
+func NewLogicCallConfirm(ctx context.Context, gravityID string, call types.OutgoingLogicCall, orchestrator sdk.AccAddress, signer EthSigner, chainID uint64) (*types.MsgConfirmLogicCall, error) {
+	signature, err := sign(ctx, &call, gravityID, signer, chainID)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "logic call %x nonce %d", call.InvalidationId, call.InvalidationNonce)
+	}
+	return &types.MsgConfirmLogicCall{
+		InvalidationId:    hex.EncodeToString(call.InvalidationId),
+		InvalidationNonce: call.InvalidationNonce,
+		EthSigner:         signer.Address().GetAddress(),
+		Orchestrator:      orchestrator.String(),
+		Signature:         signature,
+		ChainId:           chainID,
+	}, nil
+}
+
+// sign returns the hex encoded signature of the checkpoint of signed, checked to recover to the signer address
+func sign(ctx context.Context, signed types.EthereumSigned, gravityID string, signer EthSigner, chainID uint64) (string, error) {
+	checkpoint, err := Checkpoint(signed, gravityID)
+	if err != nil {
+		return "", err
+	}
+	signature, err := signer.Sign(ctx, signed, gravityID, chainID)
+	if err != nil {
+		return "", sdkerrors.Wrap(err, "sign checkpoint")
+	}
+	// the signature is verified on a copy since the recovery id may be normalized in place
+	if err := types.ValidateEthereumSignature(checkpoint, append([]byte{}, signature...), signer.Address()); err != nil {
+		return "", sdkerrors.Wrapf(err, "signature by %s", signer.Address().GetAddress())
+	}
+	return hex.EncodeToString(signature), nil
+}
@@ -0,0 +1,184 @@
+package gravityclient
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+const testGravityID = "defaultgravityid"
+
+var (
+	testOrchestrator = sdk.AccAddress([]byte("orchestrator________"))
+	testTokenAddress = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+)
+
+// fakeQueryClient answers the queries of a client from a batch waiting for confirms
+type fakeQueryClient struct {
+	types.QueryClient
+	batch    *types.OutgoingTxBatch
+	confirms []*types.MsgConfirmBatch
+}
+
+func (f *fakeQueryClient) Params(context.Context, *types.QueryParamsRequest, ...grpc.CallOption) (*types.QueryParamsResponse, error) {
+	params := types.DefaultParams()
+	params.GravityId = testGravityID
+	return &types.QueryParamsResponse{Params: *params}, nil
+}
+
+func (f *fakeQueryClient) LastPendingValsetRequestByAddr(context.Context, *types.QueryLastPendingValsetRequestByAddrRequest, ...grpc.CallOption) (*types.QueryLastPendingValsetRequestByAddrResponse, error) {
+	return &types.QueryLastPendingValsetRequestByAddrResponse{}, nil
+}
+
+func (f *fakeQueryClient) LastPendingBatchRequestByAddr(context.Context, *types.QueryLastPendingBatchRequestByAddrRequest, ...grpc.CallOption) (*types.QueryLastPendingBatchRequestByAddrResponse, error) {
+	return &types.QueryLastPendingBatchRequestByAddrResponse{Batch: f.batch}, nil
+}
+
+func (f *fakeQueryClient) LastPendingLogicCallByAddr(context.Context, *types.QueryLastPendingLogicCallByAddrRequest, ...grpc.CallOption) (*types.QueryLastPendingLogicCallByAddrResponse, error) {
+	return &types.QueryLastPendingLogicCallByAddrResponse{}, nil
+}
+
+func (f *fakeQueryClient) BatchRequestByNonce(context.Context, *types.QueryBatchRequestByNonceRequest, ...grpc.CallOption) (*types.QueryBatchRequestByNonceResponse, error) {
+	return &types.QueryBatchRequestByNonceResponse{Batch: f.batch}, nil
+}
+
+func (f *fakeQueryClient) BatchConfirms(context.Context, *types.QueryBatchConfirmsRequest, ...grpc.CallOption) (*types.QueryBatchConfirmsResponse, error) {
+	return &types.QueryBatchConfirmsResponse{Confirms: f.confirms}, nil
+}
+
+// testValset returns a valset of three members holding half, a quarter and a quarter of the power and their signers
+func testValset(t *testing.T, nonce uint64) (types.Valset, []EthSigner) {
+	powers := []uint64{2147483647, 1073741824, 1073741824}
+	valset := types.Valset{Nonce: nonce, RewardAmount: sdk.ZeroInt(), RewardToken: types.ZeroAddressString}
+	var signers []EthSigner
+	for _, power := range powers {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		signer, err := NewPrivateKeySigner(key)
+		require.NoError(t, err)
+		signers = append(signers, signer)
+		valset.Members = append(valset.Members, &types.BridgeValidator{Power: power, EthereumAddress: signer.Address().GetAddress()})
+	}
+	return valset, signers
+}
+
+func TestNewValsetConfirm(t *testing.T) {
+	_, err := NewPrivateKeySigner(nil)
+	require.ErrorIs(t, err, types.ErrEmpty)
+
+	valset, signers := testValset(t, 2)
+	confirm, err := NewValsetConfirm(context.Background(), testGravityID, valset, testOrchestrator, signers[0], 5)
+	require.NoError(t, err)
+	require.NoError(t, confirm.ValidateBasic())
+	require.Equal(t, uint64(5), confirm.ChainId)
+	require.Equal(t, signers[0].Address().GetAddress(), confirm.EthAddress)
+
+	signature, err := hex.DecodeString(confirm.Signature)
+	require.NoError(t, err)
+	require.NoError(t, types.ValidateEthereumSignature(valset.GetCheckpoint(testGravityID), signature, signers[0].Address()))
+
+	// a checkpoint that can not be computed is an error rather than a panic
+	valset.RewardToken = "invalid"
+	_, err = NewValsetConfirm(context.Background(), testGravityID, valset, testOrchestrator, signers[0], 5)
+	require.Error(t, err)
+}
+
+func TestNewSignatureSet(t *testing.T) {
+	current, signers := testValset(t, 1)
+	next, _ := testValset(t, 2)
+	checkpoint := next.GetCheckpoint(testGravityID)
+	confirm := func(signer EthSigner) *types.MsgValsetConfirm {
+		msg, err := NewValsetConfirm(context.Background(), testGravityID, next, testOrchestrator, signer, 0)
+		require.NoError(t, err)
+		return msg
+	}
+
+	// a quarter and a quarter is not enough
+	_, err := NewSignatureSet(current, checkpoint, ValsetSignatures([]*types.MsgValsetConfirm{confirm(signers[1]), confirm(signers[2])}), DefaultPowerThreshold)
+	require.ErrorIs(t, err, types.ErrInvalid)
+
+	// signatures of something else do not count
+	forged := confirm(signers[2])
+	forged.Signature = confirm(signers[1]).Signature
+	confirms := []*types.MsgValsetConfirm{forged, confirm(signers[0]), confirm(signers[1])}
+	sigs, err := NewSignatureSet(current, checkpoint, ValsetSignatures(confirms), DefaultPowerThreshold)
+	require.NoError(t, err)
+	require.Equal(t, current.Members[0].Power+current.Members[1].Power, sigs.Power)
+	require.Equal(t, uint8(0), sigs.V[2])
+	require.Equal(t, [32]byte{}, sigs.R[2])
+	for i := 0; i < 2; i++ {
+		require.Contains(t, []uint8{27, 28}, sigs.V[i])
+		signature := append(append(sigs.R[i][:], sigs.S[i][:]...), sigs.V[i])
+		require.NoError(t, types.ValidateEthereumSignature(checkpoint, signature, signers[i].Address()))
+	}
+
+	calldata, err := UpdateValsetCalldata(next, sigs)
+	require.NoError(t, err)
+	contractAbi, err := GravityContractABI()
+	require.NoError(t, err)
+	method := contractAbi.Methods["updateValset"]
+	require.Equal(t, method.ID, calldata[:4])
+	args, err := method.Inputs.Unpack(calldata[4:])
+	require.NoError(t, err)
+	require.Len(t, args, 5)
+	require.Equal(t, sigs.V, args[2])
+	require.Equal(t, sigs.R, args[3])
+	require.Equal(t, sigs.S, args[4])
+}
+
+//nolint: exhaustivestruct
+func TestSubmitBatchCalldata(t *testing.T) {
+	current, signers := testValset(t, 1)
+	destination := "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+	query := &fakeQueryClient{batch: &types.OutgoingTxBatch{
+		BatchNonce:   3,
+		BatchTimeout: 1000,
+		Transactions: []*types.OutgoingTransferTx{{
+			Id:          1,
+			Sender:      testOrchestrator.String(),
+			DestAddress: destination,
+			Erc20Token:  &types.ERC20Token{Contract: testTokenAddress, Amount: sdk.NewInt(100)},
+			Erc20Fee:    &types.ERC20Token{Contract: testTokenAddress, Amount: sdk.NewInt(2)},
+		}},
+		TokenContract: testTokenAddress,
+	}}
+	client := &Client{Query: query}
+	ctx := context.Background()
+
+	// orchestrators sign the batch waiting for them
+	for _, signer := range signers[:2] {
+		msg, err := client.PendingConfirmations(ctx, testOrchestrator, signer)
+		require.NoError(t, err)
+		require.Len(t, msg.BatchConfirms, 1)
+		require.Empty(t, msg.ValsetConfirms)
+		query.confirms = append(query.confirms, &msg.BatchConfirms[0])
+	}
+
+	calldata, err := client.SubmitBatchCalldata(ctx, current, testTokenAddress, 3, DefaultPowerThreshold)
+	require.NoError(t, err)
+	contractAbi, err := GravityContractABI()
+	require.NoError(t, err)
+	method := contractAbi.Methods["submitBatch"]
+	require.Equal(t, method.ID, calldata[:4])
+	args, err := method.Inputs.Unpack(calldata[4:])
+	require.NoError(t, err)
+	require.Equal(t, []*big.Int{big.NewInt(100)}, args[4])
+	require.Equal(t, []gethcommon.Address{gethcommon.HexToAddress(destination)}, args[5])
+	require.Equal(t, []*big.Int{big.NewInt(2)}, args[6])
+	require.Equal(t, big.NewInt(3), args[7])
+	require.Equal(t, gethcommon.HexToAddress(testTokenAddress), args[8])
+
+	// without enough confirms there is nothing to submit
+	query.confirms = query.confirms[1:]
+	_, err = client.SubmitBatchCalldata(ctx, current, testTokenAddress, 3, DefaultPowerThreshold)
+	require.Error(t, err)
+}
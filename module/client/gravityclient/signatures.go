@@ -0,0 +1,136 @@
+package gravityclient
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// DefaultPowerThreshold is the power the signers of a call must exceed for the Gravity contract to accept it
+// when deployed the usual way, 66% of the total valset power which the module normalizes to the max uint32
+const DefaultPowerThreshold uint64 = 2834678415
+
+// Signature is a hex encoded signature of a checkpoint by an Ethereum key
+type Signature struct {
+	EthAddress string
+	Signature  string
+}
+
+// ValsetSignatures returns the signatures of valset confirms
+func ValsetSignatures(confirms []*types.MsgValsetConfirm) []Signature {
+	out := make([]Signature, len(confirms))
+	for i, confirm := range confirms {
+		out[i] = Signature{EthAddress: confirm.EthAddress, Signature: confirm.Signature}
+	}
+	return out
+}
+
+// BatchSignatures returns the signatures of batch confirms
+func BatchSignatures(confirms []*types.MsgConfirmBatch) []Signature {
+	out := make([]Signature, len(confirms))
+	for i, confirm := range confirms {
+		out[i] = Signature{EthAddress: confirm.EthSigner, Signature: confirm.Signature}
+	}
+	return out
+}
+
+// LogicCallSignatures returns the signatures of logic call confirms
+func LogicCallSignatures(confirms []*types.MsgConfirmLogicCall) []Signature {
+	out := make([]Signature, len(confirms))
+	for i, confirm := range confirms {
+		out[i] = Signature{EthAddress: confirm.EthSigner, Signature: confirm.Signature}
+	}
+	return out
+}
+
+// ValsetArgs is the ValsetArgs struct of the Gravity contract, it describes the valset currently set on
+// Ethereum or the one it is updated to
+type ValsetArgs struct {
+	Validators   []gethcommon.Address
+	Powers       []*big.Int
+	ValsetNonce  *big.Int
+	RewardAmount *big.Int
+	RewardToken  gethcommon.Address
+}
+
+// NewValsetArgs returns the contract arguments describing a valset
+func NewValsetArgs(valset types.Valset) ValsetArgs {
+	args := ValsetArgs{
+		Validators:   make([]gethcommon.Address, len(valset.Members)),
+		Powers:       make([]*big.Int, len(valset.Members)),
+		ValsetNonce:  new(big.Int).SetUint64(valset.Nonce),
+		RewardAmount: big.NewInt(0),
+		RewardToken:  gethcommon.HexToAddress(valset.RewardToken),
+	}
+	if !valset.RewardAmount.IsNil() {
+		args.RewardAmount = valset.RewardAmount.BigInt()
+	}
+	for i, member := range valset.Members {
+		args.Validators[i] = gethcommon.HexToAddress(member.EthereumAddress)
+		args.Powers[i] = new(big.Int).SetUint64(member.Power)
+	}
+	return args
+}
+
+// SignatureSet is the signatures of a checkpoint by the valset set on Ethereum, in the form the Gravity contract
+// checks them. The signatures are in the order of the valset members, members who did not sign have a zero v.
+type SignatureSet struct {
+	Valset ValsetArgs
+	V      []uint8
+	R      [][32]byte
+	S      [][32]byte
+	// Power is the total power of the members who signed
+	Power uint64
+}
+
+// NewSignatureSet collects the signatures of a checkpoint by the members of the current valset. Signatures not
+// recovering to their Ethereum address or from addresses outside the valset are ignored. It returns an error
+// unless the power of the signers exceeds powerThreshold.
+func NewSignatureSet(current types.Valset, checkpoint []byte, signatures []Signature, powerThreshold uint64) (*SignatureSet, error) {
+	byAddress := make(map[string][]byte, len(signatures))
+	for _, sig := range signatures {
+		ethAddress, err := types.NewEthAddress(sig.EthAddress)
+		if err != nil {
+			continue
+		}
+		signature, err := hex.DecodeString(strings.TrimPrefix(sig.Signature, "0x"))
+		if err != nil || len(signature) != 65 {
+			continue
+		}
+		// the recovery id is normalized in place so a copy is checked
+		if err := types.ValidateEthereumSignature(checkpoint, append([]byte{}, signature...), *ethAddress); err != nil {
+			continue
+		}
+		byAddress[ethAddress.GetAddress()] = signature
+	}
+
+	set := &SignatureSet{
+		Valset: NewValsetArgs(current),
+		V:      make([]uint8, len(current.Members)),
+		R:      make([][32]byte, len(current.Members)),
+		S:      make([][32]byte, len(current.Members)),
+	}
+	for i, member := range current.Members {
+		signature, ok := byAddress[strings.ToLower(member.EthereumAddress)]
+		if !ok {
+			continue
+		}
+		copy(set.R[i][:], signature[:32])
+		copy(set.S[i][:], signature[32:64])
+		// the contract expects the recovery id in the 27 or 28 form
+		set.V[i] = signature[64]
+		if set.V[i] < 27 {
+			set.V[i] += 27
+		}
+		set.Power += member.Power
+	}
+	if set.Power <= powerThreshold {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "signers power %d does not exceed the threshold %d", set.Power, powerThreshold)
+	}
+	return set, nil
+}
@@ -0,0 +1,57 @@
+package gravityclient
+
+import (
+	"context"
+	"crypto/ecdsa"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// EthSigner signs valsets, batches and logic calls with the Ethereum key of an orchestrator. The key may be held
+// in memory, by a remote signer or by a hardware wallet. Signers are handed the object rather than its checkpoint
+// so that they can check what they sign.
+type EthSigner interface {
+	// Address returns the Ethereum address of the key
+	Address() types.EthAddress
+	// Sign returns the 65 bytes signature of the checkpoint of a *types.Valset, *types.OutgoingTxBatch or
+	// *types.OutgoingLogicCall of the EVM chain chainID salted with gravityID, in the format of
+	// types.NewEthereumSignature
+	Sign(ctx context.Context, signed types.EthereumSigned, gravityID string, chainID uint64) ([]byte, error)
+}
+
+// PrivateKeySigner signs checkpoints with a private key held in memory
+type PrivateKeySigner struct {
+	key     *ecdsa.PrivateKey
+	address types.EthAddress
+}
+
+var _ EthSigner = &PrivateKeySigner{}
+
+// NewPrivateKeySigner returns a signer of checkpoints with key
+func NewPrivateKeySigner(key *ecdsa.PrivateKey) (*PrivateKeySigner, error) {
+	if key == nil {
+		return nil, sdkerrors.Wrap(types.ErrEmpty, "private key")
+	}
+	address, err := types.NewEthAddress(crypto.PubkeyToAddress(key.PublicKey).Hex())
+	if err != nil {
+		return nil, err
+	}
+	return &PrivateKeySigner{key: key, address: *address}, nil
+}
+
+// Address implements EthSigner
+func (s *PrivateKeySigner) Address() types.EthAddress {
+	return s.address
+}
+
+// Sign implements EthSigner
+func (s *PrivateKeySigner) Sign(_ context.Context, signed types.EthereumSigned, gravityID string, _ uint64) ([]byte, error) {
+	checkpoint, err := Checkpoint(signed, gravityID)
+	if err != nil {
+		return nil, err
+	}
+	return types.NewEthereumSignature(checkpoint, s.key)
+}
@@ -0,0 +1,211 @@
+package remotesigner
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+	"google.golang.org/grpc"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/client/gravityclient"
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+const testGravityID = "defaultgravityid"
+
+var (
+	testOrchestrator = sdk.AccAddress([]byte("orchestrator________"))
+	testTokenAddress = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+)
+
+// fakeQueryClient answers the queries of the signer from the valsets and batches it holds
+type fakeQueryClient struct {
+	types.QueryClient
+	valsets map[uint64]*types.Valset
+	batches map[uint64]*types.OutgoingTxBatch
+	calls   map[string]*types.OutgoingLogicCall
+}
+
+func (f *fakeQueryClient) Params(context.Context, *types.QueryParamsRequest, ...grpc.CallOption) (*types.QueryParamsResponse, error) {
+	params := types.DefaultParams()
+	params.GravityId = testGravityID
+	return &types.QueryParamsResponse{Params: *params}, nil
+}
+
+func (f *fakeQueryClient) ValsetRequest(_ context.Context, req *types.QueryValsetRequestRequest, _ ...grpc.CallOption) (*types.QueryValsetRequestResponse, error) {
+	return &types.QueryValsetRequestResponse{Valset: f.valsets[req.Nonce]}, nil
+}
+
+func (f *fakeQueryClient) BatchRequestByNonce(_ context.Context, req *types.QueryBatchRequestByNonceRequest, _ ...grpc.CallOption) (*types.QueryBatchRequestByNonceResponse, error) {
+	return &types.QueryBatchRequestByNonceResponse{Batch: f.batches[req.Nonce]}, nil
+}
+
+func (f *fakeQueryClient) OutgoingLogicCall(_ context.Context, req *types.QueryOutgoingLogicCallRequest, _ ...grpc.CallOption) (*types.QueryOutgoingLogicCallResponse, error) {
+	return &types.QueryOutgoingLogicCallResponse{Call: f.calls[fmt.Sprintf("%x/%d", req.InvalidationId, req.InvalidationNonce)]}, nil
+}
+
+func testValset(nonce uint64, ethAddress string, power uint64) *types.Valset {
+	return &types.Valset{
+		Nonce:        nonce,
+		Members:      []*types.BridgeValidator{{Power: power, EthereumAddress: ethAddress}},
+		RewardAmount: sdk.ZeroInt(),
+		RewardToken:  types.ZeroAddressString,
+	}
+}
+
+func testServer(t *testing.T) (*Server, *fakeQueryClient, gravityclient.EthSigner) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer, err := gravityclient.NewPrivateKeySigner(key)
+	require.NoError(t, err)
+	query := &fakeQueryClient{
+		valsets: make(map[uint64]*types.Valset),
+		batches: make(map[uint64]*types.OutgoingTxBatch),
+		calls:   make(map[string]*types.OutgoingLogicCall),
+	}
+	return NewServer(signer, query, NewSignDB(dbm.NewMemDB())), query, signer
+}
+
+func TestSignValsetPolicy(t *testing.T) {
+	server, query, signer := testServer(t)
+	ctx := context.Background()
+	valset := testValset(1, signer.Address().GetAddress(), 100)
+	sign := func(valset *types.Valset, gravityID string) error {
+		res, err := server.SignValset(ctx, &types.RemoteSignValsetRequest{Valset: *valset, GravityId: gravityID})
+		if err == nil {
+			require.NoError(t, types.ValidateEthereumSignature(valset.GetCheckpoint(testGravityID), res.Signature, signer.Address()))
+		}
+		return err
+	}
+
+	// a valset the chain does not know of is not signed
+	require.ErrorIs(t, sign(valset, ""), types.ErrUnknown)
+
+	// neither is one differing from the chain or salted with another gravity id
+	query.valsets[1] = valset
+	require.ErrorIs(t, sign(testValset(1, signer.Address().GetAddress(), 200), ""), types.ErrMismatched)
+	require.ErrorIs(t, sign(valset, "othergravityid"), types.ErrMismatched)
+
+	// the valset on chain is signed, as many times as asked
+	require.NoError(t, sign(valset, testGravityID))
+	require.NoError(t, sign(valset, ""))
+
+	// but never another one with the same nonce, even if the chain is made to return it
+	query.valsets[1] = testValset(1, signer.Address().GetAddress(), 200)
+	require.ErrorIs(t, sign(query.valsets[1], ""), types.ErrDoubleSign)
+}
+
+//nolint: exhaustivestruct
+func TestSignBatchPolicy(t *testing.T) {
+	server, query, signer := testServer(t)
+	ctx := context.Background()
+	batch := func(amount int64) *types.OutgoingTxBatch {
+		return &types.OutgoingTxBatch{
+			BatchNonce:   3,
+			BatchTimeout: 1000,
+			Transactions: []*types.OutgoingTransferTx{{
+				Id:          1,
+				Sender:      testOrchestrator.String(),
+				DestAddress: "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7",
+				Erc20Token:  &types.ERC20Token{Contract: testTokenAddress, Amount: sdk.NewInt(amount)},
+				Erc20Fee:    &types.ERC20Token{Contract: testTokenAddress, Amount: sdk.NewInt(2)},
+			}},
+			TokenContract: testTokenAddress,
+		}
+	}
+
+	query.batches[3] = batch(100)
+	res, err := server.SignBatch(ctx, &types.RemoteSignBatchRequest{Batch: *batch(100)})
+	require.NoError(t, err)
+	require.NoError(t, types.ValidateEthereumSignature(batch(100).GetCheckpoint(testGravityID), res.Signature, signer.Address()))
+
+	query.batches[3] = batch(1000)
+	_, err = server.SignBatch(ctx, &types.RemoteSignBatchRequest{Batch: *batch(1000)})
+	require.ErrorIs(t, err, types.ErrDoubleSign)
+}
+
+//nolint: exhaustivestruct
+func TestSignLogicCallPolicy(t *testing.T) {
+	server, query, signer := testServer(t)
+	ctx := context.Background()
+	call := func(timeout uint64) *types.OutgoingLogicCall {
+		token := []*types.ERC20Token{{Contract: testTokenAddress, Amount: sdk.NewInt(100)}}
+		return &types.OutgoingLogicCall{
+			Transfers:            token,
+			Fees:                 token,
+			LogicContractAddress: "0x510ab76899430424d209a6c9a5b9951fb8a6f47d",
+			Payload:              []byte("payload"),
+			Timeout:              timeout,
+			InvalidationId:       []byte("call"),
+			InvalidationNonce:    1,
+		}
+	}
+
+	_, err := server.SignLogicCall(ctx, &types.RemoteSignLogicCallRequest{Call: *call(1000)})
+	require.ErrorIs(t, err, types.ErrUnknown)
+
+	query.calls[fmt.Sprintf("%x/%d", []byte("call"), 1)] = call(1000)
+	res, err := server.SignLogicCall(ctx, &types.RemoteSignLogicCallRequest{Call: *call(1000)})
+	require.NoError(t, err)
+	require.NoError(t, types.ValidateEthereumSignature(call(1000).GetCheckpoint(testGravityID), res.Signature, signer.Address()))
+	_, err = server.SignLogicCall(ctx, &types.RemoteSignLogicCallRequest{Call: *call(2000)})
+	require.ErrorIs(t, err, types.ErrMismatched)
+}
+
+func TestSignDBPersists(t *testing.T) {
+	db := dbm.NewMemDB()
+	tokenContract, err := types.NewEthAddress(testTokenAddress)
+	require.NoError(t, err)
+	require.NoError(t, NewSignDB(db).RecordValset(0, 1, []byte{1}))
+	require.NoError(t, NewSignDB(db).RecordBatch(0, *tokenContract, 1, []byte{2}))
+
+	// a database reopened over the same storage remembers what was signed
+	signDB := NewSignDB(db)
+	require.ErrorIs(t, signDB.RecordValset(0, 1, []byte{2}), types.ErrDoubleSign)
+	require.ErrorIs(t, signDB.RecordBatch(0, *tokenContract, 1, []byte{1}), types.ErrDoubleSign)
+	// nonces are scoped to their chain
+	require.NoError(t, signDB.RecordValset(5, 1, []byte{2}))
+	require.NoError(t, signDB.RecordLogicCall(0, []byte("call"), 1, []byte{3}))
+	require.ErrorIs(t, signDB.RecordLogicCall(0, []byte("call"), 1, []byte{4}), types.ErrDoubleSign)
+}
+
+func TestRemoteSignerOverUnixSocket(t *testing.T) {
+	server, query, signer := testServer(t)
+	valset := testValset(1, signer.Address().GetAddress(), 100)
+	query.valsets[1] = valset
+
+	address := "unix://" + filepath.Join(t.TempDir(), "signer.sock")
+	lis, err := Listen(address, nil)
+	require.NoError(t, err)
+	grpcServer := NewGRPCServer(server, nil)
+	go func() { _ = grpcServer.Serve(lis) }()
+	defer grpcServer.Stop()
+
+	ctx := context.Background()
+	conn, err := Dial(ctx, address, nil)
+	require.NoError(t, err)
+	defer conn.Close()
+	remote, err := NewRemoteSigner(ctx, conn)
+	require.NoError(t, err)
+	require.Equal(t, signer.Address(), remote.Address())
+
+	// confirms are built the same way whether the key is remote or not
+	confirm, err := gravityclient.NewValsetConfirm(ctx, testGravityID, *valset, testOrchestrator, remote, 0)
+	require.NoError(t, err)
+	signature, err := hex.DecodeString(confirm.Signature)
+	require.NoError(t, err)
+	require.NoError(t, types.ValidateEthereumSignature(valset.GetCheckpoint(testGravityID), signature, signer.Address()))
+
+	_, err = gravityclient.NewValsetConfirm(ctx, testGravityID, *testValset(1, signer.Address().GetAddress(), 200), testOrchestrator, remote, 0)
+	require.Error(t, err)
+
+	// TCP is only served over mutual TLS
+	_, err = Listen("tcp://127.0.0.1:0", nil)
+	require.ErrorIs(t, err, types.ErrInvalid)
+}
@@ -0,0 +1,148 @@
+// Package remotesigner keeps the Ethereum key of an orchestrator away from the orchestrator host, like tmkms does
+// for consensus keys. The signer serves the RemoteSigner gRPC service over a Unix socket or over TCP with mutual
+// TLS. It only signs the valsets, batches and logic calls stored on chain, computing their checkpoints itself, and
+// records every checkpoint it signs so that a compromised orchestrator can never get it to sign two different
+// objects with the same nonce.
+package remotesigner
+
+import (
+	"bytes"
+	"context"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/client/gravityclient"
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// Server signs with the key of signer the objects the chain queried through query asks validators to sign
+type Server struct {
+	signer gravityclient.EthSigner
+	query  types.QueryClient
+	db     *SignDB
+}
+
+var _ types.RemoteSignerServer = &Server{}
+
+// NewServer returns a remote signer signing with signer, checking requests against the chain queried through
+// query and recording signatures in db
+func NewServer(signer gravityclient.EthSigner, query types.QueryClient, db *SignDB) *Server {
+	return &Server{signer: signer, query: query, db: db}
+}
+
+// EthAddress implements types.RemoteSignerServer
+func (s *Server) EthAddress(context.Context, *types.RemoteSignerEthAddressRequest) (*types.RemoteSignerEthAddressResponse, error) {
+	return &types.RemoteSignerEthAddressResponse{EthAddress: s.signer.Address().GetAddress()}, nil
+}
+
+// SignValset implements types.RemoteSignerServer
+func (s *Server) SignValset(ctx context.Context, req *types.RemoteSignValsetRequest) (*types.RemoteSignResponse, error) {
+	client, gravityID, err := s.chain(ctx, req.ChainId, req.GravityId)
+	if err != nil {
+		return nil, err
+	}
+	valset, err := client.Valset(ctx, req.Valset.Nonce)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "query valset %d", req.Valset.Nonce)
+	}
+	if valset == nil {
+		return nil, sdkerrors.Wrapf(types.ErrUnknown, "valset %d is not on chain", req.Valset.Nonce)
+	}
+	checkpoint, err := matchCheckpoint(&req.Valset, valset, gravityID)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "valset %d", req.Valset.Nonce)
+	}
+	if err := s.db.RecordValset(req.ChainId, valset.Nonce, checkpoint); err != nil {
+		return nil, err
+	}
+	return s.sign(ctx, valset, gravityID, req.ChainId)
+}
+
+// SignBatch implements types.RemoteSignerServer
+func (s *Server) SignBatch(ctx context.Context, req *types.RemoteSignBatchRequest) (*types.RemoteSignResponse, error) {
+	tokenContract, err := types.NewEthAddress(req.Batch.TokenContract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid token contract")
+	}
+	client, gravityID, err := s.chain(ctx, req.ChainId, req.GravityId)
+	if err != nil {
+		return nil, err
+	}
+	batch, err := client.Batch(ctx, tokenContract.GetAddress(), req.Batch.BatchNonce)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "query batch %d", req.Batch.BatchNonce)
+	}
+	if batch == nil {
+		return nil, sdkerrors.Wrapf(types.ErrUnknown, "batch %d is not on chain", req.Batch.BatchNonce)
+	}
+	checkpoint, err := matchCheckpoint(&req.Batch, batch, gravityID)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "batch %d", req.Batch.BatchNonce)
+	}
+	if err := s.db.RecordBatch(req.ChainId, *tokenContract, batch.BatchNonce, checkpoint); err != nil {
+		return nil, err
+	}
+	return s.sign(ctx, batch, gravityID, req.ChainId)
+}
+
+// SignLogicCall implements types.RemoteSignerServer
+func (s *Server) SignLogicCall(ctx context.Context, req *types.RemoteSignLogicCallRequest) (*types.RemoteSignResponse, error) {
+	client, gravityID, err := s.chain(ctx, req.ChainId, req.GravityId)
+	if err != nil {
+		return nil, err
+	}
+	call, err := client.LogicCall(ctx, req.Call.InvalidationId, req.Call.InvalidationNonce)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "query logic call %x", req.Call.InvalidationId)
+	}
+	if call == nil {
+		return nil, sdkerrors.Wrapf(types.ErrUnknown, "logic call %x nonce %d is not on chain", req.Call.InvalidationId, req.Call.InvalidationNonce)
+	}
+	checkpoint, err := matchCheckpoint(&req.Call, call, gravityID)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "logic call %x", req.Call.InvalidationId)
+	}
+	if err := s.db.RecordLogicCall(req.ChainId, call.InvalidationId, call.InvalidationNonce, checkpoint); err != nil {
+		return nil, err
+	}
+	return s.sign(ctx, call, gravityID, req.ChainId)
+}
+
+// chain returns a client of the EVM chain chainID and the gravity id its checkpoints are salted with, which
+// must be the one the requester expects when it gives one
+func (s *Server) chain(ctx context.Context, chainID uint64, expectedGravityID string) (*gravityclient.Client, string, error) {
+	client := &gravityclient.Client{Query: s.query, ChainID: chainID}
+	gravityID, err := client.GravityID(ctx)
+	if err != nil {
+		return nil, "", sdkerrors.Wrap(err, "query gravity id")
+	}
+	if expectedGravityID != "" && expectedGravityID != gravityID {
+		return nil, "", sdkerrors.Wrapf(types.ErrMismatched, "gravity id %s of chain %d, requested %s", gravityID, chainID, expectedGravityID)
+	}
+	return client, gravityID, nil
+}
+
+// sign signs an object checked against the chain and recorded in the database
+func (s *Server) sign(ctx context.Context, signed types.EthereumSigned, gravityID string, chainID uint64) (*types.RemoteSignResponse, error) {
+	signature, err := s.signer.Sign(ctx, signed, gravityID, chainID)
+	if err != nil {
+		return nil, err
+	}
+	return &types.RemoteSignResponse{Signature: signature}, nil
+}
+
+// matchCheckpoint computes the checkpoint of the object found on chain and checks the requested one has the same
+func matchCheckpoint(requested, onChain types.EthereumSigned, gravityID string) ([]byte, error) {
+	checkpoint, err := gravityclient.Checkpoint(onChain, gravityID)
+	if err != nil {
+		return nil, err
+	}
+	requestedCheckpoint, err := gravityclient.Checkpoint(requested, gravityID)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(checkpoint, requestedCheckpoint) {
+		return nil, sdkerrors.Wrap(types.ErrMismatched, "requested object differs from the one on chain")
+	}
+	return checkpoint, nil
+}
@@ -0,0 +1,83 @@
+package remotesigner
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+var (
+	// signedValsetKey indexes the checkpoints of the signed valsets by chain id and nonce
+	signedValsetKey = []byte{0x1}
+	// signedBatchKey indexes the checkpoints of the signed batches by chain id, token contract and nonce
+	signedBatchKey = []byte{0x2}
+	// signedLogicCallKey indexes the checkpoints of the signed logic calls by chain id, invalidation nonce and id
+	signedLogicCallKey = []byte{0x3}
+)
+
+// SignDB records the checkpoint of every valset, batch and logic call the signer signed so that it never signs
+// two different ones with the same nonce, even across restarts
+type SignDB struct {
+	mtx sync.Mutex
+	db  dbm.DB
+}
+
+// NewSignDB returns a double sign protection database stored in db
+func NewSignDB(db dbm.DB) *SignDB {
+	return &SignDB{db: db}
+}
+
+// RecordValset records the signature of a valset checkpoint, it fails with ErrDoubleSign if another valset with
+// the same nonce was signed
+func (s *SignDB) RecordValset(chainID uint64, nonce uint64, checkpoint []byte) error {
+	key := concat(signedValsetKey, types.UInt64Bytes(chainID), types.UInt64Bytes(nonce))
+	return sdkerrors.Wrapf(s.record(key, checkpoint), "valset %d", nonce)
+}
+
+// RecordBatch records the signature of a batch checkpoint, it fails with ErrDoubleSign if another batch of the
+// token with the same nonce was signed
+func (s *SignDB) RecordBatch(chainID uint64, tokenContract types.EthAddress, nonce uint64, checkpoint []byte) error {
+	key := concat(signedBatchKey, types.UInt64Bytes(chainID), []byte(strings.ToLower(tokenContract.GetAddress())), types.UInt64Bytes(nonce))
+	return sdkerrors.Wrapf(s.record(key, checkpoint), "batch %d of %s", nonce, tokenContract.GetAddress())
+}
+
+// RecordLogicCall records the signature of a logic call checkpoint, it fails with ErrDoubleSign if another logic
+// call with the same invalidation id and nonce was signed
+func (s *SignDB) RecordLogicCall(chainID uint64, invalidationID []byte, invalidationNonce uint64, checkpoint []byte) error {
+	key := concat(signedLogicCallKey, types.UInt64Bytes(chainID), types.UInt64Bytes(invalidationNonce), invalidationID)
+	return sdkerrors.Wrapf(s.record(key, checkpoint), "logic call %x nonce %d", invalidationID, invalidationNonce)
+}
+
+// record stores checkpoint at key unless another checkpoint is stored there already, signing the same checkpoint
+// again is allowed. The write is synced before returning so that a signature is never handed out unrecorded.
+func (s *SignDB) record(key []byte, checkpoint []byte) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	signed, err := s.db.Get(key)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrInternal, err.Error())
+	}
+	if signed != nil {
+		if !bytes.Equal(signed, checkpoint) {
+			return sdkerrors.Wrapf(types.ErrDoubleSign, "already signed checkpoint %x", signed)
+		}
+		return nil
+	}
+	if err := s.db.SetSync(key, checkpoint); err != nil {
+		return sdkerrors.Wrap(types.ErrInternal, err.Error())
+	}
+	return nil
+}
+
+func concat(parts ...[]byte) []byte {
+	var out []byte
+	for _, part := range parts {
+		out = append(out, part...)
+	}
+	return out
+}
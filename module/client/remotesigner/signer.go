@@ -0,0 +1,60 @@
+package remotesigner
+
+import (
+	"context"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	grpc1 "github.com/gogo/protobuf/grpc"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/client/gravityclient"
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// RemoteSigner is the gravityclient.EthSigner of an orchestrator whose key is held by a remote signer
+type RemoteSigner struct {
+	client  types.RemoteSignerClient
+	address types.EthAddress
+}
+
+var _ gravityclient.EthSigner = &RemoteSigner{}
+
+// NewRemoteSigner returns a signer asking the remote signer connected through conn for signatures
+func NewRemoteSigner(ctx context.Context, conn grpc1.ClientConn) (*RemoteSigner, error) {
+	client := types.NewRemoteSignerClient(conn)
+	res, err := client.EthAddress(ctx, &types.RemoteSignerEthAddressRequest{})
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "query remote signer address")
+	}
+	address, err := types.NewEthAddress(res.EthAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "remote signer address")
+	}
+	return &RemoteSigner{client: client, address: *address}, nil
+}
+
+// Address implements gravityclient.EthSigner
+func (s *RemoteSigner) Address() types.EthAddress {
+	return s.address
+}
+
+// Sign implements gravityclient.EthSigner
+func (s *RemoteSigner) Sign(ctx context.Context, signed types.EthereumSigned, gravityID string, chainID uint64) ([]byte, error) {
+	var (
+		res *types.RemoteSignResponse
+		err error
+	)
+	switch signed := signed.(type) {
+	case *types.Valset:
+		res, err = s.client.SignValset(ctx, &types.RemoteSignValsetRequest{Valset: *signed, ChainId: chainID, GravityId: gravityID})
+	case *types.OutgoingTxBatch:
+		res, err = s.client.SignBatch(ctx, &types.RemoteSignBatchRequest{Batch: *signed, ChainId: chainID, GravityId: gravityID})
+	case *types.OutgoingLogicCall:
+		res, err = s.client.SignLogicCall(ctx, &types.RemoteSignLogicCallRequest{Call: *signed, ChainId: chainID, GravityId: gravityID})
+	default:
+		return nil, sdkerrors.Wrapf(types.ErrUnsupported, "remote signing of %T", signed)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return res.Signature, nil
+}
@@ -0,0 +1,140 @@
+package remotesigner
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"net"
+	"os"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+const (
+	unixScheme = "unix://"
+	tcpScheme  = "tcp://"
+)
+
+// parseAddress splits an address of the form unix:///path/to/socket or tcp://host:port into its network and
+// address, TCP addresses require a TLS config since anyone who can reach the port could otherwise get signatures
+func parseAddress(address string, tlsConfig *tls.Config) (network string, addr string, err error) {
+	switch {
+	case strings.HasPrefix(address, unixScheme):
+		return "unix", strings.TrimPrefix(address, unixScheme), nil
+	case strings.HasPrefix(address, tcpScheme):
+		if tlsConfig == nil {
+			return "", "", sdkerrors.Wrapf(types.ErrInvalid, "%s requires mutual TLS", address)
+		}
+		return "tcp", strings.TrimPrefix(address, tcpScheme), nil
+	default:
+		return "", "", sdkerrors.Wrapf(types.ErrInvalid, "address %s is neither %s nor %s", address, unixScheme, tcpScheme)
+	}
+}
+
+// Listen listens on address, a Unix socket only its owner can connect to or a TCP port
+func Listen(address string, tlsConfig *tls.Config) (net.Listener, error) {
+	network, addr, err := parseAddress(address, tlsConfig)
+	if err != nil {
+		return nil, err
+	}
+	if network == "unix" {
+		// a socket left behind by a previous run would make listening fail
+		if err := os.Remove(addr); err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+	}
+	lis, err := net.Listen(network, addr)
+	if err != nil {
+		return nil, err
+	}
+	if network == "unix" {
+		if err := os.Chmod(addr, 0600); err != nil {
+			_ = lis.Close()
+			return nil, err
+		}
+	}
+	return lis, nil
+}
+
+// NewGRPCServer returns a gRPC server serving server, over mutual TLS when tlsConfig is set
+func NewGRPCServer(server types.RemoteSignerServer, tlsConfig *tls.Config) *grpc.Server {
+	var opts []grpc.ServerOption
+	if tlsConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+	grpcServer := grpc.NewServer(opts...)
+	types.RegisterRemoteSignerServer(grpcServer, server)
+	return grpcServer
+}
+
+// Dial connects to the remote signer listening on address, over mutual TLS when tlsConfig is set
+func Dial(ctx context.Context, address string, tlsConfig *tls.Config) (*grpc.ClientConn, error) {
+	network, addr, err := parseAddress(address, tlsConfig)
+	if err != nil {
+		return nil, err
+	}
+	opts := []grpc.DialOption{
+		grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, network, addr)
+		}),
+	}
+	if tlsConfig != nil {
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+	}
+	return grpc.DialContext(ctx, addr, opts...)
+}
+
+// ServerTLSConfig returns the TLS config of a signer presenting the certificate in certFile and keyFile and only
+// accepting orchestrators presenting a certificate signed by the CA in caFile
+func ServerTLSConfig(certFile, keyFile, caFile string) (*tls.Config, error) {
+	cert, pool, err := loadTLSFiles(certFile, keyFile, caFile)
+	if err != nil {
+		return nil, err
+	}
+	//nolint: exhaustivestruct
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    pool,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// ClientTLSConfig returns the TLS config of an orchestrator presenting the certificate in certFile and keyFile
+// and only trusting a signer presenting a certificate signed by the CA in caFile
+func ClientTLSConfig(certFile, keyFile, caFile string) (*tls.Config, error) {
+	cert, pool, err := loadTLSFiles(certFile, keyFile, caFile)
+	if err != nil {
+		return nil, err
+	}
+	//nolint: exhaustivestruct
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      pool,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+func loadTLSFiles(certFile, keyFile, caFile string) (tls.Certificate, *x509.CertPool, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return tls.Certificate{}, nil, sdkerrors.Wrap(err, "load certificate")
+	}
+	ca, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		return tls.Certificate{}, nil, sdkerrors.Wrap(err, "read CA")
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return tls.Certificate{}, nil, sdkerrors.Wrapf(types.ErrInvalid, "no certificate in %s", caFile)
+	}
+	return cert, pool, nil
+}
@@ -0,0 +1,134 @@
+package cmd
+
+import (
+	"crypto/tls"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/spf13/cobra"
+	dbm "github.com/tendermint/tm-db"
+	"google.golang.org/grpc"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/client/gravityclient"
+	"github.com/althea-net/cosmos-gravity-bridge/module/client/remotesigner"
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+const (
+	flagListen    = "listen"
+	flagChainGRPC = "chain-grpc"
+	flagTLSCert   = "tls-cert"
+	flagTLSKey    = "tls-key"
+	flagTLSCA     = "tls-ca"
+)
+
+// RemoteSignerCommand returns the command serving an Ethereum key of the keystore written by eth_keys add to an
+// orchestrator as a remote signer
+func RemoteSignerCommand(defaultNodeHome string) *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "remote-signer [eth-address]",
+		Short: "Serve an orchestrator Ethereum key as a remote signer",
+		Long: `Serve the Ethereum key of an orchestrator to the orchestrator as a remote signer, so that the key does not
+need to be on the orchestrator host.
+
+The signer only signs valsets, batches and logic calls stored on the chain it queries through --chain-grpc and
+computes their checkpoints itself. Every signed checkpoint is recorded in a database in the home directory and
+the signer refuses to sign two different objects with the same nonce.
+
+The signer listens on a Unix socket, unix:///path/to/socket, or on a TCP port, tcp://host:port, which requires
+--tls-cert, --tls-key and --tls-ca so that only orchestrators with a certificate signed by the CA can connect.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: runRemoteSigner,
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flags.FlagKeyringDir, "", "The directory of the Ethereum keystore; if omitted, the default 'home' directory will be used")
+	cmd.Flags().String(flagPassphrase, "default", "Password the Ethereum key is encrypted with")
+	cmd.Flags().String(flagListen, "", "The address to listen on (default unix://<home>/remote_signer.sock)")
+	cmd.Flags().String(flagChainGRPC, "localhost:9090", "The gRPC address of a node of the chain")
+	cmd.Flags().String(flagTLSCert, "", "The certificate of the signer, enables mutual TLS")
+	cmd.Flags().String(flagTLSKey, "", "The private key of the certificate of the signer")
+	cmd.Flags().String(flagTLSCA, "", "The CA orchestrator certificates must be signed by")
+
+	return cmd
+}
+
+func runRemoteSigner(cmd *cobra.Command, args []string) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	ethAddress, err := types.NewEthAddress(args[0])
+	if err != nil {
+		return err
+	}
+	passphrase, _ := cmd.Flags().GetString(flagPassphrase)
+	signer, err := loadEthSigner(clientCtx.KeyringDir, *ethAddress, passphrase)
+	if err != nil {
+		return err
+	}
+
+	var tlsConfig *tls.Config
+	if certFile, _ := cmd.Flags().GetString(flagTLSCert); certFile != "" {
+		keyFile, _ := cmd.Flags().GetString(flagTLSKey)
+		caFile, _ := cmd.Flags().GetString(flagTLSCA)
+		if tlsConfig, err = remotesigner.ServerTLSConfig(certFile, keyFile, caFile); err != nil {
+			return err
+		}
+	}
+	listen, _ := cmd.Flags().GetString(flagListen)
+	if listen == "" {
+		listen = "unix://" + filepath.Join(clientCtx.HomeDir, "remote_signer.sock")
+	}
+	lis, err := remotesigner.Listen(listen, tlsConfig)
+	if err != nil {
+		return err
+	}
+
+	chainGRPC, _ := cmd.Flags().GetString(flagChainGRPC)
+	conn, err := grpc.Dial(chainGRPC, grpc.WithInsecure())
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	db, err := dbm.NewDB("remote_signer", dbm.GoLevelDBBackend, filepath.Join(clientCtx.HomeDir, "data"))
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	server := remotesigner.NewGRPCServer(remotesigner.NewServer(signer, types.NewQueryClient(conn), remotesigner.NewSignDB(db)), tlsConfig)
+	go func() {
+		<-cmd.Context().Done()
+		server.GracefulStop()
+	}()
+	cmd.PrintErrf("signing with %s on %s\n", ethAddress.GetAddress(), listen)
+	return server.Serve(lis)
+}
+
+// loadEthSigner decrypts the key of an Ethereum address from the keystore in keyringDir
+func loadEthSigner(keyringDir string, ethAddress types.EthAddress, passphrase string) (*gravityclient.PrivateKeySigner, error) {
+	ks := keystore.NewKeyStore(keyringDir, keystore.StandardScryptN, keystore.StandardScryptP)
+	for _, account := range ks.Accounts() {
+		if !strings.EqualFold(account.Address.Hex(), ethAddress.GetAddress()) {
+			continue
+		}
+		keyJSON, err := ioutil.ReadFile(account.URL.Path)
+		if err != nil {
+			return nil, err
+		}
+		key, err := keystore.DecryptKey(keyJSON, passphrase)
+		if err != nil {
+			return nil, err
+		}
+		return gravityclient.NewPrivateKeySigner(key.PrivateKey)
+	}
+	return nil, fmt.Errorf("no key of %s in %s", ethAddress.GetAddress(), keyringDir)
+}
@@ -111,6 +111,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 		txCommand(),
 		keys.Commands(app.DefaultNodeHome),
 		Commands(app.DefaultNodeHome),
+		RemoteSignerCommand(app.DefaultNodeHome),
 	)
 }
 
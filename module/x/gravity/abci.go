@@ -11,7 +11,9 @@ import (
 )
 
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
-	createBatch(ctx, k)
+	for _, k := range k.EvmChainKeepers(ctx) {
+		createBatch(ctx, k)
+	}
 }
 
 func createBatch(ctx sdk.Context, k keeper.Keeper) {
@@ -55,13 +57,28 @@ func createBatch(ctx sdk.Context, k keeper.Keeper) {
 // EndBlocker is called at the end of every block
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	params := k.GetParams(ctx)
+	for _, k := range k.EvmChainKeepers(ctx) {
+		evmChainEndBlocker(ctx, k, params)
+	}
+}
+
+// evmChainEndBlocker runs the end of block logic of the EVM chain the keeper is scoped to
+func evmChainEndBlocker(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 	// slashing(ctx, k)
 	attestationTally(ctx, k)
+	// claim slashing has to look at attestations before they are pruned
+	ClaimsSlashing(ctx, k, params)
+	k.TallyEthereumHeightVotes(ctx)
 	cleanupTimedOutBatches(ctx, k)
 	cleanupTimedOutLogicCalls(ctx, k)
 	createValsets(ctx, k)
 	pruneValsets(ctx, k, params)
 	pruneAttestations(ctx, k)
+	k.PruneTransferHistories(ctx)
+	k.PruneDepositReceipts(ctx)
+	if ctx.BlockHeight()%keeper.BridgeHealthMetricsInterval == 0 {
+		k.ReportBridgeHealthMetrics(ctx)
+	}
 }
 
 func createValsets(ctx sdk.Context, k keeper.Keeper) {
@@ -141,10 +158,12 @@ func attestationTally(ctx sdk.Context, k keeper.Keeper) {
 	}
 	// Then we sort it
 	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	lastConflictChecked := k.GetLastConflictCheckedNonce(ctx)
 
 	// This iterates over all keys (event nonces) in the attestation mapping. Each value contains
 	// a slice with one or more attestations at that event nonce. There can be multiple attestations
 	// at one event nonce when validators disagree about what event happened at that nonce.
+tally:
 	for _, nonce := range keys {
 		// This iterates over all attestations at a particular event nonce.
 		// They are ordered by when the first attestation at the event nonce was received.
@@ -167,10 +186,33 @@ func attestationTally(ctx sdk.Context, k keeper.Keeper) {
 			// If no attestation becomes observed, when we get to the next nonce, every attestation in
 			// it will be skipped. The same will happen for every nonce after that.
 			if nonce == uint64(k.GetLastObservedEventNonce(ctx))+1 {
+				// While the bridge is paused deposits are held back. Events have to be applied in
+				// order so this stops every later event as well, they will all be tallied once
+				// governance clears the hijack.
+				if k.IsBridgePaused(ctx) && isDepositAttestation(k, &att) {
+					break tally
+				}
 				k.TryAttestation(ctx, &att)
 			}
 		}
+		// Once an attestation at this nonce is observed every vote for another claim at the same
+		// nonce is a conflict. Each nonce is checked once here, votes cast after that are checked
+		// as they come in by Attest
+		if nonce > lastConflictChecked && nonce <= k.GetLastObservedEventNonce(ctx) {
+			k.FlagConflictingClaims(ctx, attmap[nonce])
+		}
 	}
+	k.SetLastConflictCheckedNonce(ctx, k.GetLastObservedEventNonce(ctx))
+}
+
+// isDepositAttestation returns true if the attestation is for a deposit into the bridge
+func isDepositAttestation(k keeper.Keeper, att *types.Attestation) bool {
+	claim, err := k.UnpackAttestationClaim(att)
+	if err != nil {
+		panic("could not cast to claim")
+	}
+	_, ok := claim.(*types.MsgSendToCosmosClaim)
+	return ok
 }
 
 // cleanupTimedOutBatches deletes batches that have passed their expiration on Ethereum
@@ -179,15 +221,18 @@ func attestationTally(ctx sdk.Context, k keeper.Keeper) {
 //    this means that we MUST only cleanup a single batch at a time
 // B) it is possible for ethereumHeight to be zero if no events have ever occurred, make sure your code accounts for this
 // C) When we compute the timeout we do our best to estimate the Ethereum block height at that very second. But what we work with
-//    here is the Ethereum block height at the time of the last Deposit or Withdraw to be observed. It's very important we do not
-//    project, if we do a slowdown on ethereum could cause a double spend. Instead timeouts will *only* occur after the timeout period
-//    AND any deposit or withdraw has occurred to update the Ethereum block height.
+//    here is the last observed Ethereum block height, advanced by observed events and by the median of the heights the
+//    orchestrators report through MsgEthereumHeightClaim. It's very important we do not project, if we do a slowdown on
+//    ethereum could cause a double spend. Instead timeouts will *only* occur after the timeout period AND more than half of
+//    the static set power has reported a later height, or an event after the timeout has been observed.
 func cleanupTimedOutBatches(ctx sdk.Context, k keeper.Keeper) {
 	ethereumHeight := k.GetLastObservedEthereumBlockHeight(ctx).EthereumBlockHeight
 	batches := k.GetOutgoingTxBatches(ctx)
 	for _, batch := range batches {
 		if batch.BatchTimeout < ethereumHeight {
-			k.CancelOutgoingTXBatch(ctx, batch.TokenContract, batch.BatchNonce)
+			if err := k.CancelOutgoingTXBatch(ctx, batch.TokenContract, batch.BatchNonce); err == nil {
+				k.RecordBatchTimedOut(ctx, *batch)
+			}
 		}
 	}
 }
@@ -198,9 +243,10 @@ func cleanupTimedOutBatches(ctx sdk.Context, k keeper.Keeper) {
 //    this means that we MUST only cleanup a single call at a time
 // B) it is possible for ethereumHeight to be zero if no events have ever occurred, make sure your code accounts for this
 // C) When we compute the timeout we do our best to estimate the Ethereum block height at that very second. But what we work with
-//    here is the Ethereum block height at the time of the last Deposit or Withdraw to be observed. It's very important we do not
-//    project, if we do a slowdown on ethereum could cause a double spend. Instead timeouts will *only* occur after the timeout period
-//    AND any deposit or withdraw has occurred to update the Ethereum block height.
+//    here is the last observed Ethereum block height, advanced by observed events and by the median of the heights the
+//    orchestrators report through MsgEthereumHeightClaim. It's very important we do not project, if we do a slowdown on
+//    ethereum could cause a double spend. Instead timeouts will *only* occur after the timeout period AND more than half of
+//    the static set power has reported a later height, or an event after the timeout has been observed.
 func cleanupTimedOutLogicCalls(ctx sdk.Context, k keeper.Keeper) {
 	ethereumHeight := k.GetLastObservedEthereumBlockHeight(ctx).EthereumBlockHeight
 	calls := k.GetOutgoingLogicCalls(ctx)
@@ -419,6 +465,123 @@ func LogicCallSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 	}
 }
 
+// ClaimsSlashing slashes bonded static validators that did not submit a claim for an observed
+// attestation once SignedClaimsWindow blocks have passed since the attestation was created. A validator
+// that missed several of the attestations leaving the window is slashed once, for the latest one. With
+// SlashFractionClaim zero and JailMissedClaims off, or on an EVM chain without claim_slashing, the window
+// still moves on, so that turning slashing on later does not reach back, but nobody is slashed: a zero
+// fraction slash still records a slash event.
+func ClaimsSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
+	// don't slash in the beginning before there aren't even SignedClaimsWindow blocks yet
+	if uint64(ctx.BlockHeight()) <= params.SignedClaimsWindow {
+		return
+	}
+	maxHeight := uint64(ctx.BlockHeight()) - params.SignedClaimsWindow
+
+	lastObservedNonce := k.GetLastObservedEventNonce(ctx)
+	lastSlashedNonce := k.GetLastSlashedClaimNonce(ctx)
+	if lastSlashedNonce >= lastObservedNonce {
+		return
+	}
+
+	slash := !params.SlashFractionClaim.IsNil() && params.SlashFractionClaim.IsPositive()
+	punish := (slash || params.JailMissedClaims) && k.ClaimSlashingEnabled()
+
+	attmap := k.GetAttestationMapping(ctx)
+	// the latest nonce each validator missed, by operator address
+	missed := make(map[string]uint64)
+	var pruned []uint64
+	for nonce := lastSlashedNonce + 1; nonce <= lastObservedNonce; nonce++ {
+		var observed *types.Attestation
+		for i := range attmap[nonce] {
+			if attmap[nonce][i].Observed {
+				observed = &attmap[nonce][i]
+				break
+			}
+		}
+		if observed == nil {
+			// the attestation was pruned or predates a genesis import, there is nothing to check
+			pruned = append(pruned, nonce)
+		} else {
+			// attestations are created in nonce order, once one is inside the window so are the rest
+			if observed.Height >= maxHeight {
+				break
+			}
+			if punish {
+				for _, val := range missedClaims(ctx, k, nonce, observed) {
+					missed[val] = nonce
+				}
+			}
+		}
+		k.SetLastSlashedClaimNonce(ctx, nonce)
+	}
+	if len(pruned) > 0 {
+		ctx.Logger().Info("Skipped claim slashing for attestations no longer in the store", "module", types.ModuleName,
+			"count", len(pruned), "first nonce", pruned[0], "last nonce", pruned[len(pruned)-1])
+	}
+
+	if !punish {
+		return
+	}
+
+	powerReduction := k.StakingKeeper.PowerReduction(ctx)
+	for _, val := range k.StakingKeeper.GetBondedValidatorsByPower(ctx) {
+		nonce, ok := missed[val.OperatorAddress]
+		if !ok {
+			continue
+		}
+		cons, err := val.GetConsAddr()
+		if err != nil {
+			ctx.Logger().Error("Cannot get consensus address", "module", types.ModuleName, "action", "claim slashing", "validator", val.OperatorAddress, "err", err)
+			continue
+		}
+		if slash {
+			ctx.Logger().Info("Slashing validator for a missed claim", "module", types.ModuleName, "validator", val.OperatorAddress, "nonce", nonce)
+			k.StakingKeeper.Slash(ctx, cons, ctx.BlockHeight(), val.ConsensusPower(powerReduction), params.SlashFractionClaim)
+		}
+		if params.JailMissedClaims && !val.IsJailed() {
+			k.StakingKeeper.Jail(ctx, cons)
+			k.SetLastUnBondingBlockHeight(ctx, uint64(ctx.BlockHeight()))
+		}
+	}
+}
+
+// missedClaims returns the operator addresses of the bonded static validators that did not submit a claim
+// for an observed attestation
+func missedClaims(ctx sdk.Context, k keeper.Keeper, nonce uint64, att *types.Attestation) (out []string) {
+	voted := make(map[string]bool, len(att.Votes))
+	for _, vote := range att.Votes {
+		voted[vote] = true
+	}
+
+	staticValOperAddrsMap := k.GetStaticValOperAddrsAsMap(ctx)
+	for _, val := range k.StakingKeeper.GetBondedValidatorsByPower(ctx) {
+		if !staticValOperAddrsMap[val.OperatorAddress] || voted[val.OperatorAddress] {
+			continue
+		}
+
+		cons, err := val.GetConsAddr()
+		if err != nil {
+			ctx.Logger().Error("Cannot get consensus address", "module", types.ModuleName, "action", "claim slashing", "validator", val.OperatorAddress, "err", err)
+			continue
+		}
+		// Don't slash validators who joined after the attestation was created
+		valSigningInfo, exist := k.SlashingKeeper.GetValidatorSigningInfo(ctx, cons)
+		if exist && valSigningInfo.StartHeight > int64(att.Height) {
+			continue
+		}
+		// Validators can only submit claims in nonce order, so a validator that has submitted past this
+		// nonce either voted for a conflicting claim, which is handled separately, or started submitting
+		// claims after this event which is how late joiners enter. One that never submitted any claim is
+		// the freeloader this is meant to catch.
+		if k.HasLastEventNonceByValidator(ctx, val.GetOperator()) && k.GetLastEventNonceByValidator(ctx, val.GetOperator()) >= nonce {
+			continue
+		}
+		out = append(out, val.OperatorAddress)
+	}
+	return out
+}
+
 // Iterate over all attestations currently being voted on in order of nonce
 // and prune those that are older than the current nonce and no longer have any
 // use. This could be combined with create attestation and save some computation
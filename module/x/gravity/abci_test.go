@@ -10,6 +10,7 @@ import (
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/keeper"
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 )
 
@@ -359,3 +360,203 @@ func TestBatchTimeout(t *testing.T) {
 	gotThirdBatch = input.GravityKeeper.GetOutgoingTXBatch(ctx, b3.TokenContract, b3.BatchNonce)
 	require.Nil(t, gotThirdBatch)
 }
+
+// claimsSlashingDeposit submits the same deposit claim at a nonce from each orchestrator and runs the EndBlocker
+func claimsSlashingDeposit(t *testing.T, ctx sdk.Context, pk keeper.Keeper, nonce uint64, orchestrators ...sdk.AccAddress) {
+	claimsSlashingEvmChainDeposit(t, ctx, pk, 0, nonce, orchestrators...)
+}
+
+// claimsSlashingEvmChainDeposit is claimsSlashingDeposit on the EVM chain with the given id
+func claimsSlashingEvmChainDeposit(t *testing.T, ctx sdk.Context, pk keeper.Keeper, chainID uint64, nonce uint64, orchestrators ...sdk.AccAddress) {
+	h := NewHandler(pk)
+	for _, orch := range orchestrators {
+		_, err := h(ctx, &types.MsgSendToCosmosClaim{
+			EventNonce:     nonce,
+			BlockHeight:    nonce,
+			TokenContract:  keeper.TokenContractAddrs[0],
+			Amount:         sdk.NewInt(100),
+			EthereumSender: keeper.EthAddrs[0].String(),
+			CosmosReceiver: keeper.AccAddrs[0].String(),
+			Orchestrator:   orch.String(),
+			ChainId:        chainID,
+		})
+		require.NoError(t, err)
+	}
+	EndBlocker(ctx, pk)
+}
+
+func TestClaimsSlashing(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	// without jailing the validator stays bonded, so slashing for more than one event would show
+	params := pk.GetParams(ctx)
+	params.JailMissedClaims = false
+	pk.SetParams(ctx, params)
+	for i := range keeper.ValAddrs {
+		pk.SetOrchestratorValidator(ctx, keeper.ValAddrs[i], keeper.AccAddrs[i])
+	}
+	deposit := func(ctx sdk.Context, nonce uint64, orchestrators ...sdk.AccAddress) {
+		claimsSlashingDeposit(t, ctx, pk, nonce, orchestrators...)
+	}
+
+	// the last validator does not take part in the first two events, then starts submitting claims at
+	// the last observed nonce like every new orchestrator does, then stops again
+	deposit(ctx, 1, keeper.AccAddrs[:4]...)
+	deposit(ctx, 2, keeper.AccAddrs[:4]...)
+	deposit(ctx, 2, keeper.AccAddrs[4])
+	deposit(ctx, 3, keeper.AccAddrs[:4]...)
+	require.Equal(t, uint64(3), pk.GetLastObservedEventNonce(ctx))
+
+	// nothing is slashed while the window is open
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.SignedClaimsWindow))
+	EndBlocker(ctx, pk)
+	require.Equal(t, uint64(0), pk.GetLastSlashedClaimNonce(ctx))
+	tokensBefore := input.StakingKeeper.Validator(ctx, keeper.ValAddrs[4]).GetTokens()
+
+	// once it closes the validator is slashed for the event it skipped after joining, not for the ones before
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	EndBlocker(ctx, pk)
+	require.Equal(t, uint64(3), pk.GetLastSlashedClaimNonce(ctx))
+	slashed := input.StakingKeeper.Validator(ctx, keeper.ValAddrs[4])
+	assert.False(t, slashed.IsJailed())
+	assert.Equal(t, tokensBefore.ToDec().Mul(sdk.OneDec().Sub(params.SlashFractionClaim)).TruncateInt(), slashed.GetTokens())
+	for _, val := range keeper.ValAddrs[:4] {
+		assert.False(t, input.StakingKeeper.Validator(ctx, val).IsJailed())
+	}
+}
+
+func TestClaimsSlashingNeverSubmitted(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	params := pk.GetParams(ctx)
+	params.JailMissedClaims = false
+	pk.SetParams(ctx, params)
+	for i := range keeper.ValAddrs {
+		pk.SetOrchestratorValidator(ctx, keeper.ValAddrs[i], keeper.AccAddrs[i])
+	}
+	tokensBefore := input.StakingKeeper.Validator(ctx, keeper.ValAddrs[4]).GetTokens()
+
+	// the last validator never submits a claim while the others keep the bridge running
+	claimsSlashingDeposit(t, ctx, pk, 1, keeper.AccAddrs[:4]...)
+	claimsSlashingDeposit(t, ctx, pk, 2, keeper.AccAddrs[:4]...)
+
+	// it is slashed for missing both events, but only once
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.SignedClaimsWindow) + 1)
+	EndBlocker(ctx, pk)
+	require.Equal(t, uint64(2), pk.GetLastSlashedClaimNonce(ctx))
+	slashedOnce := tokensBefore.ToDec().Mul(sdk.OneDec().Sub(params.SlashFractionClaim)).TruncateInt()
+	assert.Equal(t, slashedOnce, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[4]).GetTokens())
+	for _, val := range keeper.ValAddrs[:4] {
+		assert.Equal(t, tokensBefore, input.StakingKeeper.Validator(ctx, val).GetTokens())
+	}
+}
+
+func TestClaimsSlashingDisabled(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	// the staking keeper of the test keeper was copied before the distribution hooks were set
+	pk.StakingKeeper = input.StakingKeeper
+	params := pk.GetParams(ctx)
+	params.SlashFractionClaim = sdk.ZeroDec()
+	params.JailMissedClaims = false
+	pk.SetParams(ctx, params)
+	for i := range keeper.ValAddrs {
+		pk.SetOrchestratorValidator(ctx, keeper.ValAddrs[i], keeper.AccAddrs[i])
+	}
+	tokensBefore := input.StakingKeeper.Validator(ctx, keeper.ValAddrs[4]).GetTokens()
+	claimsSlashingDeposit(t, ctx, pk, 1, keeper.AccAddrs[:4]...)
+
+	// the window moves on but a zero fraction slash, which would still record a slash event, is not made
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.SignedClaimsWindow) + 1)
+	EndBlocker(ctx, pk)
+	require.Equal(t, uint64(1), pk.GetLastSlashedClaimNonce(ctx))
+	assert.Equal(t, tokensBefore, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[4]).GetTokens())
+	input.DistKeeper.IterateValidatorSlashEvents(ctx, func(val sdk.ValAddress, _ uint64, _ distrtypes.ValidatorSlashEvent) bool {
+		t.Errorf("slash event recorded for %s", val)
+		return false
+	})
+}
+
+func TestClaimsSlashingEvmChainOptIn(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	params := pk.GetParams(ctx)
+	params.JailMissedClaims = false
+	params.EvmChains = []types.EvmChain{{
+		ChainId:                  137,
+		BridgeEthereumAddress:    "0x8858eeb3dfffa017d4bce9801d340d36cf895ccf",
+		GravityId:                "polygon-gravity",
+		AverageEthereumBlockTime: 2000,
+		ClaimSlashing:            false,
+	}}
+	pk.SetParams(ctx, params)
+	for i := range keeper.ValAddrs {
+		pk.SetOrchestratorValidator(ctx, keeper.ValAddrs[i], keeper.AccAddrs[i])
+	}
+	evmChain, err := pk.EvmChainKeeper(ctx, 137)
+	require.NoError(t, err)
+	for i, val := range keeper.ValAddrs {
+		ethAddr, err := types.NewEthAddress(keeper.EthAddrs[i].String())
+		require.NoError(t, err)
+		evmChain.SetEthAddressForValidator(ctx, val, *ethAddr)
+	}
+	tokensBefore := input.StakingKeeper.Validator(ctx, keeper.ValAddrs[4]).GetTokens()
+
+	// the last validator does not watch the new chain yet, the window moves on without slashing it
+	claimsSlashingEvmChainDeposit(t, ctx, pk, 137, 1, keeper.AccAddrs[:4]...)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.SignedClaimsWindow) + 1)
+	EndBlocker(ctx, pk)
+	require.Equal(t, uint64(1), evmChain.GetLastSlashedClaimNonce(ctx))
+	assert.Equal(t, tokensBefore, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[4]).GetTokens())
+
+	// once the chain opts in, missed claims are slashed like on the default chain
+	params.EvmChains[0].ClaimSlashing = true
+	pk.SetParams(ctx, params)
+	claimsSlashingEvmChainDeposit(t, ctx, pk, 137, 2, keeper.AccAddrs[:4]...)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.SignedClaimsWindow) + 1)
+	EndBlocker(ctx, pk)
+	evmChain, err = pk.EvmChainKeeper(ctx, 137)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), evmChain.GetLastSlashedClaimNonce(ctx))
+	slashedOnce := tokensBefore.ToDec().Mul(sdk.OneDec().Sub(params.SlashFractionClaim)).TruncateInt()
+	assert.Equal(t, slashedOnce, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[4]).GetTokens())
+}
+
+// Batches time out on the heights reported by the orchestrators without any Ethereum event being observed
+func TestBatchTimeoutOnEthereumHeightVotes(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	for i := range keeper.ValAddrs {
+		pk.SetOrchestratorValidator(ctx, keeper.ValAddrs[i], keeper.AccAddrs[i])
+	}
+	msgServer := keeper.NewMsgServerImpl(pk)
+	token, err := types.NewInternalERC20Token(sdk.NewInt(99999), keeper.TokenContractAddrs[0])
+	require.NoError(t, err)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(token.GravityCoin())))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, keeper.AccAddrs[0], sdk.NewCoins(token.GravityCoin())))
+	receiver, err := types.NewEthAddress(keeper.EthAddrs[1].String())
+	require.NoError(t, err)
+	amount, err := types.NewInternalERC20Token(sdk.NewInt(100), keeper.TokenContractAddrs[0])
+	require.NoError(t, err)
+	_, err = pk.AddToOutgoingPool(ctx, keeper.AccAddrs[0], *receiver, amount.GravityCoin(), amount.GravityCoin())
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockTime(time.Now().UTC())
+	pk.SetLastObservedEthereumBlockHeight(ctx, 500)
+	batch, err := pk.BuildOutgoingTXBatch(ctx, token.Contract, 1)
+	require.NoError(t, err)
+
+	report := func(height uint64) {
+		for _, orch := range keeper.AccAddrs {
+			_, err := msgServer.EthereumHeightClaim(sdk.WrapSDKContext(ctx), types.NewMsgEthereumHeightClaim(orch, height))
+			require.NoError(t, err)
+		}
+	}
+	report(batch.BatchTimeout)
+	EndBlocker(ctx, pk)
+	require.NotNil(t, pk.GetOutgoingTXBatch(ctx, batch.TokenContract, batch.BatchNonce))
+
+	report(batch.BatchTimeout + 1)
+	EndBlocker(ctx, pk)
+	require.Nil(t, pk.GetOutgoingTXBatch(ctx, batch.TokenContract, batch.BatchNonce))
+}
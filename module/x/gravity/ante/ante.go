@@ -0,0 +1,63 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/keeper"
+)
+
+// HandlerOptions are the options of the SDK AnteHandler along with the gravity keeper the gravity decorators
+// check orchestrator messages against and the authz keeper holding the grants orchestrators made to hot keys
+type HandlerOptions struct {
+	ante.HandlerOptions
+	GravityKeeper *keeper.Keeper
+	AuthzKeeper   AuthzKeeper
+}
+
+// NewAnteHandler returns the SDK AnteHandler with the gravity decorators: orchestrator messages that can
+// only fail are turned away in CheckTx, and transactions made of nothing but valid orchestrator messages
+// from the static validator set are not held to the minimum gas prices
+func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
+	if options.AccountKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "account keeper is required for ante builder")
+	}
+	if options.BankKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "bank keeper is required for ante builder")
+	}
+	if options.SignModeHandler == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
+	}
+	if options.GravityKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "gravity keeper is required for ante builder")
+	}
+	if options.AuthzKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "authz keeper is required for ante builder")
+	}
+
+	var sigGasConsumer = options.SigGasConsumer
+	if sigGasConsumer == nil {
+		sigGasConsumer = ante.DefaultSigVerificationGasConsumer
+	}
+
+	anteDecorators := []sdk.AnteDecorator{
+		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
+		ante.NewRejectExtensionOptionsDecorator(),
+		ante.NewValidateBasicDecorator(),
+		ante.NewTxTimeoutHeightDecorator(),
+		ante.NewValidateMemoDecorator(options.AccountKeeper),
+		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
+		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper),
+		ante.NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
+		ante.NewValidateSigCountDecorator(options.AccountKeeper),
+		ante.NewSigGasConsumeDecorator(options.AccountKeeper, sigGasConsumer),
+		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
+		// the orchestrator checks recover signatures and read the store, they only run for signed transactions
+		NewOrchestratorMsgDecorator(*options.GravityKeeper),
+		NewMempoolFeeDecorator(*options.GravityKeeper, options.AuthzKeeper),
+		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
+	}
+
+	return sdk.ChainAnteDecorators(anteDecorators...), nil
+}
@@ -0,0 +1,134 @@
+package ante
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/keeper"
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// OrchestratorMsgDecorator rejects, in CheckTx, transactions holding a confirm or claim that would fail when
+// delivered: one from an account that is not a registered orchestrator, a confirm of an unknown checkpoint or
+// with a bad signature, or a duplicate or stale confirm or claim. Confirms and claims a grantee submits for an
+// orchestrator in an authz MsgExec are checked the same way. Delivered transactions are not checked, the msg
+// server makes the same checks. It must run after the SigVerificationDecorator so that the checks are not made
+// for transactions nobody signed.
+type OrchestratorMsgDecorator struct {
+	k keeper.Keeper
+}
+
+func NewOrchestratorMsgDecorator(k keeper.Keeper) OrchestratorMsgDecorator {
+	return OrchestratorMsgDecorator{k: k}
+}
+
+func (d OrchestratorMsgDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if ctx.IsCheckTx() {
+		for _, msg := range tx.GetMsgs() {
+			_, executed, err := unwrapMsgExec(msg)
+			if err != nil {
+				return ctx, err
+			}
+			for _, msg := range executed {
+				if !isOrchestratorMsg(msg) {
+					continue
+				}
+				if err := d.k.ValidateOrchestratorMsg(ctx, msg); err != nil {
+					return ctx, sdkerrors.Wrap(err, sdk.MsgTypeURL(msg))
+				}
+			}
+		}
+	}
+	return next(ctx, tx, simulate)
+}
+
+// MempoolFeeDecorator is the SDK MempoolFeeDecorator, except that transactions made of nothing but orchestrator
+// messages signed by orchestrators of bonded static validators, or executed for them by a grantee they authorized,
+// are not held to the minimum gas prices. It must run after the SigVerificationDecorator, which makes sure the
+// messages were signed by their signers, and after the OrchestratorMsgDecorator, which makes sure they are valid.
+type MempoolFeeDecorator struct {
+	k            keeper.Keeper
+	authzKeeper  AuthzKeeper
+	feeDecorator ante.MempoolFeeDecorator
+}
+
+func NewMempoolFeeDecorator(k keeper.Keeper, authzKeeper AuthzKeeper) MempoolFeeDecorator {
+	return MempoolFeeDecorator{k: k, authzKeeper: authzKeeper, feeDecorator: ante.NewMempoolFeeDecorator()}
+}
+
+func (d MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if ctx.IsCheckTx() && d.isFeeExempt(ctx, tx) {
+		return next(ctx, tx, simulate)
+	}
+	return d.feeDecorator.AnteHandle(ctx, tx, simulate, next)
+}
+
+func (d MempoolFeeDecorator) isFeeExempt(ctx sdk.Context, tx sdk.Tx) bool {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return false
+	}
+	for _, msg := range msgs {
+		grantee, executed, err := unwrapMsgExec(msg)
+		if err != nil || len(executed) == 0 {
+			return false
+		}
+		for _, msg := range executed {
+			if !isOrchestratorMsg(msg) {
+				return false
+			}
+			for _, signer := range msg.GetSigners() {
+				if !d.k.IsBondedStaticOrchestrator(ctx, signer) {
+					return false
+				}
+				// the grantee must hold a grant, as it will need once the message is delivered
+				if grantee != nil && !grantee.Equals(signer) {
+					if authorization, _ := d.authzKeeper.GetCleanAuthorization(ctx, grantee, signer, sdk.MsgTypeURL(msg)); authorization == nil {
+						return false
+					}
+				}
+			}
+		}
+	}
+	return true
+}
+
+// unwrapMsgExec returns the grantee and the messages of an authz MsgExec, any other message is returned on its
+// own with no grantee
+func unwrapMsgExec(msg sdk.Msg) (grantee sdk.AccAddress, msgs []sdk.Msg, err error) {
+	exec, ok := msg.(*authz.MsgExec)
+	if !ok {
+		return nil, []sdk.Msg{msg}, nil
+	}
+	grantee, err = sdk.AccAddressFromBech32(exec.Grantee)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, exec.Grantee)
+	}
+	msgs, err = exec.GetMessages()
+	if err != nil {
+		return nil, nil, err
+	}
+	return grantee, msgs, nil
+}
+
+// AuthzKeeper is the part of the authz keeper the MempoolFeeDecorator needs to check grants
+type AuthzKeeper interface {
+	GetCleanAuthorization(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccAddress, msgType string) (authz.Authorization, time.Time)
+}
+
+// isOrchestratorMsg returns true for the confirms and claims orchestrators submit as part of running the bridge
+func isOrchestratorMsg(msg sdk.Msg) bool {
+	switch msg.(type) {
+	case *types.MsgValsetConfirm, *types.MsgConfirmBatch, *types.MsgConfirmLogicCall, *types.MsgEthereumHeightClaim,
+		*types.MsgSubmitConfirmations, *types.MsgSubmitClaims:
+		return true
+	case types.EthereumClaim:
+		return true
+	default:
+		return false
+	}
+}
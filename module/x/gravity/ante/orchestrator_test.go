@@ -0,0 +1,142 @@
+package ante
+
+import (
+	"encoding/hex"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/keeper"
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// testTx is the least of a sdk.FeeTx the decorators need
+type testTx struct {
+	msgs []sdk.Msg
+	fee  sdk.Coins
+}
+
+func (tx testTx) GetMsgs() []sdk.Msg         { return tx.msgs }
+func (tx testTx) ValidateBasic() error       { return nil }
+func (tx testTx) GetGas() uint64             { return 100000 }
+func (tx testTx) GetFee() sdk.Coins          { return tx.fee }
+func (tx testTx) FeePayer() sdk.AccAddress   { return tx.msgs[0].GetSigners()[0] }
+func (tx testTx) FeeGranter() sdk.AccAddress { return nil }
+
+//nolint: exhaustivestruct
+func TestOrchestratorMsgDecorators(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	k := input.GravityKeeper
+	for i := range keeper.ValAddrs {
+		k.SetOrchestratorValidator(ctx, keeper.ValAddrs[i], keeper.AccAddrs[i])
+	}
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	ethAddress, err := types.NewEthAddress(crypto.PubkeyToAddress(privKey.PublicKey).String())
+	require.NoError(t, err)
+	k.SetEthAddressForValidator(ctx, keeper.ValAddrs[0], *ethAddress)
+
+	valset := k.SetValsetRequest(ctx)
+	signature, err := types.NewEthereumSignature(valset.GetCheckpoint(k.GetGravityID(ctx)), privKey)
+	require.NoError(t, err)
+	confirm := types.NewMsgValsetConfirm(valset.Nonce, *ethAddress, keeper.AccAddrs[0], hex.EncodeToString(signature))
+	badConfirm := types.NewMsgValsetConfirm(valset.Nonce, *ethAddress, keeper.AccAddrs[0], hex.EncodeToString(make([]byte, 65)))
+
+	anteHandler := sdk.ChainAnteDecorators(NewOrchestratorMsgDecorator(k), NewMempoolFeeDecorator(k, input.AuthzKeeper))
+	checkCtx := ctx.WithIsCheckTx(true).WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoin("stake", sdk.NewInt(1))))
+	check := func(msgs ...sdk.Msg) error {
+		_, err := anteHandler(checkCtx, testTx{msgs: msgs}, false)
+		return err
+	}
+
+	// valid orchestrator messages pay no fee
+	require.NoError(t, check(confirm))
+	require.NoError(t, check(types.NewMsgEthereumHeightClaim(keeper.AccAddrs[1], 100)))
+
+	// a height report that does not move the validator's vote forward is turned away
+	_, err = keeper.NewMsgServerImpl(k).EthereumHeightClaim(sdk.WrapSDKContext(ctx), types.NewMsgEthereumHeightClaim(keeper.AccAddrs[1], 100))
+	require.NoError(t, err)
+	require.ErrorIs(t, check(types.NewMsgEthereumHeightClaim(keeper.AccAddrs[1], 100)), types.ErrInvalid)
+	require.NoError(t, check(types.NewMsgEthereumHeightClaim(keeper.AccAddrs[1], 101)))
+
+	// a bad signature is turned away in CheckTx and left to the msg server once delivered
+	require.Error(t, check(badConfirm))
+	_, err = anteHandler(ctx.WithIsCheckTx(false), testTx{msgs: []sdk.Msg{badConfirm}}, false)
+	require.NoError(t, err)
+
+	// an aggregate holding a bad, repeated or excess confirm is turned away along with its good ones
+	submit := func(confirms ...types.MsgValsetConfirm) sdk.Msg {
+		return types.NewMsgSubmitConfirmations(keeper.AccAddrs[0], confirms, nil, nil)
+	}
+	require.NoError(t, check(submit(*confirm)))
+	require.Error(t, check(submit(*confirm, *badConfirm)))
+	require.ErrorIs(t, check(submit(*confirm, *confirm)), types.ErrDuplicate)
+	excess := make([]types.MsgValsetConfirm, types.MaxSubmissionEntries+1)
+	for i := range excess {
+		excess[i] = *confirm
+	}
+	require.ErrorIs(t, check(submit(excess...)), types.ErrInvalid)
+
+	// so are duplicate confirms and claims for nonces the validator already claimed
+	k.SetValsetConfirm(ctx, *confirm)
+	require.ErrorIs(t, check(confirm), types.ErrDuplicate)
+	claim := &types.MsgSendToCosmosClaim{
+		EventNonce:     2,
+		BlockHeight:    1,
+		TokenContract:  keeper.TokenContractAddrs[0],
+		Amount:         sdk.NewInt(100),
+		EthereumSender: keeper.EthAddrs[0].String(),
+		CosmosReceiver: keeper.AccAddrs[0].String(),
+		Orchestrator:   keeper.AccAddrs[0].String(),
+	}
+	require.ErrorIs(t, check(claim), types.ErrNonContiguousEventNonce)
+	claim.EventNonce = 1
+	require.NoError(t, check(claim))
+
+	// aggregates are turned away when any of their entries would not be applied
+	require.ErrorIs(t, check(types.NewMsgSubmitConfirmations(keeper.AccAddrs[0], []types.MsgValsetConfirm{*confirm}, nil, nil)), types.ErrDuplicate)
+	claims, err := types.NewMsgSubmitClaims(keeper.AccAddrs[0], []types.EthereumClaim{claim})
+	require.NoError(t, err)
+	require.NoError(t, check(claims))
+	gap := *claim
+	gap.EventNonce = 3
+	claims, err = types.NewMsgSubmitClaims(keeper.AccAddrs[0], []types.EthereumClaim{claim, &gap})
+	require.NoError(t, err)
+	require.ErrorIs(t, check(claims), types.ErrNonContiguousEventNonce)
+
+	// as is anyone that is not an orchestrator
+	outsider, err := sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+	require.NoError(t, err)
+	require.Error(t, check(types.NewMsgEthereumHeightClaim(outsider, 100)))
+
+	// transactions with other messages pay the minimum gas prices
+	sendToEth := &types.MsgSendToEth{
+		Sender:    keeper.AccAddrs[0].String(),
+		EthDest:   keeper.EthAddrs[1].String(),
+		Amount:    sdk.NewInt64Coin("stake", 100),
+		BridgeFee: sdk.NewInt64Coin("stake", 1),
+	}
+	require.ErrorIs(t, check(claim, sendToEth), sdkerrors.ErrInsufficientFee)
+
+	// confirms and claims a hot key executes for the orchestrator are checked the same way
+	exec := func(msgs ...sdk.Msg) *authz.MsgExec {
+		msg := authz.NewMsgExec(outsider, msgs)
+		return &msg
+	}
+	require.ErrorIs(t, check(exec(confirm)), types.ErrDuplicate)
+	require.Error(t, check(exec(types.NewMsgEthereumHeightClaim(outsider, 100))))
+	// but pay the minimum gas prices until the hot key holds a grant
+	require.ErrorIs(t, check(exec(claim)), sdkerrors.ErrInsufficientFee)
+	grants, err := types.NewGenericGrants(keeper.AccAddrs[0], outsider, types.OrchestratorMsgTypeURLs(), ctx.BlockTime().AddDate(1, 0, 0))
+	require.NoError(t, err)
+	for _, grant := range grants {
+		_, err := input.AuthzKeeper.Grant(sdk.WrapSDKContext(ctx), grant.(*authz.MsgGrant))
+		require.NoError(t, err)
+	}
+	require.NoError(t, check(exec(claim)))
+	require.ErrorIs(t, check(exec(claim, sendToEth)), sdkerrors.ErrInsufficientFee)
+}
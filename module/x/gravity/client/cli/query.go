@@ -1,15 +1,19 @@
 package cli
 
 import (
+	"encoding/hex"
 	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/spf13/cobra"
 
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
 )
 
+const flagLimit = "limit"
+
 func GetQueryCmd() *cobra.Command {
 	//nolint: exhaustivestruct
 	gravityQueryCmd := &cobra.Command{
@@ -20,56 +24,71 @@ func GetQueryCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 	gravityQueryCmd.AddCommand([]*cobra.Command{
+		CmdGetParams(),
 		CmdGetCurrentValset(),
 		CmdGetValsetRequest(),
 		CmdGetValsetConfirm(),
+		CmdGetValsetConfirms(),
+		CmdGetLastValsetRequests(),
 		CmdGetPendingValsetRequest(),
 		CmdGetPendingOutgoingTXBatchRequest(),
-		// CmdGetAllOutgoingTXBatchRequest(),
-		// CmdGetOutgoingTXBatchByNonceRequest(),
-		// CmdGetAllAttestationsRequest(),
-		// CmdGetAttestationRequest(),
-		// QueryObserved(),
-		// QueryApproved(),
+		CmdGetPendingLogicCallRequest(),
+		CmdGetLastEventNonce(),
+		CmdGetBatchFees(),
+		CmdGetAllOutgoingTXBatchRequest(),
+		CmdGetOutgoingTXBatchByNonceRequest(),
+		CmdGetBatchConfirms(),
+		CmdGetAllOutgoingLogicCalls(),
+		CmdGetOutgoingLogicCall(),
+		CmdGetLogicConfirms(),
+		CmdGetERC20ToDenom(),
+		CmdGetDenomToERC20(),
+		CmdGetAttestations(),
+		CmdGetDelegateKeysByValidator(),
+		CmdGetDelegateKeysByEth(),
+		CmdGetDelegateKeysByOrchestrator(),
+		CmdGetPendingSendToEth(),
+		CmdGetTransferHistory(),
+		CmdGetTransferHistoryBySender(),
+		CmdGetDepositReceipt(),
+		CmdGetDepositReceiptsByReceiver(),
+		CmdGetDepositReceiptsByEthereumSender(),
+		CmdGetFailedDeposits(),
+		CmdGetEthereumHeight(),
+		CmdGetProtocolFee(),
+		CmdGetBlocklist(),
+		CmdGetQuarantinedDeposits(),
+		CmdGetBridgeHijackIncidents(),
+		CmdGetConflictingClaims(),
+		CmdGetBadSignatureEvidence(),
 	}...)
+	gravityQueryCmd.PersistentFlags().Uint64(flagEvmChainID, 0, evmChainIDUsage)
 
 	return gravityQueryCmd
 }
 
-func QueryObserved() *cobra.Command {
+func CmdGetParams() *cobra.Command {
 	//nolint: exhaustivestruct
-	testingTxCmd := &cobra.Command{
-		Use:                        "observed",
-		Short:                      "observed ETH events",
-		DisableFlagParsing:         true,
-		SuggestionsMinimumDistance: 2,
-		RunE:                       client.ValidateCmd,
-	}
-	testingTxCmd.AddCommand([]*cobra.Command{
-		// CmdGetLastObservedNonceRequest(storeKey, cdc),
-		// CmdGetLastObservedNoncesRequest(storeKey, cdc),
-		// CmdGetLastObservedMultiSigUpdateRequest(storeKey, cdc),
-		// CmdGetAllBridgedDenominatorsRequest(storeKey, cdc),
-	}...)
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the current gravity parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
 
-	return testingTxCmd
-}
-func QueryApproved() *cobra.Command {
-	//nolint: exhaustivestruct
-	testingTxCmd := &cobra.Command{
-		Use:                        "approved",
-		Short:                      "approved cosmos operation",
-		DisableFlagParsing:         true,
-		SuggestionsMinimumDistance: 2,
-		RunE:                       client.ValidateCmd,
-	}
-	testingTxCmd.AddCommand([]*cobra.Command{
-		// CmdGetLastApprovedNoncesRequest(storeKey, cdc),
-		// CmdGetLastApprovedMultiSigUpdateRequest(storeKey, cdc),
-		// CmdGetInflightBatchesRequest(storeKey, cdc),
-	}...)
+			req := &types.QueryParamsRequest{}
+
+			res, err := queryClient.Params(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
 
-	return testingTxCmd
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
 }
 
 func CmdGetCurrentValset() *cobra.Command {
@@ -82,7 +101,14 @@ func CmdGetCurrentValset() *cobra.Command {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 			queryClient := types.NewQueryClient(clientCtx)
 
-			req := &types.QueryCurrentValsetRequest{}
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryCurrentValsetRequest{
+				ChainId: chainID,
+			}
 
 			res, err := queryClient.CurrentValset(cmd.Context(), req)
 			if err != nil {
@@ -111,8 +137,14 @@ func CmdGetValsetRequest() *cobra.Command {
 				return err
 			}
 
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
 			req := &types.QueryValsetRequestRequest{
-				Nonce: nonce,
+				Nonce:   nonce,
+				ChainId: chainID,
 			}
 
 			res, err := queryClient.ValsetRequest(cmd.Context(), req)
@@ -142,9 +174,15 @@ func CmdGetValsetConfirm() *cobra.Command {
 				return err
 			}
 
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
 			req := &types.QueryValsetConfirmRequest{
 				Nonce:   nonce,
 				Address: args[1],
+				ChainId: chainID,
 			}
 
 			res, err := queryClient.ValsetConfirm(cmd.Context(), req)
@@ -169,8 +207,14 @@ func CmdGetPendingValsetRequest() *cobra.Command {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 			queryClient := types.NewQueryClient(clientCtx)
 
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
 			req := &types.QueryLastPendingValsetRequestByAddrRequest{
 				Address: args[0],
+				ChainId: chainID,
 			}
 
 			res, err := queryClient.LastPendingValsetRequestByAddr(cmd.Context(), req)
@@ -195,8 +239,14 @@ func CmdGetPendingOutgoingTXBatchRequest() *cobra.Command {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 			queryClient := types.NewQueryClient(clientCtx)
 
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
 			req := &types.QueryLastPendingBatchRequestByAddrRequest{
 				Address: args[0],
+				ChainId: chainID,
 			}
 
 			res, err := queryClient.LastPendingBatchRequestByAddr(cmd.Context(), req)
@@ -210,3 +260,1040 @@ func CmdGetPendingOutgoingTXBatchRequest() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdGetBridgeHijackIncidents() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "bridge-hijack-incidents",
+		Short: "Query recorded bridge hijack incidents and whether the bridge is paused",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryBridgeHijackIncidentsRequest{
+				ChainId: chainID,
+			}
+
+			res, err := queryClient.BridgeHijackIncidents(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetConflictingClaims() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "conflicting-claims [event-nonce]",
+		Short: "Query validators that voted for a claim conflicting with the observed one, optionally at a single event nonce",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryConflictingClaimsRequest{
+				ChainId: chainID,
+			}
+			if len(args) == 1 {
+				nonce, err := strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return err
+				}
+				req.EventNonce = nonce
+			}
+
+			res, err := queryClient.ConflictingClaims(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetBadSignatureEvidence() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "bad-signature-evidence [validator]",
+		Short: "Query the bad Ethereum signature evidence acted on so far, optionally against a single validator",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryBadSignatureEvidenceRequest{
+				ChainId: chainID,
+			}
+			if len(args) == 1 {
+				req.Validator = args[0]
+			}
+
+			res, err := queryClient.BadSignatureEvidence(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetValsetConfirms() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "valset-confirms [nonce]",
+		Short: "Get all valset confirmations with a particular nonce",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			nonce, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryValsetConfirmsByNonceRequest{
+				Nonce:   nonce,
+				ChainId: chainID,
+			}
+
+			res, err := queryClient.ValsetConfirmsByNonce(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetLastValsetRequests() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "last-valset-requests",
+		Short: "Get the most recent valset requests",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryLastValsetRequestsRequest{
+				ChainId: chainID,
+			}
+
+			res, err := queryClient.LastValsetRequests(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetPendingLogicCallRequest() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "pending-logic-call-request [bech32 orchestrator address]",
+		Short: "Get the latest outgoing logic call which has not been signed by a particular orchestrator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryLastPendingLogicCallByAddrRequest{
+				Address: args[0],
+				ChainId: chainID,
+			}
+
+			res, err := queryClient.LastPendingLogicCallByAddr(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetLastEventNonce() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "last-event-nonce [bech32 orchestrator address]",
+		Short: "Get the last event nonce the validator of a particular orchestrator has claimed",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryLastEventNonceByAddrRequest{
+				Address: args[0],
+				ChainId: chainID,
+			}
+
+			res, err := queryClient.LastEventNonceByAddr(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetBatchFees() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "batch-fees",
+		Short: "Get the fees a batch would pay for each token in the pool",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryBatchFeeRequest{
+				ChainId: chainID,
+			}
+
+			res, err := queryClient.BatchFees(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetAllOutgoingTXBatchRequest() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "outgoing-tx-batches",
+		Short: "Get all outgoing TX batches that have not been executed yet",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryOutgoingTxBatchesRequest{
+				ChainId: chainID,
+			}
+
+			res, err := queryClient.OutgoingTxBatches(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetOutgoingTXBatchByNonceRequest() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "batch-request [token-contract] [nonce]",
+		Short: "Get the outgoing TX batch of a token with a particular nonce",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			nonce, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryBatchRequestByNonceRequest{
+				Nonce:           nonce,
+				ContractAddress: args[0],
+				ChainId:         chainID,
+			}
+
+			res, err := queryClient.BatchRequestByNonce(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetBatchConfirms() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "batch-confirms [token-contract] [nonce]",
+		Short: "Get all confirmations of the outgoing TX batch of a token with a particular nonce",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			nonce, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryBatchConfirmsRequest{
+				Nonce:           nonce,
+				ContractAddress: args[0],
+				ChainId:         chainID,
+			}
+
+			res, err := queryClient.BatchConfirms(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetAllOutgoingLogicCalls() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "outgoing-logic-calls",
+		Short: "Get all outgoing logic calls that have not been executed yet",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryOutgoingLogicCallsRequest{
+				ChainId: chainID,
+			}
+
+			res, err := queryClient.OutgoingLogicCalls(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetOutgoingLogicCall() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "outgoing-logic-call [invalidation-id] [invalidation-nonce]",
+		Short: "Get the outgoing logic call with a particular hex encoded invalidation id and nonce",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			invalidationID, err := hex.DecodeString(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalidation id")
+			}
+			nonce, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryOutgoingLogicCallRequest{
+				InvalidationId:    invalidationID,
+				InvalidationNonce: nonce,
+				ChainId:           chainID,
+			}
+
+			res, err := queryClient.OutgoingLogicCall(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetLogicConfirms() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "logic-confirms [invalidation-id] [invalidation-nonce]",
+		Short: "Get all confirmations of the outgoing logic call with a particular hex encoded invalidation id and nonce",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			invalidationID, err := hex.DecodeString(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalidation id")
+			}
+			nonce, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryLogicConfirmsRequest{
+				InvalidationId:    invalidationID,
+				InvalidationNonce: nonce,
+				ChainId:           chainID,
+			}
+
+			res, err := queryClient.LogicConfirms(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetERC20ToDenom() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "erc20-to-denom [erc20]",
+		Short: "Get the Cosmos denom an ERC20 token is represented by",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryERC20ToDenomRequest{
+				Erc20:   args[0],
+				ChainId: chainID,
+			}
+
+			res, err := queryClient.ERC20ToDenom(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetDenomToERC20() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "denom-to-erc20 [denom]",
+		Short: "Get the ERC20 token a Cosmos denom is represented by",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDenomToERC20Request{
+				Denom:   args[0],
+				ChainId: chainID,
+			}
+
+			res, err := queryClient.DenomToERC20(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetAttestations() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "attestations",
+		Short: "Get the most recent attestations, the chain returns at most 1000",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			limit, err := cmd.Flags().GetUint64(flagLimit)
+			if err != nil {
+				return err
+			}
+
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryAttestationsRequest{
+				Limit:   limit,
+				ChainId: chainID,
+			}
+
+			res, err := queryClient.GetAttestations(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().Uint64(flagLimit, 100, "number of attestations to return")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetDelegateKeysByValidator() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "delegate-keys-by-validator [bech32 validator address]",
+		Short: "Get the orchestrator and Ethereum address a validator has set",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDelegateKeysByValidatorAddress{
+				ValidatorAddress: args[0],
+				ChainId:          chainID,
+			}
+
+			res, err := queryClient.GetDelegateKeyByValidator(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetDelegateKeysByEth() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "delegate-keys-by-eth [eth address]",
+		Short: "Get the validator and orchestrator address an Ethereum address belongs to",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDelegateKeysByEthAddress{
+				EthAddress: args[0],
+				ChainId:    chainID,
+			}
+
+			res, err := queryClient.GetDelegateKeyByEth(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetDelegateKeysByOrchestrator() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "delegate-keys-by-orchestrator [bech32 orchestrator address]",
+		Short: "Get the validator and Ethereum address an orchestrator belongs to",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDelegateKeysByOrchestratorAddress{
+				OrchestratorAddress: args[0],
+				ChainId:             chainID,
+			}
+
+			res, err := queryClient.GetDelegateKeyByOrchestrator(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetPendingSendToEth() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "pending-send-to-eth [bech32 sender address]",
+		Short: "Get the transfers to Ethereum of a sender that have not been executed yet, batched or not",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPendingSendToEth{
+				SenderAddress: args[0],
+				ChainId:       chainID,
+			}
+
+			res, err := queryClient.GetPendingSendToEth(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetTransferHistory() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "transfer-history [tx-id]",
+		Short: "Get every step in the life of a transfer to Ethereum, from entering the pool to being executed or canceled",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			txID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryTransferHistoryRequest{
+				TxId:    txID,
+				ChainId: chainID,
+			}
+
+			res, err := queryClient.TransferHistory(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetTransferHistoryBySender() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "transfer-history-by-sender [bech32 sender address]",
+		Short: "Get the history of every transfer to Ethereum of a sender that has not been pruned yet",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryTransferHistoryBySenderRequest{
+				Sender:  args[0],
+				ChainId: chainID,
+			}
+
+			res, err := queryClient.TransferHistoryBySender(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetDepositReceipt() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "deposit-receipt [event-nonce]",
+		Short: "Get the outcome of the deposit from Ethereum observed at an event nonce",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			nonce, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDepositReceiptRequest{
+				EventNonce: nonce,
+				ChainId:    chainID,
+			}
+
+			res, err := queryClient.DepositReceipt(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetDepositReceiptsByReceiver() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "deposit-receipts-by-receiver [bech32 receiver address]",
+		Short: "Get the outcome of every deposit from Ethereum to a receiver that has not been pruned yet",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDepositReceiptsByReceiverRequest{
+				Receiver: args[0],
+				ChainId:  chainID,
+			}
+
+			res, err := queryClient.DepositReceiptsByReceiver(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetDepositReceiptsByEthereumSender() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "deposit-receipts-by-eth-sender [eth sender address]",
+		Short: "Get the outcome of every deposit from an Ethereum sender that has not been pruned yet",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDepositReceiptsByEthereumSenderRequest{
+				EthereumSender: args[0],
+				ChainId:        chainID,
+			}
+
+			res, err := queryClient.DepositReceiptsByEthereumSender(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetFailedDeposits() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "failed-deposits",
+		Short: "Get every deposit from Ethereum held in escrow because it could not be credited",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryFailedDepositsRequest{
+				ChainId: chainID,
+			}
+
+			res, err := queryClient.FailedDeposits(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetEthereumHeight() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "ethereum-height",
+		Short: "Get the last observed Ethereum height and the heights reported by the orchestrators",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryEthereumHeightRequest{
+				ChainId: chainID,
+			}
+
+			res, err := queryClient.EthereumHeight(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetProtocolFee() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "protocol-fee [amount]",
+		Short: "Get the protocol fee a transfer of amount to Ethereum pays on top of its bridge fee",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryProtocolFeeRequest{
+				Amount:  args[0],
+				ChainId: chainID,
+			}
+
+			res, err := queryClient.ProtocolFee(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetBlocklist() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "blocklist",
+		Short: "Get every blocked Ethereum and Cosmos address",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryBlocklistRequest{}
+
+			res, err := queryClient.Blocklist(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetQuarantinedDeposits() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "quarantined-deposits",
+		Short: "Get every deposit from Ethereum held in quarantine because its sender or receiver is blocked",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryQuarantinedDepositsRequest{
+				ChainId: chainID,
+			}
+
+			res, err := queryClient.QuarantinedDeposits(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
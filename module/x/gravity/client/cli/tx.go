@@ -5,18 +5,39 @@ import (
 	"encoding/hex"
 	"fmt"
 	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	ethCrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/gogo/protobuf/proto"
 	"github.com/spf13/cobra"
 
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
 )
 
+const (
+	flagRewardAmount = "reward-amount"
+	flagRewardToken  = "reward-token"
+	flagEvmChainID   = "evm-chain-id"
+	flagBlock        = "block"
+	flagUnblock      = "unblock"
+	flagExpiration   = "expiration"
+	flagDisplay      = "display"
+	flagAliases      = "aliases"
+)
+
+const evmChainIDUsage = "chain id of the EVM chain the command is for, the default chain when not set"
+
 func GetTxCmd(storeKey string) *cobra.Command {
 	//nolint: exhaustivestruct
 	gravityTxCmd := &cobra.Command{
@@ -29,15 +50,50 @@ func GetTxCmd(storeKey string) *cobra.Command {
 
 	gravityTxCmd.AddCommand([]*cobra.Command{
 		CmdSendToEth(),
+		CmdCancelSendToEth(),
 		CmdSetMinFeeTransferToEth(),
 		CmdRequestBatch(),
 		CmdSetOrchestratorAddress(),
+		CmdValsetConfirm(),
+		CmdConfirmBatch(),
+		CmdConfirmLogicCall(),
+		CmdSubmitBadSignatureEvidence(),
+		CmdGrantOrchestrator(),
+		CmdGrantBridge(),
+		GetClaimCmd(),
 		GetUnsafeTestingCmd(),
 	}...)
+	gravityTxCmd.PersistentFlags().Uint64(flagEvmChainID, 0, evmChainIDUsage)
 
 	return gravityTxCmd
 }
 
+// evmChainID returns the chain id given with the evm-chain-id flag, zero refers to the default chain
+func evmChainID(cmd *cobra.Command) (uint64, error) {
+	return cmd.Flags().GetUint64(flagEvmChainID)
+}
+
+func GetClaimCmd() *cobra.Command {
+	//nolint: exhaustivestruct
+	claimCmd := &cobra.Command{
+		Use:                        "claim",
+		Short:                      "Submit claims of events observed on Ethereum, signed by the orchestrator key",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	claimCmd.AddCommand([]*cobra.Command{
+		CmdSendToCosmosClaim(),
+		CmdBatchSendToEthClaim(),
+		CmdERC20DeployedClaim(),
+		CmdLogicCallExecutedClaim(),
+		CmdValsetUpdatedClaim(),
+		CmdEthereumHeightClaim(),
+	}...)
+
+	return claimCmd
+}
+
 func GetUnsafeTestingCmd() *cobra.Command {
 	//nolint: exhaustivestruct
 	testingTxCmd := &cobra.Command{
@@ -130,12 +186,18 @@ func CmdSendToEth() *cobra.Command {
 				return fmt.Errorf("coin amounts too long or zero, expecting just 1 coin amount for both amount and bridgeFee")
 			}
 
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
 			// Make the message
 			msg := types.MsgSendToEth{
 				Sender:    cosmosAddr.String(),
 				EthDest:   ethAddr.GetAddress(),
 				Amount:    amount[0],
 				BridgeFee: bridgeFee[0],
+				ChainId:   chainID,
 			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -201,10 +263,16 @@ func CmdRequestBatch() *cobra.Command {
 			}
 			cosmosAddr := cliCtx.GetFromAddress()
 
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
 			// TODO: better denom searching
 			msg := types.MsgRequestBatch{
-				Sender: cosmosAddr.String(),
-				Denom:  args[0], // fmt.Sprintf("gravity%s", args[0]),
+				Sender:  cosmosAddr.String(),
+				Denom:   args[0], // fmt.Sprintf("gravity%s", args[0]),
+				ChainId: chainID,
 			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -228,10 +296,343 @@ func CmdSetOrchestratorAddress() *cobra.Command {
 			if err != nil {
 				return err
 			}
+
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := types.MsgSetOrchestratorAddress{
 				Validator:    args[0],
 				Orchestrator: args[1],
 				EthAddress:   args[2],
+				ChainId:      chainID,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGrantOrchestrator() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "grant-orchestrator [grantee]",
+		Short: "Authorizes a hot key to submit the confirms and claims of the orchestrator signing the transaction",
+		Long: `Grants the grantee a generic authorization for every confirm and claim an orchestrator submits, the grantee
+submits them wrapped in an authz MsgExec and the orchestrator key can be kept cold.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return grantGeneric(cmd, args[0], types.OrchestratorMsgTypeURLs())
+		},
+	}
+	cmd.Flags().Int64(flagExpiration, 0, "unix timestamp the authorizations expire at, a year from now when not set")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGrantBridge() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "grant-bridge [grantee]",
+		Short: "Authorizes an account, such as a custodial wallet, to send the coins of the signer to Ethereum",
+		Long: `Grants the grantee a generic authorization for MsgSendToEth and MsgCancelSendToEth, so that it can
+bridge coins to Ethereum and cancel those transfers on behalf of the account signing the transaction.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return grantGeneric(cmd, args[0], types.BridgeMsgTypeURLs())
+		},
+	}
+	cmd.Flags().Int64(flagExpiration, 0, "unix timestamp the authorizations expire at, a year from now when not set")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// grantGeneric broadcasts the grants of a generic authorization for each of the message types from the signer
+// of the transaction to grantee
+func grantGeneric(cmd *cobra.Command, granteeArg string, msgTypeURLs []string) error {
+	cliCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+	grantee, err := sdk.AccAddressFromBech32(granteeArg)
+	if err != nil {
+		return sdkerrors.Wrap(err, "grantee")
+	}
+	expiration := time.Now().AddDate(1, 0, 0)
+	if exp, err := cmd.Flags().GetInt64(flagExpiration); err != nil {
+		return err
+	} else if exp != 0 {
+		expiration = time.Unix(exp, 0)
+	}
+
+	msgs, err := types.NewGenericGrants(cliCtx.GetFromAddress(), grantee, msgTypeURLs, expiration)
+	if err != nil {
+		return err
+	}
+	for _, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	// Send it
+	return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msgs...)
+}
+
+func CmdSubmitClearBridgeHijackProposal() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "clear-bridge-hijack [flags]",
+		Short: "Submit a proposal to clear all recorded bridge hijack incidents and resume the bridge",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			from := cliCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewClearBridgeHijackProposal(title, description)
+			content.ChainId = chainID
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().Uint64(flagEvmChainID, 0, evmChainIDUsage)
+	cmd.MarkFlagRequired(govcli.FlagTitle)
+	cmd.MarkFlagRequired(govcli.FlagDescription)
+	// the tx flags are added by the gov submit-proposal command this is mounted under
+	return cmd
+}
+
+func CmdCancelSendToEth() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "cancel-send-to-eth [transaction-id]",
+		Short: "Removes a transfer that has not been batched yet from the pool and refunds the amount and the fee",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			txID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "transaction id")
+			}
+
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelSendToEth(cliCtx.GetFromAddress(), txID)
+			msg.ChainId = chainID
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdValsetConfirm() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "valset-confirm [nonce] [eth-address] [signature]",
+		Short: "Submits the orchestrator's Ethereum signature over the valset with a particular nonce",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			nonce, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "nonce")
+			}
+
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgValsetConfirm{
+				Nonce:        nonce,
+				Orchestrator: cliCtx.GetFromAddress().String(),
+				EthAddress:   args[1],
+				Signature:    args[2],
+				ChainId:      chainID,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdConfirmBatch() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "confirm-batch [token-contract] [nonce] [eth-signer] [signature]",
+		Short: "Submits the orchestrator's Ethereum signature over the batch of a token with a particular nonce",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			nonce, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "nonce")
+			}
+
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgConfirmBatch{
+				Nonce:         nonce,
+				TokenContract: args[0],
+				EthSigner:     args[2],
+				Orchestrator:  cliCtx.GetFromAddress().String(),
+				Signature:     args[3],
+				ChainId:       chainID,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdConfirmLogicCall() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "confirm-logic-call [invalidation-id] [invalidation-nonce] [eth-signer] [signature]",
+		Short: "Submits the orchestrator's Ethereum signature over the logic call with a particular hex encoded invalidation id and nonce",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			nonce, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalidation nonce")
+			}
+
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgConfirmLogicCall{
+				InvalidationId:    args[0],
+				InvalidationNonce: nonce,
+				EthSigner:         args[2],
+				Orchestrator:      cliCtx.GetFromAddress().String(),
+				Signature:         args[3],
+				ChainId:           chainID,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdSubmitBadSignatureEvidence() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "submit-bad-signature-evidence [subject-json-file] [signature]",
+		Short: "Submits a validator's Ethereum signature over a valset, batch or logic call this chain never produced",
+		Long: `Submits a validator's Ethereum signature over a valset, batch or logic call this chain never produced.
+The subject file holds the signed object in JSON with its type, for example:
+
+{"@type": "/gravity.v1.Valset", "nonce": "5", "members": [...], "height": "0", "reward_amount": "0", "reward_token": "0x0000000000000000000000000000000000000000"}`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var subject types.EthereumSigned
+			if err := cliCtx.Codec.UnmarshalInterfaceJSON(bz, &subject); err != nil {
+				return sdkerrors.Wrap(err, "subject")
+			}
+			any, err := codectypes.NewAnyWithValue(subject.(proto.Message))
+			if err != nil {
+				return err
+			}
+
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgSubmitBadSignatureEvidence{
+				Subject:   any,
+				Signature: args[1],
+				Sender:    cliCtx.GetFromAddress().String(),
+				ChainId:   chainID,
 			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -243,3 +644,588 @@ func CmdSetOrchestratorAddress() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// parseClaimHeights parses the event nonce and Ethereum block height every claim starts with
+func parseClaimHeights(eventNonceArg, blockHeightArg string) (eventNonce uint64, blockHeight uint64, err error) {
+	eventNonce, err = strconv.ParseUint(eventNonceArg, 10, 64)
+	if err != nil {
+		return 0, 0, sdkerrors.Wrap(err, "event nonce")
+	}
+	blockHeight, err = strconv.ParseUint(blockHeightArg, 10, 64)
+	if err != nil {
+		return 0, 0, sdkerrors.Wrap(err, "block height")
+	}
+	return eventNonce, blockHeight, nil
+}
+
+func CmdSendToCosmosClaim() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "send-to-cosmos [event-nonce] [block-height] [token-contract] [amount] [ethereum-sender] [cosmos-receiver]",
+		Short: "Claims a deposit into the bridge contract on Ethereum",
+		Args:  cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			eventNonce, blockHeight, err := parseClaimHeights(args[0], args[1])
+			if err != nil {
+				return err
+			}
+			amount, ok := sdk.NewIntFromString(args[3])
+			if !ok {
+				return fmt.Errorf("invalid amount %s", args[3])
+			}
+
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgSendToCosmosClaim{
+				EventNonce:     eventNonce,
+				BlockHeight:    blockHeight,
+				TokenContract:  args[2],
+				Amount:         amount,
+				EthereumSender: args[4],
+				CosmosReceiver: args[5],
+				Orchestrator:   cliCtx.GetFromAddress().String(),
+				ChainId:        chainID,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdBatchSendToEthClaim() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "batch-send-to-eth [event-nonce] [block-height] [token-contract] [batch-nonce]",
+		Short: "Claims the execution of a batch on Ethereum",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			eventNonce, blockHeight, err := parseClaimHeights(args[0], args[1])
+			if err != nil {
+				return err
+			}
+			batchNonce, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "batch nonce")
+			}
+
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgBatchSendToEthClaim{
+				EventNonce:    eventNonce,
+				BlockHeight:   blockHeight,
+				BatchNonce:    batchNonce,
+				TokenContract: args[2],
+				Orchestrator:  cliCtx.GetFromAddress().String(),
+				ChainId:       chainID,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdERC20DeployedClaim() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "erc20-deployed [event-nonce] [block-height] [cosmos-denom] [token-contract] [name] [symbol] [decimals]",
+		Short: "Claims the deployment of an ERC20 representing a Cosmos denom on Ethereum",
+		Args:  cobra.ExactArgs(7),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			eventNonce, blockHeight, err := parseClaimHeights(args[0], args[1])
+			if err != nil {
+				return err
+			}
+			decimals, err := strconv.ParseUint(args[6], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "decimals")
+			}
+
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgERC20DeployedClaim{
+				EventNonce:    eventNonce,
+				BlockHeight:   blockHeight,
+				CosmosDenom:   args[2],
+				TokenContract: args[3],
+				Name:          args[4],
+				Symbol:        args[5],
+				Decimals:      decimals,
+				Orchestrator:  cliCtx.GetFromAddress().String(),
+				ChainId:       chainID,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdLogicCallExecutedClaim() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "logic-call-executed [event-nonce] [block-height] [invalidation-id] [invalidation-nonce]",
+		Short: "Claims the execution of the logic call with a particular hex encoded invalidation id and nonce on Ethereum",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			eventNonce, blockHeight, err := parseClaimHeights(args[0], args[1])
+			if err != nil {
+				return err
+			}
+			invalidationID, err := hex.DecodeString(args[2])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalidation id")
+			}
+			invalidationNonce, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalidation nonce")
+			}
+
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgLogicCallExecutedClaim{
+				EventNonce:        eventNonce,
+				BlockHeight:       blockHeight,
+				InvalidationId:    invalidationID,
+				InvalidationNonce: invalidationNonce,
+				Orchestrator:      cliCtx.GetFromAddress().String(),
+				ChainId:           chainID,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdValsetUpdatedClaim() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "valset-updated [event-nonce] [block-height] [valset-nonce] [members]",
+		Short: "Claims a validator set update on Ethereum, members are given as comma separated eth-address:power pairs",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			eventNonce, blockHeight, err := parseClaimHeights(args[0], args[1])
+			if err != nil {
+				return err
+			}
+			valsetNonce, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "valset nonce")
+			}
+			members, err := parseBridgeValidators(args[3])
+			if err != nil {
+				return err
+			}
+			rewardAmountStr, err := cmd.Flags().GetString(flagRewardAmount)
+			if err != nil {
+				return err
+			}
+			rewardAmount, ok := sdk.NewIntFromString(rewardAmountStr)
+			if !ok {
+				return fmt.Errorf("invalid reward amount %s", rewardAmountStr)
+			}
+			rewardToken, err := cmd.Flags().GetString(flagRewardToken)
+			if err != nil {
+				return err
+			}
+
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgValsetUpdatedClaim{
+				EventNonce:   eventNonce,
+				ValsetNonce:  valsetNonce,
+				BlockHeight:  blockHeight,
+				Members:      members,
+				RewardAmount: rewardAmount,
+				RewardToken:  rewardToken,
+				Orchestrator: cliCtx.GetFromAddress().String(),
+				ChainId:      chainID,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().String(flagRewardAmount, "0", "reward paid out by the valset update")
+	cmd.Flags().String(flagRewardToken, types.ZeroAddressString, "ERC20 the reward was paid in")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseBridgeValidators parses comma separated eth-address:power pairs
+func parseBridgeValidators(arg string) ([]*types.BridgeValidator, error) {
+	var members []*types.BridgeValidator
+	for _, pair := range strings.Split(arg, ",") {
+		parts := strings.Split(pair, ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid member %s, expecting eth-address:power", pair)
+		}
+		power, err := strconv.ParseUint(parts[1], 10, 64)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "power of member %s", parts[0])
+		}
+		members = append(members, &types.BridgeValidator{
+			Power:           power,
+			EthereumAddress: parts[0],
+		})
+	}
+	return members, nil
+}
+
+func CmdSubmitReleaseFailedDepositProposal() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "release-failed-deposit [event-nonce] [recipient] [flags]",
+		Short: "Submit a proposal to pay a deposit held in escrow to recipient, or to refund it to its Ethereum sender when recipient is omitted",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			from := cliCtx.GetFromAddress()
+
+			nonce, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			var recipient string
+			if len(args) > 1 {
+				recipient = args[1]
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewReleaseFailedDepositProposal(title, description, nonce, recipient)
+			content.ChainId = chainID
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().Uint64(flagEvmChainID, 0, evmChainIDUsage)
+	cmd.MarkFlagRequired(govcli.FlagTitle)
+	cmd.MarkFlagRequired(govcli.FlagDescription)
+	// the tx flags are added by the gov submit-proposal command this is mounted under
+	return cmd
+}
+
+func CmdSubmitUpdateBlocklistProposal() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "update-blocklist [flags]",
+		Short: "Submit a proposal to add Ethereum or Cosmos addresses to the blocklist or to remove them from it",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			from := cliCtx.GetFromAddress()
+
+			blocked, err := cmd.Flags().GetStringSlice(flagBlock)
+			if err != nil {
+				return err
+			}
+			unblocked, err := cmd.Flags().GetStringSlice(flagUnblock)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewUpdateBlocklistProposal(title, description, blocked, unblocked)
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().StringSlice(flagBlock, nil, "comma separated Ethereum or Cosmos addresses to add to the blocklist")
+	cmd.Flags().StringSlice(flagUnblock, nil, "comma separated Ethereum or Cosmos addresses to remove from the blocklist")
+	cmd.MarkFlagRequired(govcli.FlagTitle)
+	cmd.MarkFlagRequired(govcli.FlagDescription)
+	// the tx flags are added by the gov submit-proposal command this is mounted under
+	return cmd
+}
+
+func CmdSubmitReleaseQuarantinedDepositProposal() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "release-quarantined-deposit [event-nonce] [recipient] [flags]",
+		Short: "Submit a proposal to pay a deposit held in quarantine to recipient, or to its Cosmos receiver when recipient is omitted",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			from := cliCtx.GetFromAddress()
+
+			nonce, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			var recipient string
+			if len(args) > 1 {
+				recipient = args[1]
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewReleaseQuarantinedDepositProposal(title, description, nonce, recipient)
+			content.ChainId = chainID
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().Uint64(flagEvmChainID, 0, evmChainIDUsage)
+	cmd.MarkFlagRequired(govcli.FlagTitle)
+	cmd.MarkFlagRequired(govcli.FlagDescription)
+	// the tx flags are added by the gov submit-proposal command this is mounted under
+	return cmd
+}
+
+func CmdEthereumHeightClaim() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "ethereum-height [ethereum-height]",
+		Short: "Reports the latest Ethereum block height seen by the orchestrator's Ethereum node",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			ethereumHeight, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "ethereum height")
+			}
+
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgEthereumHeightClaim(cliCtx.GetFromAddress(), ethereumHeight)
+			msg.ChainId = chainID
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdSubmitSetERC20MetadataProposal() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "set-erc20-metadata [erc20] [name] [symbol] [decimals] [flags]",
+		Short: "Submit a proposal to register the denom metadata of the vouchers of an Ethereum originated ERC20",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			from := cliCtx.GetFromAddress()
+
+			decimals, err := strconv.ParseUint(args[3], 10, 32)
+			if err != nil {
+				return sdkerrors.Wrap(err, "decimals")
+			}
+			display, err := cmd.Flags().GetString(flagDisplay)
+			if err != nil {
+				return err
+			}
+			aliases, err := cmd.Flags().GetStringSlice(flagAliases)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			chainID, err := evmChainID(cmd)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewSetERC20MetadataProposal(title, description, args[0], args[1], args[2], uint32(decimals), display, aliases)
+			content.ChainId = chainID
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().String(flagDisplay, "", "denom of the display unit, the symbol when not set")
+	cmd.Flags().StringSlice(flagAliases, nil, "other names of the display unit")
+	cmd.Flags().Uint64(flagEvmChainID, 0, evmChainIDUsage)
+	cmd.MarkFlagRequired(govcli.FlagTitle)
+	cmd.MarkFlagRequired(govcli.FlagDescription)
+	// the tx flags are added by the gov submit-proposal command this is mounted under
+	return cmd
+}
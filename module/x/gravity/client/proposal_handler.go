@@ -0,0 +1,25 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/client/cli"
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/client/rest"
+)
+
+// ClearBridgeHijackProposalHandler is the proposal handler used to resume the bridge after a hijack
+var ClearBridgeHijackProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitClearBridgeHijackProposal, rest.ClearBridgeHijackProposalRESTHandler)
+
+// ReleaseFailedDepositProposalHandler is the proposal handler used to release a deposit held in escrow
+var ReleaseFailedDepositProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitReleaseFailedDepositProposal, rest.ReleaseFailedDepositProposalRESTHandler)
+
+// UpdateBlocklistProposalHandler is the proposal handler used to add addresses to or remove addresses from
+// the blocklist
+var UpdateBlocklistProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitUpdateBlocklistProposal, rest.UpdateBlocklistProposalRESTHandler)
+
+// ReleaseQuarantinedDepositProposalHandler is the proposal handler used to release a deposit held in quarantine
+var ReleaseQuarantinedDepositProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitReleaseQuarantinedDepositProposal, rest.ReleaseQuarantinedDepositProposalRESTHandler)
+
+// SetERC20MetadataProposalHandler is the proposal handler used to register the denom metadata of an Ethereum
+// originated ERC20
+var SetERC20MetadataProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitSetERC20MetadataProposal, rest.SetERC20MetadataProposalRESTHandler)
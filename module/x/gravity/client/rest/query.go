@@ -1,214 +1,434 @@
 package rest
 
 import (
-	"fmt"
+	"encoding/hex"
 	"net/http"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/gorilla/mux"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
 )
 
-func getValsetRequestHandler(cliCtx client.Context, storeName string) http.HandlerFunc {
+// newQueryClient returns a gRPC query client for the height requested with the height query parameter,
+// false is returned once the error has been written
+func newQueryClient(w http.ResponseWriter, r *http.Request, cliCtx client.Context) (types.QueryClient, client.Context, bool) {
+	cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+	if !ok {
+		return nil, cliCtx, false
+	}
+	return types.NewQueryClient(cliCtx), cliCtx, true
+}
+
+// writeLegacyResponse writes a value taken from a gRPC response in the amino JSON these routes have
+// always returned, at the height the query was answered at
+func writeLegacyResponse(w http.ResponseWriter, cliCtx client.Context, header metadata.MD, v interface{}) {
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, v)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	rest.PostProcessResponse(w, cliCtx.WithHeight(responseHeight(header)), res)
+}
+
+// responseHeight returns the height a gRPC query was answered at
+func responseHeight(header metadata.MD) int64 {
+	heights := header.Get(grpctypes.GRPCBlockHeightHeader)
+	if len(heights) == 0 {
+		return 0
+	}
+	height, err := strconv.ParseInt(heights[0], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return height
+}
+
+func getValsetRequestHandler(cliCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		vars := mux.Vars(r)
-		nonce := vars[nonce]
+		queryClient, cliCtx, ok := newQueryClient(w, r, cliCtx)
+		if !ok {
+			return
+		}
+		nonce, err := types.UInt64FromString(mux.Vars(r)[nonce])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
 
-		res, height, err := cliCtx.Query(fmt.Sprintf("custom/%s/valsetRequest/%s", storeName, nonce))
+		var header metadata.MD
+		res, err := queryClient.ValsetRequest(r.Context(), &types.QueryValsetRequestRequest{Nonce: nonce}, grpc.Header(&header))
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
-		if len(res) == 0 {
+		if res.Valset == nil {
 			rest.WriteErrorResponse(w, http.StatusNotFound, "valset not found")
 			return
 		}
 
-		var out types.Valset
-		cliCtx.JSONCodec.MustUnmarshalJSON(res, &out)
-		rest.PostProcessResponse(w, cliCtx.WithHeight(height), res)
+		writeLegacyResponse(w, cliCtx, header, res.Valset)
 	}
 }
 
 // USED BY RUST
-func batchByNonceHandler(cliCtx client.Context, storeName string) http.HandlerFunc {
+func batchByNonceHandler(cliCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		queryClient, cliCtx, ok := newQueryClient(w, r, cliCtx)
+		if !ok {
+			return
+		}
 		vars := mux.Vars(r)
-		nonce := vars[nonce]
-		denom := vars[tokenAddress]
+		nonce, err := types.UInt64FromString(vars[nonce])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
 
-		res, height, err := cliCtx.Query(fmt.Sprintf("custom/%s/batch/%s/%s", storeName, nonce, denom))
+		var header metadata.MD
+		res, err := queryClient.BatchRequestByNonce(r.Context(), &types.QueryBatchRequestByNonceRequest{
+			Nonce:           nonce,
+			ContractAddress: vars[tokenAddress],
+		}, grpc.Header(&header))
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
-		if len(res) == 0 {
-			rest.WriteErrorResponse(w, http.StatusNotFound, "valset not found")
+		if res.Batch == nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, "batch not found")
 			return
 		}
 
-		var out types.OutgoingTxBatch
-		cliCtx.JSONCodec.MustUnmarshalJSON(res, &out)
-		rest.PostProcessResponse(w, cliCtx.WithHeight(height), res)
+		writeLegacyResponse(w, cliCtx, header, res.Batch)
 	}
 }
 
 // USED BY RUST
-func lastBatchesHandler(cliCtx client.Context, storeName string) http.HandlerFunc {
+func lastBatchesHandler(cliCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		queryClient, cliCtx, ok := newQueryClient(w, r, cliCtx)
+		if !ok {
+			return
+		}
 
-		res, height, err := cliCtx.Query(fmt.Sprintf("custom/%s/lastBatches", storeName))
+		var header metadata.MD
+		res, err := queryClient.OutgoingTxBatches(r.Context(), &types.QueryOutgoingTxBatchesRequest{}, grpc.Header(&header))
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
-		if len(res) == 0 {
-			rest.WriteErrorResponse(w, http.StatusNotFound, "valset not found")
+		if len(res.Batches) == 0 {
+			rest.WriteErrorResponse(w, http.StatusNotFound, "batches not found")
 			return
 		}
 
-		rest.PostProcessResponse(w, cliCtx.WithHeight(height), res)
+		writeLegacyResponse(w, cliCtx, header, res.Batches)
 	}
 }
 
 // gets all the confirm messages for a given validator set nonce
-func allValsetConfirmsHandler(cliCtx client.Context, storeName string) http.HandlerFunc {
+func allValsetConfirmsHandler(cliCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		vars := mux.Vars(r)
-		nonce := vars[nonce]
+		queryClient, cliCtx, ok := newQueryClient(w, r, cliCtx)
+		if !ok {
+			return
+		}
+		nonce, err := types.UInt64FromString(mux.Vars(r)[nonce])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
 
-		res, height, err := cliCtx.Query(fmt.Sprintf("custom/%s/valsetConfirms/%s", storeName, nonce))
+		var header metadata.MD
+		res, err := queryClient.ValsetConfirmsByNonce(r.Context(), &types.QueryValsetConfirmsByNonceRequest{Nonce: nonce}, grpc.Header(&header))
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
-		if len(res) == 0 {
+		if len(res.Confirms) == 0 {
 			rest.WriteErrorResponse(w, http.StatusNotFound, "valset confirms not found")
 			return
 		}
 
-		rest.PostProcessResponse(w, cliCtx.WithHeight(height), res)
+		writeLegacyResponse(w, cliCtx, header, res.Confirms)
 	}
 }
 
 // gets all the confirm messages for a given transaction batch
-func allBatchConfirmsHandler(cliCtx client.Context, storeName string) http.HandlerFunc {
+func allBatchConfirmsHandler(cliCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		queryClient, cliCtx, ok := newQueryClient(w, r, cliCtx)
+		if !ok {
+			return
+		}
 		vars := mux.Vars(r)
-		nonce := vars[nonce]
-		denom := vars[tokenAddress]
+		nonce, err := types.UInt64FromString(vars[nonce])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
 
-		res, height, err := cliCtx.Query(fmt.Sprintf("custom/%s/batchConfirms/%s/%s", storeName, nonce, denom))
+		var header metadata.MD
+		res, err := queryClient.BatchConfirms(r.Context(), &types.QueryBatchConfirmsRequest{
+			Nonce:           nonce,
+			ContractAddress: vars[tokenAddress],
+		}, grpc.Header(&header))
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
-		if len(res) == 0 {
-			rest.WriteErrorResponse(w, http.StatusNotFound, "valset confirms not found")
+		if len(res.Confirms) == 0 {
+			rest.WriteErrorResponse(w, http.StatusNotFound, "batch confirms not found")
 			return
 		}
 
-		rest.PostProcessResponse(w, cliCtx.WithHeight(height), res)
+		writeLegacyResponse(w, cliCtx, header, res.Confirms)
 	}
 }
 
-func lastValsetRequestsHandler(cliCtx client.Context, storeName string) http.HandlerFunc {
+func lastValsetRequestsHandler(cliCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		res, height, err := cliCtx.Query(fmt.Sprintf("custom/%s/lastValsetRequests", storeName))
+		queryClient, cliCtx, ok := newQueryClient(w, r, cliCtx)
+		if !ok {
+			return
+		}
+
+		var header metadata.MD
+		res, err := queryClient.LastValsetRequests(r.Context(), &types.QueryLastValsetRequestsRequest{}, grpc.Header(&header))
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
-		if len(res) == 0 {
+		if len(res.Valsets) == 0 {
 			rest.WriteErrorResponse(w, http.StatusNotFound, "valset requests not found")
 			return
 		}
 
-		rest.PostProcessResponse(w, cliCtx.WithHeight(height), res)
+		writeLegacyResponse(w, cliCtx, header, res.Valsets)
 	}
 }
 
-func lastValsetRequestsByAddressHandler(cliCtx client.Context, storeName string) http.HandlerFunc {
+func lastValsetRequestsByAddressHandler(cliCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		vars := mux.Vars(r)
-		operatorAddr := vars[bech32ValidatorAddress]
+		queryClient, cliCtx, ok := newQueryClient(w, r, cliCtx)
+		if !ok {
+			return
+		}
 
-		res, height, err := cliCtx.Query(fmt.Sprintf("custom/%s/lastPendingValsetRequest/%s", storeName, operatorAddr))
+		var header metadata.MD
+		res, err := queryClient.LastPendingValsetRequestByAddr(r.Context(), &types.QueryLastPendingValsetRequestByAddrRequest{
+			Address: mux.Vars(r)[bech32ValidatorAddress],
+		}, grpc.Header(&header))
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
-		if len(res) == 0 {
+		if len(res.Valsets) == 0 {
 			rest.WriteErrorResponse(w, http.StatusNotFound, "no pending valset requests found")
 			return
 		}
 
-		var out types.Valset
-		cliCtx.JSONCodec.MustUnmarshalJSON(res, &out)
-		rest.PostProcessResponse(w, cliCtx.WithHeight(height), res)
+		writeLegacyResponse(w, cliCtx, header, res.Valsets)
+	}
+}
+
+func lastBatchesByAddressHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		queryClient, cliCtx, ok := newQueryClient(w, r, cliCtx)
+		if !ok {
+			return
+		}
+
+		var header metadata.MD
+		res, err := queryClient.LastPendingBatchRequestByAddr(r.Context(), &types.QueryLastPendingBatchRequestByAddrRequest{
+			Address: mux.Vars(r)[bech32ValidatorAddress],
+		}, grpc.Header(&header))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if res.Batch == nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, "no pending batch requests found")
+			return
+		}
+
+		writeLegacyResponse(w, cliCtx, header, res.Batch)
 	}
 }
 
-func lastBatchesByAddressHandler(cliCtx client.Context, storeName string) http.HandlerFunc {
+func lastLogicCallByAddressHandler(cliCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		queryClient, cliCtx, ok := newQueryClient(w, r, cliCtx)
+		if !ok {
+			return
+		}
+
+		var header metadata.MD
+		res, err := queryClient.LastPendingLogicCallByAddr(r.Context(), &types.QueryLastPendingLogicCallByAddrRequest{
+			Address: mux.Vars(r)[bech32ValidatorAddress],
+		}, grpc.Header(&header))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if res.Call == nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, "no pending logic calls found")
+			return
+		}
+
+		writeLegacyResponse(w, cliCtx, header, res.Call)
+	}
+}
+
+func lastLogicCallsHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		queryClient, cliCtx, ok := newQueryClient(w, r, cliCtx)
+		if !ok {
+			return
+		}
+
+		var header metadata.MD
+		res, err := queryClient.OutgoingLogicCalls(r.Context(), &types.QueryOutgoingLogicCallsRequest{}, grpc.Header(&header))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if len(res.Calls) == 0 {
+			rest.WriteErrorResponse(w, http.StatusNotFound, "logic calls not found")
+			return
+		}
+
+		writeLegacyResponse(w, cliCtx, header, res.Calls)
+	}
+}
+
+// gets all the confirm messages for a given logic call
+func allLogicCallConfirmsHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		queryClient, cliCtx, ok := newQueryClient(w, r, cliCtx)
+		if !ok {
+			return
+		}
 		vars := mux.Vars(r)
-		operatorAddr := vars[bech32ValidatorAddress]
+		id, err := hex.DecodeString(vars[invalidationID])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		nonce, err := types.UInt64FromString(vars[nonce])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
 
-		res, height, err := cliCtx.Query(fmt.Sprintf("custom/%s/lastPendingBatchRequest/%s", storeName, operatorAddr))
+		var header metadata.MD
+		res, err := queryClient.LogicConfirms(r.Context(), &types.QueryLogicConfirmsRequest{
+			InvalidationId:    id,
+			InvalidationNonce: nonce,
+		}, grpc.Header(&header))
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
-		if len(res) == 0 {
-			rest.WriteErrorResponse(w, http.StatusNotFound, "no pending valset requests found")
+		if len(res.Confirms) == 0 {
+			rest.WriteErrorResponse(w, http.StatusNotFound, "logic call confirms not found")
 			return
 		}
 
-		var out types.OutgoingTxBatch
-		cliCtx.JSONCodec.MustUnmarshalJSON(res, &out)
-		rest.PostProcessResponse(w, cliCtx.WithHeight(height), res)
+		writeLegacyResponse(w, cliCtx, header, res.Confirms)
 	}
 }
 
-func currentValsetHandler(cliCtx client.Context, storeName string) http.HandlerFunc {
+// attestationsHandler has no amino JSON predecessor, attestations carry their claim as an Any so the
+// response is written in the proto JSON the gRPC gateway returns
+func attestationsHandler(cliCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		res, height, err := cliCtx.Query(fmt.Sprintf("custom/%s/currentValset", storeName))
+		queryClient, cliCtx, ok := newQueryClient(w, r, cliCtx)
+		if !ok {
+			return
+		}
+		var limit uint64
+		if v := r.FormValue("limit"); v != "" {
+			parsed, err := strconv.ParseUint(v, 10, 64)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			limit = parsed
+		}
+
+		var header metadata.MD
+		res, err := queryClient.GetAttestations(r.Context(), &types.QueryAttestationsRequest{Limit: limit}, grpc.Header(&header))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		bz, err := cliCtx.JSONCodec.MarshalJSON(res)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
-		var out types.Valset
-		cliCtx.JSONCodec.MustUnmarshalJSON(res, &out)
-		rest.PostProcessResponse(w, cliCtx.WithHeight(height), res)
+
+		rest.PostProcessResponse(w, cliCtx.WithHeight(responseHeight(header)), bz)
 	}
 }
 
-func denomToERC20Handler(cliCtx client.Context, storeName string) http.HandlerFunc {
+func currentValsetHandler(cliCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		vars := mux.Vars(r)
-		denom := vars[denom]
+		queryClient, cliCtx, ok := newQueryClient(w, r, cliCtx)
+		if !ok {
+			return
+		}
+
+		var header metadata.MD
+		res, err := queryClient.CurrentValset(r.Context(), &types.QueryCurrentValsetRequest{}, grpc.Header(&header))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		writeLegacyResponse(w, cliCtx, header, res.Valset)
+	}
+}
+
+func denomToERC20Handler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		queryClient, cliCtx, ok := newQueryClient(w, r, cliCtx)
+		if !ok {
+			return
+		}
 
-		res, height, err := cliCtx.Query(fmt.Sprintf("custom/%s/DenomToERC20/%s", storeName, denom))
+		var header metadata.MD
+		res, err := queryClient.DenomToERC20(r.Context(), &types.QueryDenomToERC20Request{Denom: mux.Vars(r)[denom]}, grpc.Header(&header))
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
-		rest.PostProcessResponse(w, cliCtx.WithHeight(height), res)
+
+		writeLegacyResponse(w, cliCtx, header, *res)
 	}
 }
 
-func ERC20ToDenomHandler(cliCtx client.Context, storeName string) http.HandlerFunc {
+func ERC20ToDenomHandler(cliCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		vars := mux.Vars(r)
-		ERC20 := vars[tokenAddress]
+		queryClient, cliCtx, ok := newQueryClient(w, r, cliCtx)
+		if !ok {
+			return
+		}
 
-		res, height, err := cliCtx.Query(fmt.Sprintf("custom/%s/ERC20ToDenom/%s", storeName, ERC20))
+		var header metadata.MD
+		res, err := queryClient.ERC20ToDenom(r.Context(), &types.QueryERC20ToDenomRequest{Erc20: mux.Vars(r)[tokenAddress]}, grpc.Header(&header))
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
-		rest.PostProcessResponse(w, cliCtx.WithHeight(height), res)
+
+		writeLegacyResponse(w, cliCtx, header, *res)
 	}
 }
@@ -2,6 +2,7 @@ package rest
 
 import (
 	"fmt"
+	"net/http"
 
 	"github.com/cosmos/cosmos-sdk/client"
 
@@ -13,6 +14,7 @@ const (
 	tokenAddress           = "tokenAddress"
 	denom                  = "denom"
 	bech32ValidatorAddress = "bech32ValidatorAddress"
+	invalidationID         = "invalidationId"
 )
 
 // Here are the routes that are actually queried by the rust
@@ -25,6 +27,9 @@ const (
 // "gravity/signed_batches"
 
 // RegisterRoutes - Central function to define routes that get registered by the main application
+// These routes are deprecated, every one of them is served by the gRPC query service and returns what
+// the gRPC gateway route named in its Link header returns, in the amino JSON it has always returned.
+// New clients should query the gRPC gateway under /gravity/v1beta directly.
 func RegisterRoutes(cliCtx client.Context, r *mux.Router, storeName string) {
 
 	/// Valsets
@@ -32,35 +37,74 @@ func RegisterRoutes(cliCtx client.Context, r *mux.Router, storeName string) {
 	// This endpoint gets all of the validator set confirmations for a given nonce. In order to determine if a valset is complete
 	// the relayer queries the latest valsets and then compares the number of members they show versus the length of this endpoints output
 	// if they match every validator has submitted a signature and we can go forward with relaying that validator set update.
-	r.HandleFunc(fmt.Sprintf("/%s/valset_confirm/{%s}", storeName, nonce), allValsetConfirmsHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/valset_confirm/{%s}", storeName, nonce),
+		deprecated("/gravity/v1beta/confirms/{nonce}", allValsetConfirmsHandler(cliCtx))).Methods("GET")
 	// gets the latest 5 validator set requests, used heavily by the relayer. Which hits this endpoint before checking which
 	// of these last 5 have sufficient signatures to relay
-	r.HandleFunc(fmt.Sprintf("/%s/valset_requests", storeName), lastValsetRequestsHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/valset_requests", storeName),
+		deprecated("/gravity/v1beta/valset/requests", lastValsetRequestsHandler(cliCtx))).Methods("GET")
 	// Returns the last 'pending' (unsigned) validator set for a given validator address.
-	r.HandleFunc(fmt.Sprintf("/%s/pending_valset_requests/{%s}", storeName, bech32ValidatorAddress), lastValsetRequestsByAddressHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/pending_valset_requests/{%s}", storeName, bech32ValidatorAddress),
+		deprecated("/gravity/v1beta/valset/last", lastValsetRequestsByAddressHandler(cliCtx))).Methods("GET")
 	// gets valset request by nonce, used to look up a specific valset. This is needed to lookup data about the current validator set on the contract
 	// and determine what can or can not be submitted as a relayer
-	r.HandleFunc(fmt.Sprintf("/%s/valset_request/{%s}", storeName, nonce), getValsetRequestHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/valset_request/{%s}", storeName, nonce),
+		deprecated("/gravity/v1beta/valset", getValsetRequestHandler(cliCtx))).Methods("GET")
 	// Provides the current validator set with powers and eth addresses, useful to check the current validator state
 	// used to deploy the contract by the contract deployer script
-	r.HandleFunc(fmt.Sprintf("/%s/current_valset", storeName), currentValsetHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/current_valset", storeName),
+		deprecated("/gravity/v1beta/valset/current", currentValsetHandler(cliCtx))).Methods("GET")
 
 	/// Batches
 
 	// The Ethereum signer queries this endpoint and signs whatever it returns once per loop iteration
-	r.HandleFunc(fmt.Sprintf("/%s/pending_batch_requests/{%s}", storeName, bech32ValidatorAddress), lastBatchesByAddressHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/pending_batch_requests/{%s}", storeName, bech32ValidatorAddress),
+		deprecated("/gravity/v1beta/batch/{address}", lastBatchesByAddressHandler(cliCtx))).Methods("GET")
 	// Gets all outgoing batches in the batch queue, up to 100
-	r.HandleFunc(fmt.Sprintf("/%s/transaction_batches", storeName), lastBatchesHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/transaction_batches", storeName),
+		deprecated("/gravity/v1beta/batch/outgoingtx", lastBatchesHandler(cliCtx))).Methods("GET")
 	// Gets a specific batch request from the outgoing queue by denom
-	r.HandleFunc(fmt.Sprintf("/%s/transaction_batch/{%s}/{%s}", storeName, nonce, tokenAddress), batchByNonceHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/transaction_batch/{%s}/{%s}", storeName, nonce, tokenAddress),
+		deprecated("/gravity/v1beta/batch/{nonce}", batchByNonceHandler(cliCtx))).Methods("GET")
 	// This endpoint gets all of the batch confirmations for a given nonce and denom In order to determine if a batch is complete
 	// the relayer will compare the valset power on the contract to the number of signatures
-	r.HandleFunc(fmt.Sprintf("/%s/batch_confirm/{%s}/{%s}", storeName, nonce, tokenAddress), allBatchConfirmsHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/batch_confirm/{%s}/{%s}", storeName, nonce, tokenAddress),
+		deprecated("/gravity/v1beta/batch/confirms", allBatchConfirmsHandler(cliCtx))).Methods("GET")
+
+	/// Logic calls
+
+	// The Ethereum signer queries this endpoint for the next logic call it has not signed
+	r.HandleFunc(fmt.Sprintf("/%s/pending_logic_calls/{%s}", storeName, bech32ValidatorAddress),
+		deprecated("/gravity/v1beta/logic/{address}", lastLogicCallByAddressHandler(cliCtx))).Methods("GET")
+	// Gets all outgoing logic calls, up to 100
+	r.HandleFunc(fmt.Sprintf("/%s/logic_calls", storeName),
+		deprecated("/gravity/v1beta/batch/outgoinglogic", lastLogicCallsHandler(cliCtx))).Methods("GET")
+	// Gets all of the confirmations for a logic call by its hex encoded invalidation id and nonce
+	r.HandleFunc(fmt.Sprintf("/%s/logic_call_confirm/{%s}/{%s}", storeName, invalidationID, nonce),
+		deprecated("/gravity/v1beta/logic/confirms", allLogicCallConfirmsHandler(cliCtx))).Methods("GET")
+
+	/// Attestations
+
+	// Gets the most recent attestations, the limit query parameter caps how many
+	r.HandleFunc(fmt.Sprintf("/%s/attestations", storeName),
+		deprecated("/gravity/v1beta/query_attestations", attestationsHandler(cliCtx))).Methods("GET")
 
 	/// Cosmos originated assets
 
 	// This handler lets you retrieve the ERC20 contract corresponding to a given denom
-	r.HandleFunc(fmt.Sprintf("/%s/denom_to_erc20/{%s}", storeName, denom), denomToERC20Handler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/denom_to_erc20/{%s}", storeName, denom),
+		deprecated("/gravity/v1beta/cosmos_originated/denom_to_erc20", denomToERC20Handler(cliCtx))).Methods("GET")
 	// This handler lets you retrieve the denom corresponding to a given ERC20 contract
-	r.HandleFunc(fmt.Sprintf("/%s/erc20_to_denom/{%s}", storeName, tokenAddress), ERC20ToDenomHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/erc20_to_denom/{%s}", storeName, tokenAddress),
+		deprecated("/gravity/v1beta/cosmos_originated/erc20_to_denom", ERC20ToDenomHandler(cliCtx))).Methods("GET")
+}
+
+// deprecated marks the response of a legacy route as deprecated and links the gRPC gateway
+// route that replaces it
+func deprecated(gatewayPath string, handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Deprecation", "true")
+		w.Header().Set("Link", fmt.Sprintf("<%s>; rel=\"successor-version\"", gatewayPath))
+		handler(w, r)
+	}
 }
@@ -1,100 +1,232 @@
 package rest
 
 import (
-	"fmt"
 	"net/http"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
-	hexUtil "github.com/ethereum/go-ethereum/common/hexutil"
-	ethCrypto "github.com/ethereum/go-ethereum/crypto"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
 )
 
-type valsetConfirmReq struct {
-	BaseReq    rest.BaseReq `json:"base_req"`
-	EthAddress string       `json:"eth_address"`
-	Nonce      string       `json:"nonce"`
-	EthSig     string       `json:"ethSig"`
+type clearBridgeHijackProposalReq struct {
+	BaseReq     rest.BaseReq   `json:"base_req"`
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	Proposer    sdk.AccAddress `json:"proposer"`
+	Deposit     sdk.Coins      `json:"deposit"`
 }
 
-// check the ethereum sig on a particular valset and broadcast a transaction containing
-// it if correct. The nonce / block height is used to determine what valset to look up
-// locally and verify
-func createValsetConfirmHandler(cliCtx client.Context, storeKey string) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		var req valsetConfirmReq
+// ClearBridgeHijackProposalRESTHandler returns the REST handler for submitting a ClearBridgeHijackProposal
+func ClearBridgeHijackProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "clear_bridge_hijack",
+		Handler:  postClearBridgeHijackProposalHandler(cliCtx),
+	}
+}
 
+func postClearBridgeHijackProposalHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req clearBridgeHijackProposalReq
 		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
 			return
 		}
 
-		baseReq := req.BaseReq.Sanitize()
-		if !baseReq.ValidateBasic(w) {
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewClearBridgeHijackProposal(req.Title, req.Description)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, msg)
+	}
+}
+
+type releaseFailedDepositProposalReq struct {
+	BaseReq     rest.BaseReq   `json:"base_req"`
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	EventNonce  uint64         `json:"event_nonce"`
+	Recipient   string         `json:"recipient"`
+	Proposer    sdk.AccAddress `json:"proposer"`
+	Deposit     sdk.Coins      `json:"deposit"`
+}
+
+// ReleaseFailedDepositProposalRESTHandler returns the REST handler for submitting a ReleaseFailedDepositProposal
+func ReleaseFailedDepositProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "release_failed_deposit",
+		Handler:  postReleaseFailedDepositProposalHandler(cliCtx),
+	}
+}
+
+func postReleaseFailedDepositProposalHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req releaseFailedDepositProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
 			return
 		}
 
-		ethAddr, err := types.NewEthAddress(req.EthAddress)
-		if err != nil {
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
 			return
 		}
 
-		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/valsetRequest/%s", storeKey, req.Nonce), nil)
-		if err != nil {
-			fmt.Printf("could not get valset")
-			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+		content := types.NewReleaseFailedDepositProposal(req.Title, req.Description, req.EventNonce, req.Recipient)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
 			return
 		}
-		var valset types.Valset
-		cliCtx.JSONCodec.MustUnmarshalJSON(res, &valset)
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, msg)
+	}
+}
 
-		// TODO: fix this, need to fetch the gravityID from params here
-		checkpoint := valset.GetCheckpoint("fetch-gravity-id-from-params-please-this-should-panic")
+type updateBlocklistProposalReq struct {
+	BaseReq     rest.BaseReq   `json:"base_req"`
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	Blocked     []string       `json:"blocked"`
+	Unblocked   []string       `json:"unblocked"`
+	Proposer    sdk.AccAddress `json:"proposer"`
+	Deposit     sdk.Coins      `json:"deposit"`
+}
+
+// UpdateBlocklistProposalRESTHandler returns the REST handler for submitting a UpdateBlocklistProposal
+func UpdateBlocklistProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "update_blocklist",
+		Handler:  postUpdateBlocklistProposalHandler(cliCtx),
+	}
+}
+
+func postUpdateBlocklistProposalHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req updateBlocklistProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			return
+		}
 
-		// the signed message should be the hash of the checkpoint at the given nonce
-		ethHash := ethCrypto.Keccak256Hash(checkpoint)
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
 
-		ethSig, err := hexUtil.Decode(req.EthSig)
-		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		content := types.NewUpdateBlocklistProposal(req.Title, req.Description, req.Blocked, req.Unblocked)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
 			return
 		}
-		ethPubkey, err := ethCrypto.SigToPub(ethHash.Bytes(), ethSig)
-		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, msg)
+	}
+}
+
+type releaseQuarantinedDepositProposalReq struct {
+	BaseReq     rest.BaseReq   `json:"base_req"`
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	EventNonce  uint64         `json:"event_nonce"`
+	Recipient   string         `json:"recipient"`
+	Proposer    sdk.AccAddress `json:"proposer"`
+	Deposit     sdk.Coins      `json:"deposit"`
+}
+
+// ReleaseQuarantinedDepositProposalRESTHandler returns the REST handler for submitting a
+// ReleaseQuarantinedDepositProposal
+func ReleaseQuarantinedDepositProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "release_quarantined_deposit",
+		Handler:  postReleaseQuarantinedDepositProposalHandler(cliCtx),
+	}
+}
+
+func postReleaseQuarantinedDepositProposalHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req releaseQuarantinedDepositProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
 			return
 		}
-		ethPubkeyBytes := ethCrypto.FromECDSAPub(ethPubkey)
 
-		correct := ethCrypto.VerifySignature(ethPubkeyBytes, ethHash.Bytes(), ethSig)
-		if !correct {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
 			return
 		}
 
-		cosmosAddr := cliCtx.GetFromAddress()
-		msg := types.NewMsgValsetConfirm(valset.Nonce, *ethAddr, cosmosAddr, req.EthSig)
-		err = msg.ValidateBasic()
-		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+		content := types.NewReleaseQuarantinedDepositProposal(req.Title, req.Description, req.EventNonce, req.Recipient)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
 			return
 		}
 
-		tx.WriteGeneratedTxResponse(cliCtx, w, baseReq, msg)
+		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, msg)
+	}
+}
+
+type setERC20MetadataProposalReq struct {
+	BaseReq     rest.BaseReq   `json:"base_req"`
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	Erc20       string         `json:"erc20"`
+	Name        string         `json:"name"`
+	Symbol      string         `json:"symbol"`
+	Decimals    uint32         `json:"decimals"`
+	Display     string         `json:"display"`
+	Aliases     []string       `json:"aliases"`
+	Proposer    sdk.AccAddress `json:"proposer"`
+	Deposit     sdk.Coins      `json:"deposit"`
+}
+
+// SetERC20MetadataProposalRESTHandler returns the REST handler for submitting a SetERC20MetadataProposal
+func SetERC20MetadataProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "set_erc20_metadata",
+		Handler:  postSetERC20MetadataProposalHandler(cliCtx),
 	}
 }
 
-type bootstrapConfirmReq struct {
-	BaseReq               rest.BaseReq           `json:"base_req"`
-	Orchestrator          sdk.AccAddress         `json:"orchestrator"`
-	EthereumChainID       uint64                 `json:"ethereum_chain_id"`
-	BridgeContractAddress string                 `json:"bridge_contract_address"`
-	Block                 string                 `json:"block"`
-	BridgeValidators      types.BridgeValidators `json:"bridge_validators"`
-	GravityID             string                 `json:"gravity_id"`
-	StartThreshold        uint64                 `json:"start_threshold"`
+func postSetERC20MetadataProposalHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req setERC20MetadataProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewSetERC20MetadataProposal(req.Title, req.Description, req.Erc20, req.Name, req.Symbol, req.Decimals, req.Display, req.Aliases)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, msg)
+	}
 }
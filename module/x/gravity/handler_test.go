@@ -432,3 +432,185 @@ func TestMsgSetOrchestratorAddresses(t *testing.T) {
 	_, err = h(ctx, msg)
 	require.Error(t, err)
 }
+
+//nolint: exhaustivestruct
+func TestMsgValsetUpdatedClaimHijack(t *testing.T) {
+	var (
+		orchestratorAddr1, _ = sdk.AccAddressFromBech32("cosmos1dg55rtevlfxh46w88yjpdd08sqhh5cc3xhkcej")
+		orchestratorAddr2, _ = sdk.AccAddressFromBech32("cosmos164knshrzuuurf05qxf3q5ewpfnwzl4gj4m4dfy")
+		orchestratorAddr3, _ = sdk.AccAddressFromBech32("cosmos193fw83ynn76328pty4yl7473vg9x86alq2cft7")
+		validatorEthAddr1, _ = types.NewEthAddress("0x0000000000000000000000000000000000000001")
+		validatorEthAddr2, _ = types.NewEthAddress("0x0000000000000000000000000000000000000002")
+		validatorEthAddr3, _ = types.NewEthAddress("0x0000000000000000000000000000000000000003")
+		attackerEthAddr      = "0x00000000000000000000000000000000000000aa"
+		myCosmosAddr, _      = sdk.AccAddressFromBech32("cosmos16ahjkfqxpp6lvfy9fpfnfjg39xr96qett0alj5")
+		valAddr1             = sdk.ValAddress(orchestratorAddr1)
+		valAddr2             = sdk.ValAddress(orchestratorAddr2)
+		valAddr3             = sdk.ValAddress(orchestratorAddr3)
+		orchestrators        = []sdk.AccAddress{orchestratorAddr1, orchestratorAddr2, orchestratorAddr3}
+		anyETHAddr           = "0xf9613b532673Cc223aBa451dFA8539B87e1F666D"
+		tokenETHAddr         = "0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e"
+		myBlockTime          = time.Date(2020, 9, 14, 15, 20, 10, 0, time.UTC)
+	)
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context.WithBlockTime(myBlockTime)
+	input.GravityKeeper.StakingKeeper = keeper.NewStakingKeeperMock(valAddr1, valAddr2, valAddr3)
+	input.GravityKeeper.SetEthAddressForValidator(ctx, valAddr1, *validatorEthAddr1)
+	input.GravityKeeper.SetEthAddressForValidator(ctx, valAddr2, *validatorEthAddr2)
+	input.GravityKeeper.SetEthAddressForValidator(ctx, valAddr3, *validatorEthAddr3)
+	for i, orch := range orchestrators {
+		input.GravityKeeper.SetOrchestratorValidator(ctx, []sdk.ValAddress{valAddr1, valAddr2, valAddr3}[i], orch)
+		input.GravityKeeper.SetStaticValCosmosAddr(ctx, orch.String())
+	}
+	h := NewHandler(input.GravityKeeper)
+
+	requested := []*types.BridgeValidator{
+		{Power: 1431655765, EthereumAddress: validatorEthAddr1.GetAddress()},
+		{Power: 1431655765, EthereumAddress: validatorEthAddr2.GetAddress()},
+		{Power: 1431655765, EthereumAddress: validatorEthAddr3.GetAddress()},
+	}
+	input.GravityKeeper.StoreValset(ctx, &types.Valset{
+		Nonce:        1,
+		Members:      requested,
+		RewardAmount: sdk.ZeroInt(),
+		RewardToken:  types.ZeroAddressString,
+	})
+
+	// when the bridge reports a valset update at nonce 1 controlled by someone else
+	hijacked := []*types.BridgeValidator{{Power: 4294967295, EthereumAddress: attackerEthAddr}}
+	for _, orch := range orchestrators {
+		_, err := h(ctx, &types.MsgValsetUpdatedClaim{
+			EventNonce:   1,
+			ValsetNonce:  1,
+			BlockHeight:  10,
+			Members:      hijacked,
+			RewardAmount: sdk.ZeroInt(),
+			RewardToken:  types.ZeroAddressString,
+			Orchestrator: orch.String(),
+		})
+		require.NoError(t, err)
+	}
+	EndBlocker(ctx, input.GravityKeeper)
+
+	// then the incident is recorded and the foreign valset is not accepted
+	require.True(t, input.GravityKeeper.IsBridgePaused(ctx))
+	incident := input.GravityKeeper.GetBridgeHijackIncident(ctx, 1)
+	require.NotNil(t, incident)
+	assert.Equal(t, uint64(1), incident.EventNonce)
+	assert.Equal(t, types.BridgeValidators(requested), types.BridgeValidators(incident.ExpectedMembers))
+	assert.Equal(t, types.BridgeValidators(hijacked), types.BridgeValidators(incident.ObservedMembers))
+	assert.Nil(t, input.GravityKeeper.GetLastObservedValset(ctx))
+
+	// and sending to Ethereum is rejected
+	_, err := h(ctx, &types.MsgSendToEth{
+		Sender:    myCosmosAddr.String(),
+		EthDest:   anyETHAddr,
+		Amount:    sdk.NewInt64Coin("gravity"+tokenETHAddr, 100),
+		BridgeFee: sdk.NewInt64Coin("gravity"+tokenETHAddr, 10),
+	})
+	require.ErrorIs(t, err, types.ErrBridgePaused)
+
+	// and deposits are held back
+	for _, orch := range orchestrators {
+		_, err := h(ctx, &types.MsgSendToCosmosClaim{
+			EventNonce:     2,
+			BlockHeight:    11,
+			TokenContract:  tokenETHAddr,
+			Amount:         sdk.NewInt(12),
+			EthereumSender: anyETHAddr,
+			CosmosReceiver: myCosmosAddr.String(),
+			Orchestrator:   orch.String(),
+		})
+		require.NoError(t, err)
+	}
+	EndBlocker(ctx, input.GravityKeeper)
+	assert.Equal(t, uint64(1), input.GravityKeeper.GetLastObservedEventNonce(ctx))
+	assert.True(t, input.BankKeeper.GetAllBalances(ctx, myCosmosAddr).IsZero())
+
+	// when governance clears the incident
+	proposalHandler := NewGravityProposalHandler(input.GravityKeeper)
+	require.NoError(t, proposalHandler(ctx, types.NewClearBridgeHijackProposal("resume", "bridge is safe again")))
+	require.False(t, input.GravityKeeper.IsBridgePaused(ctx))
+	require.Error(t, proposalHandler(ctx, types.NewClearBridgeHijackProposal("resume", "bridge is safe again")))
+
+	// then the held back deposit goes through
+	EndBlocker(ctx, input.GravityKeeper)
+	assert.Equal(t, uint64(2), input.GravityKeeper.GetLastObservedEventNonce(ctx))
+	assert.Equal(t, sdk.Coins{sdk.NewInt64Coin("gravity"+tokenETHAddr, 12)}, input.BankKeeper.GetAllBalances(ctx, myCosmosAddr))
+}
+
+func TestMsgSendToCosmosClaimConflicting(t *testing.T) {
+	var (
+		orchestratorAddr1, _ = sdk.AccAddressFromBech32("cosmos1dg55rtevlfxh46w88yjpdd08sqhh5cc3xhkcej")
+		orchestratorAddr2, _ = sdk.AccAddressFromBech32("cosmos164knshrzuuurf05qxf3q5ewpfnwzl4gj4m4dfy")
+		orchestratorAddr3, _ = sdk.AccAddressFromBech32("cosmos193fw83ynn76328pty4yl7473vg9x86alq2cft7")
+		orchestratorAddr4, _ = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		valAddrs             = []sdk.ValAddress{sdk.ValAddress(orchestratorAddr1), sdk.ValAddress(orchestratorAddr2), sdk.ValAddress(orchestratorAddr3), sdk.ValAddress(orchestratorAddr4)}
+		orchestrators        = []sdk.AccAddress{orchestratorAddr1, orchestratorAddr2, orchestratorAddr3, orchestratorAddr4}
+		myCosmosAddr, _      = sdk.AccAddressFromBech32("cosmos16ahjkfqxpp6lvfy9fpfnfjg39xr96qett0alj5")
+		anyETHAddr           = "0xf9613b532673Cc223aBa451dFA8539B87e1F666D"
+		tokenETHAddr         = "0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e"
+		myBlockTime          = time.Date(2020, 9, 14, 15, 20, 10, 0, time.UTC)
+	)
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context.WithBlockTime(myBlockTime)
+	input.GravityKeeper.StakingKeeper = keeper.NewStakingKeeperMock(valAddrs...)
+	for i, orch := range orchestrators {
+		ethAddr, err := types.NewEthAddress(keeper.EthAddrs[i].String())
+		require.NoError(t, err)
+		input.GravityKeeper.SetEthAddressForValidator(ctx, valAddrs[i], *ethAddr)
+		input.GravityKeeper.SetOrchestratorValidator(ctx, valAddrs[i], orch)
+		input.GravityKeeper.SetStaticValCosmosAddr(ctx, orch.String())
+	}
+	h := NewHandler(input.GravityKeeper)
+
+	deposit := func(orch sdk.AccAddress, amount int64) {
+		_, err := h(ctx, &types.MsgSendToCosmosClaim{
+			EventNonce:     1,
+			BlockHeight:    10,
+			TokenContract:  tokenETHAddr,
+			Amount:         sdk.NewInt(amount),
+			EthereumSender: anyETHAddr,
+			CosmosReceiver: myCosmosAddr.String(),
+			Orchestrator:   orch.String(),
+		})
+		require.NoError(t, err)
+	}
+
+	// when three validators agree and one reports a different amount
+	deposit(orchestratorAddr1, 12)
+	deposit(orchestratorAddr4, 1200)
+	EndBlocker(ctx, input.GravityKeeper)
+	require.Empty(t, input.GravityKeeper.GetConflictingClaims(ctx))
+
+	deposit(orchestratorAddr2, 12)
+	deposit(orchestratorAddr3, 12)
+	EndBlocker(ctx, input.GravityKeeper)
+
+	// then the honest claim is observed and the dissenting validator is recorded
+	assert.Equal(t, uint64(1), input.GravityKeeper.GetLastObservedEventNonce(ctx))
+	assert.Equal(t, sdk.Coins{sdk.NewInt64Coin("gravity"+tokenETHAddr, 12)}, input.BankKeeper.GetAllBalances(ctx, myCosmosAddr))
+	conflicts := input.GravityKeeper.GetConflictingClaimsByNonce(ctx, 1)
+	require.Len(t, conflicts, 1)
+	assert.Equal(t, valAddrs[3].String(), conflicts[0].Validator)
+	assert.NotEqual(t, conflicts[0].ClaimHash, conflicts[0].ObservedClaimHash)
+
+	var emitted int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeConflictingClaim {
+			emitted++
+		}
+	}
+	assert.Equal(t, 1, emitted)
+
+	// and the record is neither duplicated nor emitted again on later tallies
+	EndBlocker(ctx, input.GravityKeeper)
+	require.Len(t, input.GravityKeeper.GetConflictingClaims(ctx), 1)
+	emitted = 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeConflictingClaim {
+			emitted++
+		}
+	}
+	assert.Equal(t, 1, emitted)
+}
@@ -7,6 +7,7 @@ import (
 	"strconv"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -42,22 +43,31 @@ func (k Keeper) Attest(
 	}
 	att := k.GetAttestation(ctx, claim.GetEventNonce(), hash)
 
-	// If it does not exist, create a new one.
+	// If it does not exist, create a new one and snapshot the total power it is tallied against.
 	if att == nil {
 		att = &types.Attestation{
-			Observed: false,
-			Votes:    []string{},
-			Height:   uint64(ctx.BlockHeight()),
-			Claim:    anyClaim,
+			Observed:   false,
+			Votes:      []string{},
+			Height:     uint64(ctx.BlockHeight()),
+			Claim:      anyClaim,
+			VotePowers: []uint64{},
+			TotalPower: k.getStaticTotalPower(ctx),
 		}
 	}
 
-	// Add the validator's vote to this attestation
+	// Add the validator's vote to this attestation along with its current power
 	att.Votes = append(att.Votes, valAddr.String())
+	att.VotePowers = append(att.VotePowers, k.getStaticValidatorPower(ctx, valAddr))
 
 	k.SetAttestation(ctx, claim.GetEventNonce(), hash, att)
 	k.setLastEventNonceByValidator(ctx, valAddr, claim.GetEventNonce())
 
+	// The end blocker only checks each nonce for conflicting claims once, when it is observed,
+	// so a late vote at a nonce it already checked is checked here
+	if claim.GetEventNonce() <= k.GetLastConflictCheckedNonce(ctx) {
+		k.flagConflictingVote(ctx, claim.GetEventNonce(), hash, valAddr)
+	}
+
 	return att, nil
 }
 
@@ -76,39 +86,29 @@ func (k Keeper) TryAttestation(ctx sdk.Context, att *types.Attestation) {
 	// If the attestation has not yet been Observed, sum up the votes and see if it is ready to apply to the state.
 	// This conditional stops the attestation from accidentally being applied twice.
 	if !att.Observed {
-		// Sum the current powers of all validators who have voted and see if it passes the current threshold
-		// TODO: The different integer types and math here needs a careful review
-		// totalPower := k.StakingKeeper.GetLastTotalPower(ctx)
-
-		validators := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
-		staticValOperAddrsMap := k.GetStaticValOperAddrsAsMap(ctx)
-
-		var staticTotalPower uint64 = 0
-		for _, validator := range validators {
-			// ctx.Logger().Error("Debug Attesation", "validator.OperatorAddress", validator.OperatorAddress)
-			if _, found := staticValOperAddrsMap[validator.OperatorAddress]; !found {
-				// ctx.Logger().Error("Debug Attesation", "Skipped validator.OperatorAddress", validator.OperatorAddress)
-				continue
-			}
-
-			// ctx.Logger().Error("Debug Attesation", "Static validator.OperatorAddress", validator.OperatorAddress)
-			val := validator.GetOperator()
-			p := uint64(k.StakingKeeper.GetLastValidatorPower(ctx, val))
-			staticTotalPower += p
+		// Sum the powers recorded for each vote at the time it was cast and see if it passes the threshold
+		// computed from the static set power snapshot taken when the attestation was created. Attestations
+		// stored before the snapshots were recorded fall back to the current powers.
+		totalPower := sdk.NewIntFromUint64(att.TotalPower)
+		if att.TotalPower == 0 {
+			totalPower = sdk.NewIntFromUint64(k.getStaticTotalPower(ctx))
 		}
-		// ctx.Logger().Error("Debug Attesation", "staticTotalPower", staticTotalPower)
-		totalPower := sdk.NewIntFromUint64(staticTotalPower)
 
 		requiredPower := types.AttestationVotesPowerThreshold.Mul(totalPower).Quo(sdk.NewInt(100))
 		attestationPower := sdk.NewInt(0)
-		for _, validator := range att.Votes {
+		for i, validator := range att.Votes {
 			val, err := sdk.ValAddressFromBech32(validator)
 			if err != nil {
 				panic(err)
 			}
-			validatorPower := k.StakingKeeper.GetLastValidatorPower(ctx, val)
+			var validatorPower uint64
+			if i < len(att.VotePowers) {
+				validatorPower = att.VotePowers[i]
+			} else {
+				validatorPower = k.getStaticValidatorPower(ctx, val)
+			}
 			// Add it to the attestation power's sum
-			attestationPower = attestationPower.Add(sdk.NewInt(validatorPower))
+			attestationPower = attestationPower.Add(sdk.NewIntFromUint64(validatorPower))
 			// If the power of all the validators that have voted on the attestation is higher or equal to the threshold,
 			// process the attestation, set Observed to true, and break
 			if attestationPower.GTE(requiredPower) {
@@ -119,13 +119,17 @@ func (k Keeper) TryAttestation(ctx sdk.Context, att *types.Attestation) {
 					panic("attempting to apply events to state out of order")
 				}
 				k.setLastObservedEventNonce(ctx, claim.GetEventNonce())
-				k.SetLastObservedEthereumBlockHeight(ctx, claim.GetBlockHeight())
+				// the height votes of the orchestrators may already have moved past the block of this event
+				if claim.GetBlockHeight() > k.GetLastObservedEthereumBlockHeight(ctx).EthereumBlockHeight {
+					k.SetLastObservedEthereumBlockHeight(ctx, claim.GetBlockHeight())
+				}
 
 				att.Observed = true
 				k.SetAttestation(ctx, claim.GetEventNonce(), hash, att)
 
 				k.processAttestation(ctx, att, claim)
 				k.emitObservedEvent(ctx, att, claim)
+				k.recordAttestationObserved(ctx, att)
 
 				break
 			}
@@ -136,6 +140,30 @@ func (k Keeper) TryAttestation(ctx sdk.Context, att *types.Attestation) {
 	}
 }
 
+// getStaticTotalPower returns the summed power of all bonded validators in the static validator set
+func (k Keeper) getStaticTotalPower(ctx sdk.Context) uint64 {
+	validators := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
+	staticValOperAddrsMap := k.GetStaticValOperAddrsAsMap(ctx)
+
+	var staticTotalPower uint64 = 0
+	for _, validator := range validators {
+		if _, found := staticValOperAddrsMap[validator.OperatorAddress]; !found {
+			continue
+		}
+		staticTotalPower += uint64(k.StakingKeeper.GetLastValidatorPower(ctx, validator.GetOperator()))
+	}
+	return staticTotalPower
+}
+
+// getStaticValidatorPower returns the power a validator's vote carries right now, validators outside
+// the static validator set carry none since they are not counted in the total either
+func (k Keeper) getStaticValidatorPower(ctx sdk.Context, val sdk.ValAddress) uint64 {
+	if !k.IsStaticValByValAddress(ctx, val) {
+		return 0
+	}
+	return uint64(k.StakingKeeper.GetLastValidatorPower(ctx, val))
+}
+
 // processAttestation actually applies the attestation to the consensus state
 func (k Keeper) processAttestation(ctx sdk.Context, att *types.Attestation, claim types.EthereumClaim) {
 	hash, err := claim.ClaimHash()
@@ -156,6 +184,8 @@ func (k Keeper) processAttestation(ctx sdk.Context, att *types.Attestation, clai
 		)
 	} else {
 		commit() // persist transient storage
+		// the cache context has its own event manager, pass on the events of the applied attestation
+		ctx.EventManager().EmitEvents(xCtx.EventManager().Events())
 	}
 }
 
@@ -169,10 +199,9 @@ func (k Keeper) emitObservedEvent(ctx sdk.Context, att *types.Attestation, claim
 	observationEvent := sdk.NewEvent(
 		types.EventTypeObservation,
 		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-		sdk.NewAttribute(types.AttributeKeyAttestationType, string(claim.GetType())),
+		sdk.NewAttribute(types.AttributeKeyAttestationType, claim.GetType().String()),
 		sdk.NewAttribute(types.AttributeKeyContract, k.GetBridgeContractAddress(ctx).GetAddress()),
 		sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(k.GetBridgeChainID(ctx)))),
-		// todo: serialize with hex/ base64 ?
 		sdk.NewAttribute(types.AttributeKeyAttestationID,
 			hex.EncodeToString(types.GetAttestationKey(claim.GetEventNonce(), hash))),
 		sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(claim.GetEventNonce())),
@@ -180,18 +209,28 @@ func (k Keeper) emitObservedEvent(ctx sdk.Context, att *types.Attestation, claim
 	)
 
 	ctx.EventManager().EmitEvent(observationEvent)
+	k.emitTypedEvent(ctx, &types.EventAttestationObserved{
+		ClaimType:      claim.GetType(),
+		EventNonce:     claim.GetEventNonce(),
+		BlockHeight:    claim.GetBlockHeight(),
+		ClaimHash:      hex.EncodeToString(hash),
+		AttestationId:  hex.EncodeToString(types.GetAttestationKey(claim.GetEventNonce(), hash)),
+		Votes:          att.Votes,
+		BridgeContract: k.GetBridgeContractAddress(ctx).GetAddress(),
+		BridgeChainId:  k.GetBridgeChainID(ctx),
+	})
 }
 
 // SetAttestation sets the attestation in the store
 func (k Keeper) SetAttestation(ctx sdk.Context, eventNonce uint64, claimHash []byte, att *types.Attestation) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	aKey := types.GetAttestationKey(eventNonce, claimHash)
 	store.Set(aKey, k.cdc.MustMarshal(att))
 }
 
 // GetAttestation return an attestation given a nonce
 func (k Keeper) GetAttestation(ctx sdk.Context, eventNonce uint64, claimHash []byte) *types.Attestation {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	aKey := types.GetAttestationKey(eventNonce, claimHash)
 	bz := store.Get(aKey)
 	if len(bz) == 0 {
@@ -212,7 +251,7 @@ func (k Keeper) DeleteAttestation(ctx sdk.Context, att types.Attestation) {
 	if err != nil {
 		panic(sdkerrors.Wrap(err, "unable to compute claim hash"))
 	}
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 
 	store.Delete(types.GetAttestationKey(claim.GetEventNonce(), hash))
 }
@@ -238,7 +277,7 @@ func (k Keeper) GetAttestationMapping(ctx sdk.Context) (out map[uint64][]types.A
 
 // IterateAttestaions iterates through all attestations
 func (k Keeper) IterateAttestaions(ctx sdk.Context, cb func([]byte, types.Attestation) bool) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	prefix := types.OracleAttestationKey
 	iter := store.Iterator(prefixRange(prefix))
 	defer iter.Close()
@@ -297,7 +336,7 @@ func (k Keeper) GetMostRecentAttestations(ctx sdk.Context, limit uint64) []*type
 
 // GetLastObservedEventNonce returns the latest observed event nonce
 func (k Keeper) GetLastObservedEventNonce(ctx sdk.Context) uint64 {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	bytes := store.Get(types.LastObservedEventNonceKey)
 
 	if len(bytes) == 0 {
@@ -309,7 +348,7 @@ func (k Keeper) GetLastObservedEventNonce(ctx sdk.Context) uint64 {
 // GetLastObservedEthereumBlockHeight height gets the block height to of the last observed attestation from
 // the store
 func (k Keeper) GetLastObservedEthereumBlockHeight(ctx sdk.Context) types.LastObservedEthereumBlockHeight {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	bytes := store.Get(types.LastObservedEthereumBlockHeightKey)
 
 	if len(bytes) == 0 {
@@ -330,7 +369,7 @@ func (k Keeper) GetLastObservedEthereumBlockHeight(ctx sdk.Context) types.LastOb
 
 // SetLastObservedEthereumBlockHeight sets the block height in the store.
 func (k Keeper) SetLastObservedEthereumBlockHeight(ctx sdk.Context, ethereumHeight uint64) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	height := types.LastObservedEthereumBlockHeight{
 		EthereumBlockHeight: ethereumHeight,
 		CosmosBlockHeight:   uint64(ctx.BlockHeight()),
@@ -339,12 +378,18 @@ func (k Keeper) SetLastObservedEthereumBlockHeight(ctx sdk.Context, ethereumHeig
 	store.Set(types.LastObservedEthereumBlockHeightKey, k.cdc.MustMarshal(&height))
 }
 
+// SetLastObservedEthereumBlockHeightUnsafe sets the last observed heights as given, it is used to restore them from genesis
+func (k Keeper) SetLastObservedEthereumBlockHeightUnsafe(ctx sdk.Context, height types.LastObservedEthereumBlockHeight) {
+	store := k.store(ctx)
+	store.Set(types.LastObservedEthereumBlockHeightKey, k.cdc.MustMarshal(&height))
+}
+
 // GetLastObservedValset retrieves the last observed validator set from the store
 // WARNING: This value is not an up to date validator set on Ethereum, it is a validator set
 // that AT ONE POINT was the one in the Gravity bridge on Ethereum. If you assume that it's up
 // to date you may break the bridge
 func (k Keeper) GetLastObservedValset(ctx sdk.Context) *types.Valset {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	bytes := store.Get(types.LastObservedValsetKey)
 
 	if len(bytes) == 0 {
@@ -363,19 +408,19 @@ func (k Keeper) GetLastObservedValset(ctx sdk.Context) *types.Valset {
 
 // SetLastObservedValset updates the last observed validator set in the store
 func (k Keeper) SetLastObservedValset(ctx sdk.Context, valset types.Valset) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	store.Set(types.LastObservedValsetKey, k.cdc.MustMarshal(&valset))
 }
 
 // setLastObservedEventNonce sets the latest observed event nonce
 func (k Keeper) setLastObservedEventNonce(ctx sdk.Context, nonce uint64) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	store.Set(types.LastObservedEventNonceKey, types.UInt64Bytes(nonce))
 }
 
 // GetLastEventNonceByValidator returns the latest event nonce for a given validator
 func (k Keeper) GetLastEventNonceByValidator(ctx sdk.Context, validator sdk.ValAddress) uint64 {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	bytes := store.Get(types.GetLastEventNonceByValidatorKey(validator))
 
 	if len(bytes) == 0 {
@@ -395,6 +440,44 @@ func (k Keeper) GetLastEventNonceByValidator(ctx sdk.Context, validator sdk.ValA
 
 // setLastEventNonceByValidator sets the latest event nonce for a give validator
 func (k Keeper) setLastEventNonceByValidator(ctx sdk.Context, validator sdk.ValAddress, nonce uint64) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	store.Set(types.GetLastEventNonceByValidatorKey(validator), types.UInt64Bytes(nonce))
 }
+
+// GetLastEventNoncesByValidator returns the stored last event nonce of every validator that has submitted a claim
+func (k Keeper) GetLastEventNoncesByValidator(ctx sdk.Context) (out []*types.ValidatorEventNonce) {
+	prefixStore := prefix.NewStore(k.store(ctx), types.LastEventNonceByValidatorKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		out = append(out, &types.ValidatorEventNonce{
+			Validator:  sdk.ValAddress(iter.Key()).String(),
+			EventNonce: types.UInt64FromBytes(iter.Value()),
+		})
+	}
+	return
+}
+
+// HasLastEventNonceByValidator returns true once a validator has submitted its first claim, unlike
+// GetLastEventNonceByValidator which falls back to a nonce derived from the last observed one
+func (k Keeper) HasLastEventNonceByValidator(ctx sdk.Context, validator sdk.ValAddress) bool {
+	store := k.store(ctx)
+	return store.Has(types.GetLastEventNonceByValidatorKey(validator))
+}
+
+// SetLastSlashedClaimNonce sets the latest event nonce validators were slashed for not submitting a claim on
+func (k Keeper) SetLastSlashedClaimNonce(ctx sdk.Context, nonce uint64) {
+	store := k.store(ctx)
+	store.Set(types.LastSlashedClaimNonce, types.UInt64Bytes(nonce))
+}
+
+// GetLastSlashedClaimNonce returns the latest event nonce validators were slashed for not submitting a claim on
+func (k Keeper) GetLastSlashedClaimNonce(ctx sdk.Context) uint64 {
+	store := k.store(ctx)
+	bytes := store.Get(types.LastSlashedClaimNonce)
+
+	if len(bytes) == 0 {
+		return 0
+	}
+	return types.UInt64FromBytes(bytes)
+}
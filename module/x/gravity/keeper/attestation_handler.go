@@ -26,43 +26,78 @@ func (a AttestationHandler) Handle(ctx sdk.Context, att types.Attestation, claim
 		}
 		// Check if coin is Cosmos-originated asset and get denom
 		isCosmosOriginated, denom := a.keeper.ERC20ToDenomLookup(ctx, *tokenAddress)
+		receipt := types.DepositReceipt{
+			EventNonce:     claim.EventNonce,
+			EthereumHeight: claim.BlockHeight,
+			TokenContract:  tokenAddress.GetAddress(),
+			Amount:         sdk.NewCoin(denom, claim.Amount),
+			EthereumSender: claim.EthereumSender,
+			CosmosReceiver: claim.CosmosReceiver,
+			Height:         ctx.BlockHeight(),
+		}
 
+		// a deposit of Cosmos originated coins beyond the ones locked for the chain would unlock the coins of
+		// the other chains, it is held in escrow and only governance can release it
 		if isCosmosOriginated {
-			// If it is cosmos originated, unlock the coins
-			coins := sdk.Coins{sdk.NewCoin(denom, claim.Amount)}
-
-			addr, err := sdk.AccAddressFromBech32(claim.CosmosReceiver)
-			if err != nil {
-				return sdkerrors.Wrap(err, "invalid receiver address")
-			}
-
-			if err = a.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins); err != nil {
-				return sdkerrors.Wrap(err, "transfer vouchers")
-			}
-		} else {
-			// If it is not cosmos originated, mint the coins (aka vouchers)
-			coins := sdk.Coins{sdk.NewCoin(denom, claim.Amount)}
-
-			if err := a.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
-				return sdkerrors.Wrapf(err, "mint vouchers coins: %s", coins)
+			if err := a.keeper.unlockCosmosOriginated(ctx, receipt.Amount); err != nil {
+				a.keeper.logger(ctx).Error("deposit exceeds the locked coins",
+					"cause", err.Error(),
+					"nonce", fmt.Sprint(claim.EventNonce),
+					"receiver", claim.CosmosReceiver,
+				)
+				receipt.Error = err.Error()
+				a.keeper.escrowFailedDeposit(ctx, &receipt, isCosmosOriginated, false)
+				a.keeper.SetDepositReceipt(ctx, receipt)
+				a.keeper.emitTypedEvent(ctx, &types.EventDepositFailed{Receipt: receipt})
+				return nil
 			}
+		}
 
-			addr, err := sdk.AccAddressFromBech32(claim.CosmosReceiver)
-			if err != nil {
-				return sdkerrors.Wrap(err, "invalid receiver address")
-			}
+		if a.keeper.IsBlockedAddress(ctx, claim.EthereumSender) || a.keeper.IsBlockedAddress(ctx, claim.CosmosReceiver) {
+			a.keeper.logger(ctx).Info("deposit quarantined",
+				"nonce", fmt.Sprint(claim.EventNonce),
+				"sender", claim.EthereumSender,
+				"receiver", claim.CosmosReceiver,
+			)
+			a.keeper.quarantineDeposit(ctx, &receipt)
+			a.keeper.SetDepositReceipt(ctx, receipt)
+			return nil
+		}
 
-			if err = a.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins); err != nil {
-				return sdkerrors.Wrap(err, "transfer vouchers")
-			}
+		// credit in a cache context so that a failed deposit still leaves its receipt behind
+		xCtx, commit := ctx.CacheContext()
+		if err := a.creditDeposit(xCtx, receipt.Amount, claim.CosmosReceiver, isCosmosOriginated); err != nil {
+			a.keeper.logger(ctx).Error("deposit failed",
+				"cause", err.Error(),
+				"nonce", fmt.Sprint(claim.EventNonce),
+				"receiver", claim.CosmosReceiver,
+			)
+			receipt.Error = err.Error()
+			a.keeper.escrowFailedDeposit(ctx, &receipt, isCosmosOriginated, true)
+			a.keeper.SetDepositReceipt(ctx, receipt)
+			a.keeper.emitTypedEvent(ctx, &types.EventDepositFailed{Receipt: receipt})
+			return nil
 		}
+		commit()
+		ctx.EventManager().EmitEvents(xCtx.EventManager().Events())
+		receipt.Success = true
+		a.keeper.SetDepositReceipt(ctx, receipt)
+		a.keeper.recordDepositCredited(ctx, *tokenAddress, claim.Amount)
+		a.keeper.emitTypedEvent(ctx, &types.EventDepositCredited{
+			EventNonce:       claim.EventNonce,
+			EthereumSender:   claim.EthereumSender,
+			CosmosReceiver:   claim.CosmosReceiver,
+			TokenContract:    tokenAddress.GetAddress(),
+			Amount:           receipt.Amount,
+			CosmosOriginated: isCosmosOriginated,
+		})
 	// withdraw in this context means a withdraw from the Ethereum side of the bridge
 	case *types.MsgBatchSendToEthClaim:
 		contract, err := types.NewEthAddress(claim.TokenContract)
 		if err != nil {
 			return sdkerrors.Wrap(err, "invalid token contract on batch")
 		}
-		a.keeper.OutgoingTxBatchExecuted(ctx, *contract, claim.BatchNonce)
+		a.keeper.OutgoingTxBatchExecuted(ctx, *contract, claim.BatchNonce, claim.BlockHeight)
 		return nil
 	case *types.MsgERC20DeployedClaim:
 		tokenAddress, err := types.NewEthAddress(claim.TokenContract)
@@ -77,6 +112,14 @@ func (a AttestationHandler) Handle(ctx sdk.Context, att types.Attestation, claim
 				fmt.Sprintf("ERC20 %s already exists for denom %s", existingERC20, claim.CosmosDenom))
 		}
 
+		// Vouchers of this chain have metadata once registered by proposal, but they are unlocked by deposits
+		// of their own token contract and can never be Cosmos originated
+		if _, err := a.keeper.gravityDenomToERC20(claim.CosmosDenom); err == nil {
+			return sdkerrors.Wrap(
+				types.ErrInvalid,
+				fmt.Sprintf("denom %s is a voucher of an Ethereum originated token", claim.CosmosDenom))
+		}
+
 		// Check if denom exists
 		metadata, found := a.keeper.bankKeeper.GetDenomMetaData(ctx, claim.CosmosDenom)
 		if !found || metadata.Base == "" {
@@ -125,20 +168,36 @@ func (a AttestationHandler) Handle(ctx sdk.Context, att types.Attestation, claim
 
 		// Add to denom-erc20 mapping
 		a.keeper.setCosmosOriginatedDenomToERC20(ctx, claim.CosmosDenom, *tokenAddress)
+		a.keeper.emitTypedEvent(ctx, &types.EventERC20Deployed{
+			EventNonce:    claim.EventNonce,
+			CosmosDenom:   claim.CosmosDenom,
+			TokenContract: tokenAddress.GetAddress(),
+			Name:          claim.Name,
+			Symbol:        claim.Symbol,
+			Decimals:      claim.Decimals,
+		})
 	case *types.MsgValsetUpdatedClaim:
 		rewardAddress, err := types.NewEthAddress(claim.RewardToken)
 		if err != nil {
 			return sdkerrors.Wrap(err, "invalid reward token on claim")
 		}
-		// TODO here we should check the contents of the validator set against
-		// the store, if they differ we should take some action to indicate to the
-		// user that bridge highjacking has occurred
-		a.keeper.SetLastObservedValset(ctx, types.Valset{
+		// if the members differ from the valset we requested at this nonce the bridge has been
+		// hijacked, the incident is recorded and the bridge paused. We do not accept the foreign
+		// valset as the last observed one nor do we account for any reward it paid out
+		if !a.keeper.CheckValsetUpdatedClaim(ctx, claim) {
+			return nil
+		}
+		observedValset := types.Valset{
 			Nonce:        claim.ValsetNonce,
 			Members:      claim.Members,
 			Height:       0,
 			RewardAmount: claim.RewardAmount,
 			RewardToken:  claim.RewardToken,
+		}
+		a.keeper.SetLastObservedValset(ctx, observedValset)
+		a.keeper.emitTypedEvent(ctx, &types.EventValsetUpdated{
+			EventNonce: claim.EventNonce,
+			Valset:     observedValset,
 		})
 		// if the reward is greater than zero and the reward token
 		// is valid then some reward was issued by this validator set
@@ -164,6 +223,7 @@ func (a AttestationHandler) Handle(ctx sdk.Context, att types.Attestation, claim
 				// could change between when this event occurred and the present
 				coins := sdk.Coins{sdk.NewCoin(denom, claim.RewardAmount)}
 				a.bankKeeper.MintCoins(ctx, types.ModuleName, coins)
+				a.keeper.lockCosmosOriginated(ctx, coins[0])
 			} else {
 				// // If it is not cosmos originated, burn the coins (aka Vouchers)
 				// // so that we don't think we have more in the bridge than we actually do
@@ -182,3 +242,24 @@ func (a AttestationHandler) Handle(ctx sdk.Context, att types.Attestation, claim
 	}
 	return nil
 }
+
+// creditDeposit pays out a deposit from Ethereum to its receiver, Cosmos originated coins are
+// unlocked from the module and vouchers for Ethereum originated tokens are minted
+func (a AttestationHandler) creditDeposit(ctx sdk.Context, coin sdk.Coin, receiver string, isCosmosOriginated bool) error {
+	coins := sdk.Coins{coin}
+	if !isCosmosOriginated {
+		if err := a.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+			return sdkerrors.Wrapf(err, "mint vouchers coins: %s", coins)
+		}
+	}
+
+	addr, err := sdk.AccAddressFromBech32(receiver)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid receiver address")
+	}
+
+	if err = a.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins); err != nil {
+		return sdkerrors.Wrap(err, "transfer vouchers")
+	}
+	return nil
+}
@@ -51,3 +51,135 @@ func TestGetMostRecentAttestations(t *testing.T) {
 			"The %vth claim does not match our message: claim %v\n message %v", n, attest.Claim, msgs[n])
 	}
 }
+
+// setupAttestationVoters registers each validator of a weighted staking mock as a static validator with its own
+// orchestrator, returning the orchestrators in the same order along with the mock
+func setupAttestationVoters(input *TestInput, vals ...MockStakingValidatorData) ([]sdktypes.AccAddress, *StakingKeeperMock) {
+	mock := NewStakingKeeperWeightedMock(vals...)
+	input.GravityKeeper.StakingKeeper = mock
+	orchestrators := make([]sdktypes.AccAddress, len(vals))
+	for i, val := range vals {
+		orchestrators[i] = AccAddrs[i]
+		input.GravityKeeper.SetOrchestratorValidator(input.Context, val.Operator, orchestrators[i])
+		input.GravityKeeper.SetStaticValCosmosAddr(input.Context, sdktypes.AccAddress(val.Operator).String())
+	}
+	return orchestrators, mock
+}
+
+// attestDeposit casts an orchestrator's vote on the first deposit and tallies it, returning the resulting attestation
+func attestDeposit(t *testing.T, input TestInput, orchestrator sdktypes.AccAddress) *types.Attestation {
+	k := input.GravityKeeper
+	ctx := input.Context
+	msg := types.MsgSendToCosmosClaim{
+		EventNonce:     1,
+		BlockHeight:    1,
+		TokenContract:  TokenContractAddrs[0],
+		Amount:         sdktypes.NewInt(1000),
+		EthereumSender: EthAddrs[0].String(),
+		CosmosReceiver: AccAddrs[0].String(),
+		Orchestrator:   orchestrator.String(),
+	}
+	any, err := codectypes.NewAnyWithValue(&msg)
+	require.NoError(t, err)
+	att, err := k.Attest(ctx, &msg, any)
+	require.NoError(t, err)
+	if !att.Observed {
+		k.TryAttestation(ctx, att)
+	}
+	hash, err := msg.ClaimHash()
+	require.NoError(t, err)
+	return k.GetAttestation(ctx, 1, hash)
+}
+
+// A voter that unbonds after voting keeps the power it voted with, otherwise its vote would vanish from
+// the tally and the remaining votes would fall short of the threshold of the shrunken set
+func TestAttestationVotePowerSnapshotUnbonding(t *testing.T) {
+	input := CreateTestEnv(t)
+	staking := []MockStakingValidatorData{
+		{Operator: ValAddrs[0], Power: 400},
+		{Operator: ValAddrs[1], Power: 100},
+		{Operator: ValAddrs[2], Power: 100},
+		{Operator: ValAddrs[3], Power: 100},
+		{Operator: ValAddrs[4], Power: 100},
+	}
+	orchestrators, mock := setupAttestationVoters(&input, staking...)
+
+	// 500 of 800 is below the 66% threshold
+	att := attestDeposit(t, input, orchestrators[0])
+	att = attestDeposit(t, input, orchestrators[1])
+	require.False(t, att.Observed)
+	require.Equal(t, []uint64{400, 100}, att.VotePowers)
+	require.Equal(t, uint64(800), att.TotalPower)
+
+	// the largest voter unbonds, by current powers the votes would only sum to 200 of 400
+	mock.BondedValidators = mock.BondedValidators[1:]
+	mock.ValidatorPower[ValAddrs[0].String()] = 0
+
+	att = attestDeposit(t, input, orchestrators[2])
+	require.True(t, att.Observed)
+	require.Equal(t, []uint64{400, 100, 100}, att.VotePowers)
+	require.Equal(t, uint64(800), att.TotalPower)
+}
+
+// Removing a validator from the static set while a vote is ongoing must not lower the threshold the
+// votes cast so far are measured against
+func TestAttestationVotePowerSnapshotStaticRemoval(t *testing.T) {
+	input := CreateTestEnv(t)
+	staking := []MockStakingValidatorData{
+		{Operator: ValAddrs[0], Power: 100},
+		{Operator: ValAddrs[1], Power: 100},
+		{Operator: ValAddrs[2], Power: 100},
+		{Operator: ValAddrs[3], Power: 100},
+		{Operator: ValAddrs[4], Power: 100},
+	}
+	orchestrators, _ := setupAttestationVoters(&input, staking...)
+
+	att := attestDeposit(t, input, orchestrators[0])
+	att = attestDeposit(t, input, orchestrators[1])
+	att = attestDeposit(t, input, orchestrators[2])
+	require.False(t, att.Observed)
+
+	// with the last validator removed 300 of the current 400 would pass
+	k := input.GravityKeeper
+	input.Context.KVStore(k.storeKey).Delete(types.GetStaticValCosmosAddrKey(sdktypes.AccAddress(ValAddrs[4]).String()))
+	k.TryAttestation(input.Context, att)
+	require.False(t, att.Observed)
+
+	// the removed validator can still vote, but carries no power
+	att = attestDeposit(t, input, orchestrators[4])
+	require.False(t, att.Observed)
+	require.Equal(t, []uint64{100, 100, 100, 0}, att.VotePowers)
+
+	att = attestDeposit(t, input, orchestrators[3])
+	require.True(t, att.Observed)
+	require.Equal(t, uint64(500), att.TotalPower)
+}
+
+// Validators joining the static set or gaining power after the first vote do not raise the threshold,
+// while a late voter is counted at the power it had when voting
+func TestAttestationVotePowerSnapshotPowerIncrease(t *testing.T) {
+	input := CreateTestEnv(t)
+	staking := []MockStakingValidatorData{
+		{Operator: ValAddrs[0], Power: 100},
+		{Operator: ValAddrs[1], Power: 100},
+		{Operator: ValAddrs[2], Power: 100},
+	}
+	orchestrators, mock := setupAttestationVoters(&input, staking...)
+
+	att := attestDeposit(t, input, orchestrators[0])
+	require.False(t, att.Observed)
+	require.Equal(t, uint64(300), att.TotalPower)
+
+	// a new static validator with more power than everyone else joins
+	newcomer := NewStakingKeeperWeightedMock(MockStakingValidatorData{Operator: ValAddrs[3], Power: 1000})
+	mock.BondedValidators = append(mock.BondedValidators, newcomer.BondedValidators...)
+	mock.ValidatorPower[ValAddrs[3].String()] = 1000
+	input.GravityKeeper.SetStaticValCosmosAddr(input.Context, sdktypes.AccAddress(ValAddrs[3]).String())
+
+	// a voter increasing its power after the first vote is counted at its new power
+	mock.ValidatorPower[ValAddrs[1].String()] = 150
+	att = attestDeposit(t, input, orchestrators[1])
+	require.True(t, att.Observed)
+	require.Equal(t, []uint64{100, 150}, att.VotePowers)
+	require.Equal(t, uint64(300), att.TotalPower)
+}
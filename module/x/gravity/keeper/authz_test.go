@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/stretchr/testify/require"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// grant makes every grant of msgTypeURLs from granter to grantee through the authz msg server
+func grant(t *testing.T, input TestInput, granter sdk.AccAddress, grantee sdk.AccAddress, msgTypeURLs []string) {
+	grants, err := types.NewGenericGrants(granter, grantee, msgTypeURLs, input.Context.BlockTime().AddDate(1, 0, 0))
+	require.NoError(t, err)
+	for _, msg := range grants {
+		_, err := input.AuthzKeeper.Grant(sdk.WrapSDKContext(input.Context), msg.(*authz.MsgGrant))
+		require.NoError(t, err)
+	}
+}
+
+//nolint: exhaustivestruct
+func TestOrchestratorMsgsExecutedByGrantee(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	input.Context = ctx
+	k := input.GravityKeeper
+	for i := range ValAddrs {
+		k.SetOrchestratorValidator(ctx, ValAddrs[i], AccAddrs[i])
+	}
+	hotKey, err := sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+	require.NoError(t, err)
+	claim := func(orchestrator sdk.AccAddress) *types.MsgSendToCosmosClaim {
+		return &types.MsgSendToCosmosClaim{
+			EventNonce:     1,
+			BlockHeight:    1,
+			TokenContract:  TokenContractAddrs[0],
+			Amount:         sdk.NewInt(100),
+			EthereumSender: EthAddrs[0].String(),
+			CosmosReceiver: AccAddrs[0].String(),
+			Orchestrator:   orchestrator.String(),
+		}
+	}
+	exec := func(msgs ...sdk.Msg) error {
+		msg := authz.NewMsgExec(hotKey, msgs)
+		_, err := input.AuthzKeeper.Exec(sdk.WrapSDKContext(ctx), &msg)
+		return err
+	}
+
+	// the hot key holds no grant yet
+	require.ErrorIs(t, exec(claim(AccAddrs[0])), sdkerrors.ErrUnauthorized)
+
+	// once granted the claims it executes are made by the validator of the orchestrator
+	grant(t, input, AccAddrs[0], hotKey, types.OrchestratorMsgTypeURLs())
+	require.NoError(t, exec(claim(AccAddrs[0])))
+	require.Equal(t, uint64(1), k.GetLastEventNonceByValidator(ctx, ValAddrs[0]))
+	require.Zero(t, k.GetLastEventNonceByValidator(ctx, ValAddrs[1]))
+	require.Len(t, k.GetAttestationMapping(ctx), 1)
+
+	// but only for the orchestrators that granted it
+	require.ErrorIs(t, exec(claim(AccAddrs[1])), sdkerrors.ErrUnauthorized)
+	require.ErrorIs(t, exec(types.NewMsgEthereumHeightClaim(AccAddrs[1], 100)), sdkerrors.ErrUnauthorized)
+	require.NoError(t, exec(types.NewMsgEthereumHeightClaim(AccAddrs[0], 100)))
+}
+
+//nolint: exhaustivestruct
+func TestSendToEthExecutedByGrantee(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	input.Context = ctx
+	k := input.GravityKeeper
+	custodian, err := sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+	require.NoError(t, err)
+	token, err := types.NewInternalERC20Token(sdk.NewInt(1000), TokenContractAddrs[0])
+	require.NoError(t, err)
+	balance := MintVouchersFromAir(t, ctx, k, AccAddrs[0], *token)
+	sendToEth := &types.MsgSendToEth{
+		Sender:    AccAddrs[0].String(),
+		EthDest:   EthAddrs[0].String(),
+		Amount:    sdk.NewInt64Coin(balance.Denom, 100),
+		BridgeFee: sdk.NewInt64Coin(balance.Denom, 10),
+	}
+	exec := func(msg sdk.Msg) error {
+		exec := authz.NewMsgExec(custodian, []sdk.Msg{msg})
+		_, err := input.AuthzKeeper.Exec(sdk.WrapSDKContext(ctx), &exec)
+		return err
+	}
+
+	require.ErrorIs(t, exec(sendToEth), sdkerrors.ErrUnauthorized)
+
+	// the custodian sends the coins of the account that granted it and can cancel the transfer
+	grant(t, input, AccAddrs[0], custodian, types.BridgeMsgTypeURLs())
+	require.NoError(t, exec(sendToEth))
+	require.Equal(t, balance.SubAmount(sdk.NewInt(110)), input.BankKeeper.GetBalance(ctx, AccAddrs[0], balance.Denom))
+	tx, err := k.GetUnbatchedTxById(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, AccAddrs[0], tx.Sender)
+
+	require.NoError(t, exec(types.NewMsgCancelSendToEth(AccAddrs[0], 1)))
+	require.Equal(t, balance, input.BankKeeper.GetBalance(ctx, AccAddrs[0], balance.Denom))
+	require.True(t, input.BankKeeper.GetAllBalances(ctx, custodian).IsZero())
+
+	// the grants are limited to the bridge messages
+	require.ErrorIs(t, exec(types.NewMsgEthereumHeightClaim(AccAddrs[0], 100)), sdkerrors.ErrUnauthorized)
+}
+
+func TestNewGenericGrants(t *testing.T) {
+	expiration := time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC)
+	grants, err := types.NewGenericGrants(AccAddrs[0], AccAddrs[1], types.BridgeMsgTypeURLs(), expiration)
+	require.NoError(t, err)
+	require.Len(t, grants, 2)
+	for i, msg := range grants {
+		require.NoError(t, msg.ValidateBasic())
+		grant := msg.(*authz.MsgGrant)
+		require.Equal(t, AccAddrs[0].String(), grant.Granter)
+		require.Equal(t, types.BridgeMsgTypeURLs()[i], grant.Grant.GetAuthorization().MsgTypeURL())
+		require.Equal(t, expiration, grant.Grant.Expiration)
+	}
+}
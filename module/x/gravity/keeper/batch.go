@@ -17,7 +17,7 @@ const OutgoingTxBatchSize = 100
 // - find bridged denominator for given voucher type
 // - determine if an unexecuted batch is already waiting for this token type, if so confirm the new batch would
 //   have a higher total fees. If not exit without creating a batch
-// - select available transactions from the outgoing transaction pool sorted by fee desc
+// - select available transactions from the outgoing transaction pool under the batch selection policy
 // - persist an outgoing batch object with an incrementing ID = nonce
 // - emit an event
 func (k Keeper) BuildOutgoingTXBatch(
@@ -27,6 +27,9 @@ func (k Keeper) BuildOutgoingTXBatch(
 	if maxElements == 0 {
 		return nil, sdkerrors.Wrap(types.ErrInvalid, "max elements value")
 	}
+	if k.IsBridgePaused(ctx) {
+		return nil, sdkerrors.Wrap(types.ErrBridgePaused, "can not build batch")
+	}
 
 	lastBatch := k.GetLastOutgoingBatchByTokenType(ctx, contract)
 
@@ -70,6 +73,12 @@ func (k Keeper) BuildOutgoingTXBatch(
 		sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(nextID)),
 	)
 	ctx.EventManager().EmitEvent(batchEvent)
+	k.emitTypedEvent(ctx, &types.EventBatchCreated{
+		Batch:          *batch.ToExternal(),
+		BridgeContract: k.GetBridgeContractAddress(ctx).GetAddress(),
+		BridgeChainId:  k.GetBridgeChainID(ctx),
+	})
+	k.recordBatchStatus(ctx, *batch, types.TransferStatusUpdate{Status: types.TRANSFER_STATUS_BATCHED})
 	return batch, nil
 }
 
@@ -79,7 +88,8 @@ func (k Keeper) getBatchTimeoutHeight(ctx sdk.Context) uint64 {
 	// currentCosmosHeight := ctx.BlockHeight()
 	currentCosmosTimeMs := uint64(ctx.BlockTime().UnixNano() / 1000000)
 	// we store the last observed Cosmos and Ethereum heights, we do not concern ourselves if these values are zero because
-	// no batch can be produced if the last Ethereum block height is not first populated by a deposit event.
+	// no batch can be produced if the last Ethereum block height is not first populated by an observed event or the
+	// height votes of the orchestrators.
 	heights := k.GetLastObservedEthereumBlockHeight(ctx)
 	if heights.CosmosBlockHeight == 0 || heights.EthereumBlockHeight == 0 || heights.CosmosBlockTimeMs == 0 {
 		return 0
@@ -94,20 +104,21 @@ func (k Keeper) getBatchTimeoutHeight(ctx sdk.Context) uint64 {
 	// The difference between them is equal to the delay, which is usually less than 5 minutes.
 	// Even if it is more than 5 minutes, the logic is save as long as the delay is lower than batch_timeout parameter, which by default is 12h.
 	// In order to make it as precise as possible, we set 300000ms as a contants which purpose is to cover the usual delay between the two events.
-	ethereumOffset := 300000 / params.AverageEthereumBlockTime
+	averageEthereumBlockTime := k.GetAverageEthereumBlockTime(ctx)
+	ethereumOffset := 300000 / averageEthereumBlockTime
 	// we convert that projection into the current Ethereum height using the average Ethereum block time in millis
 	// projectedCurrentEthereumHeight := (projectedMillis / params.AverageEthereumBlockTime) + heights.EthereumBlockHeight
-	projectedCurrentEthereumHeight := (realMillis / params.AverageEthereumBlockTime) + heights.EthereumBlockHeight + ethereumOffset
+	projectedCurrentEthereumHeight := (realMillis / averageEthereumBlockTime) + heights.EthereumBlockHeight + ethereumOffset
 	// we convert our target time for block timeouts (lets say 12 hours) into a number of blocks to
 	// place on top of our projection of the current Ethereum block height.
-	blocksToAdd := params.TargetBatchTimeout / params.AverageEthereumBlockTime
+	blocksToAdd := params.TargetBatchTimeout / averageEthereumBlockTime
 	return projectedCurrentEthereumHeight + blocksToAdd
 }
 
 // OutgoingTxBatchExecuted is run when the Cosmos chain detects that a batch has been executed on Ethereum
-// It frees all the transactions in the batch, then cancels all earlier batches, this function panics instead
-// of returning errors because any failure will cause a double spend.
-func (k Keeper) OutgoingTxBatchExecuted(ctx sdk.Context, tokenContract types.EthAddress, nonce uint64) {
+// at ethereumHeight. It frees all the transactions in the batch, then cancels all earlier batches, this
+// function panics instead of returning errors because any failure will cause a double spend.
+func (k Keeper) OutgoingTxBatchExecuted(ctx sdk.Context, tokenContract types.EthAddress, nonce uint64, ethereumHeight uint64) {
 	b := k.GetOutgoingTXBatch(ctx, tokenContract, nonce)
 	if b == nil {
 		panic(fmt.Sprintf("unknown batch nonce for outgoing tx batch %s %d", tokenContract, nonce))
@@ -127,7 +138,33 @@ func (k Keeper) OutgoingTxBatchExecuted(ctx sdk.Context, tokenContract types.Eth
 
 	// Delete batch since it is finished
 	k.DeleteBatch(ctx, *b)
+	for _, tx := range b.Transactions {
+		k.DeleteQueuedTransferHeight(ctx, tx.Erc20Fee.Contract, tx.Id)
+		// the batch was executed on Ethereum whatever happens here, a fee that can not be paid is left in the
+		// module account instead of halting the chain
+		xCtx, commit := ctx.CacheContext()
+		if err := k.payProtocolFee(xCtx, tx); err != nil {
+			k.logger(ctx).Error("unable to pay the protocol fee, leaving it in the module account",
+				"tx id", fmt.Sprint(tx.Id),
+				"fee", tx.ProtocolFee.String(),
+				"error", err.Error(),
+			)
+			continue
+		}
+		commit()
+		ctx.EventManager().EmitEvents(xCtx.EventManager().Events())
+	}
 
+	k.recordBatchExecuted(ctx, *b)
+	k.emitTypedEvent(ctx, &types.EventBatchExecuted{
+		Batch:          *b.ToExternal(),
+		BridgeContract: k.GetBridgeContractAddress(ctx).GetAddress(),
+		BridgeChainId:  k.GetBridgeChainID(ctx),
+	})
+	k.recordBatchStatus(ctx, *b, types.TransferStatusUpdate{
+		Status:         types.TRANSFER_STATUS_EXECUTED,
+		EthereumHeight: ethereumHeight,
+	})
 }
 
 // StoreBatch stores a transaction batch
@@ -135,7 +172,7 @@ func (k Keeper) StoreBatch(ctx sdk.Context, batch *types.InternalOutgoingTxBatch
 	if err := batch.ValidateBasic(); err != nil {
 		panic(sdkerrors.Wrap(err, "attempted to store invalid batch"))
 	}
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	// set the current block height when storing the batch
 	batch.Block = uint64(ctx.BlockHeight())
 	key := types.GetOutgoingTxBatchKey(batch.TokenContract, batch.BatchNonce)
@@ -151,7 +188,7 @@ func (k Keeper) StoreBatchUnsafe(ctx sdk.Context, batch *types.InternalOutgoingT
 		panic(sdkerrors.Wrap(err, "attempted to store invalid batch"))
 	}
 	batchExt := batch.ToExternal()
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	key := types.GetOutgoingTxBatchKey(batch.TokenContract, batchExt.BatchNonce)
 	store.Set(key, k.cdc.MustMarshal(batchExt))
 
@@ -164,37 +201,32 @@ func (k Keeper) DeleteBatch(ctx sdk.Context, batch types.InternalOutgoingTxBatch
 	if err := batch.ValidateBasic(); err != nil {
 		panic(sdkerrors.Wrap(err, "attempted to delete invalid batch"))
 	}
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	store.Delete(types.GetOutgoingTxBatchKey(batch.TokenContract, batch.BatchNonce))
 	store.Delete(types.GetOutgoingTxBatchBlockKey(batch.Block))
 }
 
-// pickUnbatchedTX find TX in pool and remove from "available" second index
+// pickUnbatchedTX selects the TX for a new batch from the pool and removes them from the "available" second index
 func (k Keeper) pickUnbatchedTX(
 	ctx sdk.Context,
 	contractAddress types.EthAddress,
 	maxElements uint) ([]*types.InternalOutgoingTransferTx, error) {
-	var selectedTx []*types.InternalOutgoingTransferTx
-	var err error
-	k.IterateUnbatchedTransactionsByContract(ctx, contractAddress, func(_ []byte, tx *types.InternalOutgoingTransferTx) bool {
-		if tx != nil && tx.Erc20Fee != nil {
-			selectedTx = append(selectedTx, tx)
-			err = k.removeUnbatchedTX(ctx, *tx.Erc20Fee, tx.Id)
-			oldTx, oldTxErr := k.GetUnbatchedTxByFeeAndId(ctx, *tx.Erc20Fee, tx.Id)
-			if oldTx != nil || oldTxErr == nil {
-				panic("picked a duplicate transaction from the pool, duplicates should never exist!")
-			}
-			return err != nil || uint(len(selectedTx)) == maxElements
-		} else {
-			panic("tx and fee should never be nil!")
+	selectedTx := k.selectUnbatchedTXs(ctx, contractAddress, maxElements)
+	for _, tx := range selectedTx {
+		if err := k.removeUnbatchedTX(ctx, *tx.Erc20Fee, tx.Id); err != nil {
+			return nil, err
 		}
-	})
-	return selectedTx, err
+		oldTx, oldTxErr := k.GetUnbatchedTxByFeeAndId(ctx, *tx.Erc20Fee, tx.Id)
+		if oldTx != nil || oldTxErr == nil {
+			panic("picked a duplicate transaction from the pool, duplicates should never exist!")
+		}
+	}
+	return selectedTx, nil
 }
 
 // GetOutgoingTXBatch loads a batch object. Returns nil when not exists.
 func (k Keeper) GetOutgoingTXBatch(ctx sdk.Context, tokenContract types.EthAddress, nonce uint64) *types.InternalOutgoingTxBatch {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	key := types.GetOutgoingTxBatchKey(tokenContract, nonce)
 	bz := store.Get(key)
 	if len(bz) == 0 {
@@ -238,12 +270,23 @@ func (k Keeper) CancelOutgoingTXBatch(ctx sdk.Context, tokenContract types.EthAd
 		sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(nonce)),
 	)
 	ctx.EventManager().EmitEvent(batchEvent)
+	k.emitTypedEvent(ctx, &types.EventBatchCanceled{
+		Batch:          *batch.ToExternal(),
+		BridgeContract: k.GetBridgeContractAddress(ctx).GetAddress(),
+		BridgeChainId:  k.GetBridgeChainID(ctx),
+	})
+	// batches are canceled either once they time out or once a later batch is executed
+	status := types.TRANSFER_STATUS_BATCH_CANCELED
+	if batch.BatchTimeout < k.GetLastObservedEthereumBlockHeight(ctx).EthereumBlockHeight {
+		status = types.TRANSFER_STATUS_BATCH_TIMED_OUT
+	}
+	k.recordBatchStatus(ctx, *batch, types.TransferStatusUpdate{Status: status})
 	return nil
 }
 
 // IterateOutgoingTXBatches iterates through all outgoing batches in DESC order.
 func (k Keeper) IterateOutgoingTXBatches(ctx sdk.Context, cb func(key []byte, batch *types.InternalOutgoingTxBatch) bool) {
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.OutgoingTXBatchKey)
+	prefixStore := prefix.NewStore(k.store(ctx), types.OutgoingTXBatchKey)
 	iter := prefixStore.ReverseIterator(nil, nil)
 	defer iter.Close()
 	for ; iter.Valid(); iter.Next() {
@@ -285,13 +328,13 @@ func (k Keeper) GetLastOutgoingBatchByTokenType(ctx sdk.Context, token types.Eth
 
 // SetLastSlashedBatchBlock sets the latest slashed Batch block height
 func (k Keeper) SetLastSlashedBatchBlock(ctx sdk.Context, blockHeight uint64) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	store.Set(types.LastSlashedBatchBlock, types.UInt64Bytes(blockHeight))
 }
 
 // GetLastSlashedBatchBlock returns the latest slashed Batch block
 func (k Keeper) GetLastSlashedBatchBlock(ctx sdk.Context) uint64 {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	bytes := store.Get(types.LastSlashedBatchBlock)
 
 	if len(bytes) == 0 {
@@ -321,7 +364,7 @@ func (k Keeper) IterateBatchBySlashedBatchBlock(
 	lastSlashedBatchBlock uint64,
 	maxHeight uint64,
 	cb func([]byte, *types.InternalOutgoingTxBatch) bool) {
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.OutgoingTXBatchBlockKey)
+	prefixStore := prefix.NewStore(k.store(ctx), types.OutgoingTXBatchBlockKey)
 	iter := prefixStore.Iterator(types.UInt64Bytes(lastSlashedBatchBlock), types.UInt64Bytes(maxHeight))
 	defer iter.Close()
 
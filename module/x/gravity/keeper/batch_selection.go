@@ -0,0 +1,178 @@
+package keeper
+
+import (
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// BatchSelectionScanLimit is the number of transfers of a token the batch selection looks at beyond the
+// ones a batch can take, so that building a batch or predicting its fees never walks the whole pool.
+// Transfers past it are reached as the transfers ahead of them are batched.
+const BatchSelectionScanLimit = 1000
+
+// selectUnbatchedTXs chooses the transfers the next batch of a token would take from the pool, without
+// removing them. Transfers that have waited BatchGuaranteedInclusionBlocks go first, oldest first, the rest
+// are ordered by the BatchSelectionPolicy param. Both batch creation and GetBatchFeeByTokenType use this so
+// that the fees predicted for a batch are always the fees of the batch that would be built.
+func (k Keeper) selectUnbatchedTXs(
+	ctx sdk.Context,
+	contractAddress types.EthAddress,
+	maxElements uint) []*types.InternalOutgoingTransferTx {
+	params := k.GetParams(ctx)
+	var selected []*types.InternalOutgoingTransferTx
+	scanLimit := maxElements + BatchSelectionScanLimit
+	taken := make(map[uint64]bool)
+	take := func(tx *types.InternalOutgoingTransferTx) bool {
+		selected = append(selected, tx)
+		taken[tx.Id] = true
+		return uint(len(selected)) == maxElements
+	}
+
+	if params.BatchGuaranteedInclusionBlocks != 0 {
+		// transfers are visited oldest first, the first one that is not overdue ends the walk
+		full := false
+		k.iterateQueuedTransfers(ctx, contractAddress, scanLimit, func(queued *types.QueuedTransferHeight, tx *types.InternalOutgoingTransferTx) bool {
+			if queuedTransferAge(ctx, queued) < params.BatchGuaranteedInclusionBlocks {
+				return true
+			}
+			full = take(tx)
+			return full
+		})
+		if full {
+			return selected
+		}
+	}
+
+	switch params.BatchSelectionPolicy {
+	case types.BATCH_SELECTION_POLICY_FEE_PER_AGE:
+		// a transfer that has waited n blocks weighs as much as one paying n + 1 times its fee right away,
+		// the candidates are the best paying transfers of the pool
+		type candidate struct {
+			tx     *types.InternalOutgoingTransferTx
+			weight sdk.Int
+		}
+		var candidates []candidate
+		k.IterateUnbatchedTransactionsByContract(ctx, contractAddress, func(_ []byte, tx *types.InternalOutgoingTransferTx) bool {
+			if !taken[tx.Id] {
+				var age uint64
+				if queued := k.GetQueuedTransferHeight(ctx, contractAddress, tx.Id); queued != nil {
+					age = queuedTransferAge(ctx, queued)
+				}
+				candidates = append(candidates, candidate{tx: tx, weight: tx.Erc20Fee.Amount.Mul(sdk.NewIntFromUint64(age + 1))})
+			}
+			return uint(len(candidates)) == scanLimit
+		})
+		sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].weight.GT(candidates[j].weight) })
+		for _, c := range candidates {
+			if take(c.tx) {
+				break
+			}
+		}
+	case types.BATCH_SELECTION_POLICY_FIFO_MIN_FEE:
+		minimum := k.getBatchMinimumFee(ctx, params, contractAddress)
+		k.iterateQueuedTransfers(ctx, contractAddress, scanLimit, func(_ *types.QueuedTransferHeight, tx *types.InternalOutgoingTransferTx) bool {
+			if taken[tx.Id] || tx.Erc20Fee.Amount.LT(minimum) {
+				return false
+			}
+			return take(tx)
+		})
+	default:
+		// the pool is sorted by fee, there is no need to look past the first maxElements transfers
+		k.IterateUnbatchedTransactionsByContract(ctx, contractAddress, func(_ []byte, tx *types.InternalOutgoingTransferTx) bool {
+			if taken[tx.Id] {
+				return false
+			}
+			return take(tx)
+		})
+	}
+	return selected
+}
+
+// iterateQueuedTransfers walks the transfers of a token queued for Ethereum in the order they were queued,
+// oldest first, and calls cb with the first limit of them that are waiting in the pool. Transfers in a batch
+// keep their queued height and are skipped without counting toward limit, so that outstanding batches can
+// not use up the scan. cb returns true to stop early.
+func (k Keeper) iterateQueuedTransfers(
+	ctx sdk.Context,
+	contractAddress types.EthAddress,
+	limit uint,
+	cb func(queued *types.QueuedTransferHeight, tx *types.InternalOutgoingTransferTx) bool) {
+	prefixStore := prefix.NewStore(k.store(ctx), types.GetQueuedTransferHeightContractPrefix(contractAddress))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for visited := uint(0); iter.Valid() && visited < limit; iter.Next() {
+		var queued types.QueuedTransferHeight
+		k.cdc.MustUnmarshal(iter.Value(), &queued)
+		fee, err := types.NewInternalERC20Token(queued.Fee, contractAddress.GetAddress())
+		if err != nil {
+			panic(sdkerrors.Wrapf(err, "invalid queued transfer height in store: %v", queued))
+		}
+		tx, err := k.GetUnbatchedTxByFeeAndId(ctx, *fee, queued.TxId)
+		if err != nil {
+			continue
+		}
+		visited++
+		if cb(&queued, tx) {
+			break
+		}
+	}
+}
+
+// getBatchMinimumFee returns the fee a transfer of a token must pay to be batched under the FIFO policy, the
+// entry of BatchMinimumFees for the denom of the token, zero when there is none
+func (k Keeper) getBatchMinimumFee(ctx sdk.Context, params types.Params, contractAddress types.EthAddress) sdk.Int {
+	_, denom := k.ERC20ToDenomLookup(ctx, contractAddress)
+	return params.BatchMinimumFees.AmountOf(denom)
+}
+
+// queuedTransferAge returns the number of blocks a transfer has been waiting to be executed
+func queuedTransferAge(ctx sdk.Context, queued *types.QueuedTransferHeight) uint64 {
+	if queued.Height >= ctx.BlockHeight() {
+		return 0
+	}
+	return uint64(ctx.BlockHeight() - queued.Height)
+}
+
+// SetQueuedTransferHeight stores the height a transfer to Ethereum was queued at
+func (k Keeper) SetQueuedTransferHeight(ctx sdk.Context, queued types.QueuedTransferHeight) {
+	tokenContract, err := types.NewEthAddress(queued.TokenContract)
+	if err != nil {
+		panic(sdkerrors.Wrapf(err, "invalid token contract of queued transfer %d", queued.TxId))
+	}
+	k.store(ctx).Set(types.GetQueuedTransferHeightKey(*tokenContract, queued.TxId), k.cdc.MustMarshal(&queued))
+}
+
+// GetQueuedTransferHeight returns the height a transfer to Ethereum was queued at, nil if it is not known
+func (k Keeper) GetQueuedTransferHeight(ctx sdk.Context, tokenContract types.EthAddress, txID uint64) *types.QueuedTransferHeight {
+	bz := k.store(ctx).Get(types.GetQueuedTransferHeightKey(tokenContract, txID))
+	if bz == nil {
+		return nil
+	}
+	var queued types.QueuedTransferHeight
+	k.cdc.MustUnmarshal(bz, &queued)
+	return &queued
+}
+
+// DeleteQueuedTransferHeight removes the queued height of a transfer that was executed or canceled
+func (k Keeper) DeleteQueuedTransferHeight(ctx sdk.Context, tokenContract types.EthAddress, txID uint64) {
+	k.store(ctx).Delete(types.GetQueuedTransferHeightKey(tokenContract, txID))
+}
+
+// GetQueuedTransferHeights returns the queued height of every transfer in flight in ASC token contract then
+// tx id order
+func (k Keeper) GetQueuedTransferHeights(ctx sdk.Context) (out []*types.QueuedTransferHeight) {
+	prefixStore := prefix.NewStore(k.store(ctx), types.QueuedTransferHeightKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var queued types.QueuedTransferHeight
+		k.cdc.MustUnmarshal(iter.Value(), &queued)
+		out = append(out, &queued)
+	}
+	return
+}
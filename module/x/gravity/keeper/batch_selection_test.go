@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+//nolint: exhaustivestruct
+func TestBatchSelectionPolicies(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	var (
+		mySender, _            = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver, _          = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr, _ = types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5") // Pickle
+		token, err             = types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr.GetAddress())
+		allVouchers            = sdk.NewCoins(token.GravityCoin())
+	)
+	require.NoError(t, err)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
+
+	queue := func(height int64, fee int64) {
+		amount := sdk.NewCoin(token.GravityCoin().Denom, sdk.NewInt(100))
+		_, err := k.AddToOutgoingPool(ctx.WithBlockHeight(height), mySender, *myReceiver, amount, sdk.NewCoin(amount.Denom, sdk.NewInt(fee)))
+		require.NoError(t, err)
+	}
+	// 1: fee is 1, queued at height 1
+	// 2: fee is 5, queued at height 100
+	// 3: fee is 50, queued at height 100
+	// 4: fee is 20, queued at height 100
+	queue(1, 1)
+	queue(100, 5)
+	queue(100, 50)
+	queue(100, 20)
+	ctx = ctx.WithBlockHeight(110)
+	require.Equal(t, int64(1), k.GetQueuedTransferHeight(ctx, *myTokenContractAddr, 1).Height)
+
+	setPolicy := func(policy types.BatchSelectionPolicy, minimumFee int64, guaranteedInclusionBlocks uint64) {
+		params := k.GetParams(ctx)
+		params.BatchSelectionPolicy = policy
+		params.BatchMinimumFees = sdk.NewCoins(sdk.NewInt64Coin(token.GravityCoin().Denom, minimumFee))
+		params.BatchGuaranteedInclusionBlocks = guaranteedInclusionBlocks
+		k.SetParams(ctx, params)
+	}
+	assertSelection := func(maxElements uint, expIds []uint64, expFees int64) {
+		var ids []uint64
+		for _, tx := range k.selectUnbatchedTXs(ctx, *myTokenContractAddr, maxElements) {
+			ids = append(ids, tx.Id)
+		}
+		require.Equal(t, expIds, ids)
+		require.Equal(t, sdk.NewInt(expFees), k.GetBatchFeeByTokenType(ctx, *myTokenContractAddr, maxElements).TotalFees)
+	}
+
+	setPolicy(types.BATCH_SELECTION_POLICY_FEE_PRIORITY, 0, 0)
+	assertSelection(2, []uint64{3, 4}, 70)
+
+	// the first transfer has waited 109 blocks, which weighs 1 * 110 against 5 * 11 for the second
+	setPolicy(types.BATCH_SELECTION_POLICY_FEE_PER_AGE, 0, 0)
+	assertSelection(3, []uint64{3, 4, 1}, 71)
+
+	setPolicy(types.BATCH_SELECTION_POLICY_FIFO_MIN_FEE, 5, 0)
+	assertSelection(2, []uint64{2, 3}, 55)
+
+	// the minimum of another token does not apply
+	params := k.GetParams(ctx)
+	params.BatchMinimumFees = sdk.NewCoins(sdk.NewInt64Coin("stake", 5))
+	k.SetParams(ctx, params)
+	assertSelection(2, []uint64{1, 2}, 6)
+
+	// the first transfer is overdue and goes first under any policy
+	setPolicy(types.BATCH_SELECTION_POLICY_FEE_PRIORITY, 0, 100)
+	assertSelection(2, []uint64{1, 3}, 51)
+	setPolicy(types.BATCH_SELECTION_POLICY_FIFO_MIN_FEE, 5, 100)
+	assertSelection(2, []uint64{1, 2}, 6)
+
+	// the batch holds the selected transfers, the queued height survives until the batch is executed
+	batch, err := k.BuildOutgoingTXBatch(ctx, *myTokenContractAddr, 2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), batch.Transactions[0].Id)
+	require.Equal(t, uint64(2), batch.Transactions[1].Id)
+	assertSelection(2, []uint64{3, 4}, 70)
+	require.NotNil(t, k.GetQueuedTransferHeight(ctx, *myTokenContractAddr, 1))
+	k.OutgoingTxBatchExecuted(ctx, *myTokenContractAddr, batch.BatchNonce, 0)
+	require.Nil(t, k.GetQueuedTransferHeight(ctx, *myTokenContractAddr, 1))
+	require.Nil(t, k.GetQueuedTransferHeight(ctx, *myTokenContractAddr, 2))
+
+	require.NoError(t, k.RemoveFromOutgoingPoolAndRefund(ctx, 3, mySender))
+	require.Nil(t, k.GetQueuedTransferHeight(ctx, *myTokenContractAddr, 3))
+	require.Len(t, k.GetQueuedTransferHeights(ctx), 1)
+}
+
+func TestBatchSelectionScanIsBounded(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	var (
+		mySender, _            = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver, _          = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr, _ = types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5") // Pickle
+		token, err             = types.NewInternalERC20Token(sdk.NewInt(999999), myTokenContractAddr.GetAddress())
+		allVouchers            = sdk.NewCoins(token.GravityCoin())
+	)
+	require.NoError(t, err)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
+	queue := func(fee int64) {
+		amount := sdk.NewCoin(token.GravityCoin().Denom, sdk.NewInt(100))
+		_, err := k.AddToOutgoingPool(ctx, mySender, *myReceiver, amount, sdk.NewCoin(amount.Denom, sdk.NewInt(fee)))
+		require.NoError(t, err)
+	}
+
+	params := k.GetParams(ctx)
+	params.BatchSelectionPolicy = types.BATCH_SELECTION_POLICY_FIFO_MIN_FEE
+	params.BatchMinimumFees = sdk.NewCoins(sdk.NewInt64Coin(token.GravityCoin().Denom, 5))
+	k.SetParams(ctx, params)
+
+	// a transfer queued behind more underpaying transfers than the scan looks at is not reached
+	for i := 0; i < BatchSelectionScanLimit+1; i++ {
+		queue(1)
+	}
+	queue(5)
+	require.Empty(t, k.selectUnbatchedTXs(ctx, *myTokenContractAddr, 1))
+
+	// until some of them are canceled
+	require.NoError(t, k.RemoveFromOutgoingPoolAndRefund(ctx, 1, mySender))
+	require.Len(t, k.selectUnbatchedTXs(ctx, *myTokenContractAddr, 1), 1)
+
+	// transfers waiting in batches do not use up the scan
+	params.BatchMinimumFees = sdk.Coins{}
+	k.SetParams(ctx, params)
+	_, err = k.BuildOutgoingTXBatch(ctx, *myTokenContractAddr, BatchSelectionScanLimit+1)
+	require.NoError(t, err)
+	queue(5)
+	params.BatchGuaranteedInclusionBlocks = 10
+	k.SetParams(ctx, params)
+	require.Len(t, k.selectUnbatchedTXs(ctx, *myTokenContractAddr, 1), 1)
+	require.Len(t, k.selectUnbatchedTXs(ctx.WithBlockHeight(ctx.BlockHeight()+10), *myTokenContractAddr, 1), 1)
+}
@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// UpdateBlocklist adds the blocked addresses to the blocklist and removes the unblocked ones from it,
+// the blocklist is shared by all chains
+func (k Keeper) UpdateBlocklist(ctx sdk.Context, blocked []string, unblocked []string) error {
+	var added, removed []string
+	for _, address := range blocked {
+		normalized, err := types.NormalizeBlocklistAddress(address)
+		if err != nil {
+			return err
+		}
+		k.SetBlockedAddress(ctx, normalized)
+		added = append(added, normalized)
+	}
+	for _, address := range unblocked {
+		normalized, err := types.NormalizeBlocklistAddress(address)
+		if err != nil {
+			return err
+		}
+		ctx.KVStore(k.storeKey).Delete(types.GetBlocklistKey(normalized))
+		removed = append(removed, normalized)
+	}
+	k.emitTypedEvent(ctx, &types.EventBlocklistUpdated{Blocked: added, Unblocked: removed})
+	return nil
+}
+
+// SetBlockedAddress adds an address, in the form returned by types.NormalizeBlocklistAddress, to the blocklist
+func (k Keeper) SetBlockedAddress(ctx sdk.Context, address string) {
+	ctx.KVStore(k.storeKey).Set(types.GetBlocklistKey(address), []byte(address))
+}
+
+// IsBlockedAddress returns true if an Ethereum or Cosmos address is on the blocklist, an address that is
+// neither is never blocked
+func (k Keeper) IsBlockedAddress(ctx sdk.Context, address string) bool {
+	normalized, err := types.NormalizeBlocklistAddress(address)
+	if err != nil {
+		return false
+	}
+	return ctx.KVStore(k.storeKey).Has(types.GetBlocklistKey(normalized))
+}
+
+// GetBlockedAddresses returns every address on the blocklist in sorted order
+func (k Keeper) GetBlockedAddresses(ctx sdk.Context) (out []string) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.BlocklistKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		out = append(out, string(iter.Value()))
+	}
+	sort.Strings(out)
+	return
+}
+
+// quarantineDeposit holds a deposit from or to a blocked address instead of crediting it. Cosmos originated
+// coins stay locked in the module and no vouchers are minted for Ethereum originated tokens until
+// governance releases the deposit. The receipt is updated in place.
+func (k Keeper) quarantineDeposit(ctx sdk.Context, receipt *types.DepositReceipt) {
+	receipt.Quarantined = true
+	receipt.Error = types.ErrBlocked.Error()
+	k.SetQuarantinedDeposit(ctx, *receipt)
+	k.emitTypedEvent(ctx, &types.EventDepositQuarantined{Receipt: *receipt})
+}
+
+// ReleaseQuarantinedDeposit pays a deposit held in quarantine to recipient, or to its Cosmos receiver when
+// recipient is empty. The receipt of the deposit is updated if it has not been pruned yet.
+func (k Keeper) ReleaseQuarantinedDeposit(ctx sdk.Context, eventNonce uint64, recipient string) error {
+	deposit := k.GetQuarantinedDeposit(ctx, eventNonce)
+	if deposit == nil {
+		return sdkerrors.Wrapf(types.ErrInvalid, "no quarantined deposit at event nonce %d", eventNonce)
+	}
+	if recipient == "" {
+		recipient = deposit.CosmosReceiver
+	}
+	addr, err := sdk.AccAddressFromBech32(recipient)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, recipient)
+	}
+	if k.IsBlockedAddress(ctx, recipient) {
+		return sdkerrors.Wrapf(types.ErrBlocked, "recipient %s", recipient)
+	}
+
+	tokenContract, err := types.NewEthAddress(deposit.TokenContract)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid token contract")
+	}
+	if isCosmosOriginated, _ := k.ERC20ToDenomLookup(ctx, *tokenContract); !isCosmosOriginated {
+		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.Coins{deposit.Amount}); err != nil {
+			return sdkerrors.Wrapf(err, "mint vouchers coins: %s", deposit.Amount)
+		}
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, sdk.Coins{deposit.Amount}); err != nil {
+		return sdkerrors.Wrap(err, "release")
+	}
+	deposit.ReleasedTo = recipient
+
+	k.store(ctx).Delete(types.GetQuarantinedDepositKey(eventNonce))
+	if receipt := k.GetDepositReceipt(ctx, eventNonce); receipt != nil {
+		receipt.ReleasedTo = deposit.ReleasedTo
+		k.SetDepositReceipt(ctx, *receipt)
+	}
+	k.emitTypedEvent(ctx, &types.EventQuarantinedDepositReleased{Deposit: *deposit})
+	return nil
+}
+
+// SetQuarantinedDeposit stores a deposit held in quarantine
+func (k Keeper) SetQuarantinedDeposit(ctx sdk.Context, deposit types.DepositReceipt) {
+	k.store(ctx).Set(types.GetQuarantinedDepositKey(deposit.EventNonce), k.cdc.MustMarshal(&deposit))
+}
+
+// GetQuarantinedDeposit returns the deposit held in quarantine at an event nonce, nil if there is none
+func (k Keeper) GetQuarantinedDeposit(ctx sdk.Context, eventNonce uint64) *types.DepositReceipt {
+	bz := k.store(ctx).Get(types.GetQuarantinedDepositKey(eventNonce))
+	if bz == nil {
+		return nil
+	}
+	var deposit types.DepositReceipt
+	k.cdc.MustUnmarshal(bz, &deposit)
+	return &deposit
+}
+
+// GetQuarantinedDeposits returns every deposit held in quarantine in ASC event nonce order
+func (k Keeper) GetQuarantinedDeposits(ctx sdk.Context) (out []*types.DepositReceipt) {
+	prefixStore := prefix.NewStore(k.store(ctx), types.QuarantinedDepositKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var deposit types.DepositReceipt
+		k.cdc.MustUnmarshal(iter.Value(), &deposit)
+		out = append(out, &deposit)
+	}
+	return
+}
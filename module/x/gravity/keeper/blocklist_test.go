@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+func TestUpdateBlocklist(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+
+	// Ethereum addresses are blocked whatever their case
+	require.NoError(t, k.UpdateBlocklist(ctx, []string{EthAddrs[0].String(), AccAddrs[0].String()}, nil))
+	require.True(t, k.IsBlockedAddress(ctx, strings.ToLower(EthAddrs[0].String())))
+	require.True(t, k.IsBlockedAddress(ctx, AccAddrs[0].String()))
+	require.False(t, k.IsBlockedAddress(ctx, EthAddrs[1].String()))
+	require.False(t, k.IsBlockedAddress(ctx, "cosmos1typo"))
+	require.Len(t, k.GetBlockedAddresses(ctx), 2)
+
+	require.NoError(t, k.UpdateBlocklist(ctx, nil, []string{EthAddrs[0].String()}))
+	require.False(t, k.IsBlockedAddress(ctx, EthAddrs[0].String()))
+	require.Equal(t, []string{AccAddrs[0].String()}, k.GetBlockedAddresses(ctx))
+
+	require.Error(t, k.UpdateBlocklist(ctx, []string{"0x1"}, nil))
+}
+
+//nolint: exhaustivestruct
+func TestSendToEthBlocked(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	msgServer := NewMsgServerImpl(k)
+	msg := &types.MsgSendToEth{
+		Sender:    AccAddrs[0].String(),
+		EthDest:   EthAddrs[0].String(),
+		Amount:    sdk.NewInt64Coin("stake", 100),
+		BridgeFee: sdk.NewInt64Coin("stake", 10),
+	}
+
+	require.NoError(t, k.UpdateBlocklist(ctx, []string{AccAddrs[0].String()}, nil))
+	_, err := msgServer.SendToEth(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorIs(t, err, types.ErrBlocked)
+
+	require.NoError(t, k.UpdateBlocklist(ctx, []string{EthAddrs[0].String()}, []string{AccAddrs[0].String()}))
+	_, err = msgServer.SendToEth(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorIs(t, err, types.ErrBlocked)
+}
+
+//nolint: exhaustivestruct
+func TestDepositQuarantined(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	deposit := func(eventNonce uint64, sender string) *types.DepositReceipt {
+		claim := types.MsgSendToCosmosClaim{
+			EventNonce:     eventNonce,
+			BlockHeight:    eventNonce,
+			TokenContract:  TokenContractAddrs[0],
+			Amount:         sdk.NewInt(500),
+			EthereumSender: sender,
+			CosmosReceiver: AccAddrs[0].String(),
+			Orchestrator:   AccAddrs[0].String(),
+		}
+		require.NoError(t, k.AttestationHandler.Handle(ctx, types.Attestation{}, &claim))
+		return k.GetDepositReceipt(ctx, eventNonce)
+	}
+	require.NoError(t, k.UpdateBlocklist(ctx, []string{EthAddrs[0].String()}, nil))
+
+	// the deposit of a blocked sender is held without minting any vouchers
+	receipt := deposit(1, EthAddrs[0].String())
+	require.False(t, receipt.Success)
+	require.True(t, receipt.Quarantined)
+	require.Equal(t, receipt, k.GetQuarantinedDeposit(ctx, 1))
+	require.True(t, input.BankKeeper.GetSupply(ctx, receipt.Amount.Denom).Amount.IsZero())
+
+	// deposits of other senders are credited
+	require.True(t, deposit(2, EthAddrs[1].String()).Success)
+	require.Len(t, k.GetQuarantinedDeposits(ctx), 1)
+
+	// blocked accounts can not be paid the deposit
+	require.NoError(t, k.UpdateBlocklist(ctx, []string{AccAddrs[1].String()}, nil))
+	require.ErrorIs(t, k.ReleaseQuarantinedDeposit(ctx, 1, AccAddrs[1].String()), types.ErrBlocked)
+
+	// released the deposit is paid to its Cosmos receiver
+	require.NoError(t, k.ReleaseQuarantinedDeposit(ctx, 1, ""))
+	require.Nil(t, k.GetQuarantinedDeposit(ctx, 1))
+	require.Equal(t, sdk.NewInt(1000), input.BankKeeper.GetBalance(ctx, AccAddrs[0], receipt.Amount.Denom).Amount)
+	require.Equal(t, AccAddrs[0].String(), k.GetDepositReceipt(ctx, 1).ReleasedTo)
+	require.Error(t, k.ReleaseQuarantinedDeposit(ctx, 1, ""))
+}
@@ -0,0 +1,213 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// FlagConflictingClaims looks through the attestations at a single event nonce and, once one of them
+// has been observed, records every validator that voted for a different claim. Validators can only
+// vote once per event nonce, so a vote for any other claim means the validator's Ethereum node
+// reported something that did not happen. Each validator is only recorded, and slashed, once per nonce.
+func (k Keeper) FlagConflictingClaims(ctx sdk.Context, atts []types.Attestation) {
+	if len(atts) < 2 {
+		return
+	}
+
+	hashes := make([][]byte, len(atts))
+	var observedHash []byte
+	var eventNonce uint64
+	for i := range atts {
+		claim, err := k.UnpackAttestationClaim(&atts[i])
+		if err != nil {
+			panic("could not cast to claim")
+		}
+		hash, err := claim.ClaimHash()
+		if err != nil {
+			panic(sdkerrors.Wrap(err, "unable to compute claim hash"))
+		}
+		hashes[i] = hash
+		eventNonce = claim.GetEventNonce()
+		// the attestations passed in may predate this block's tally, the store has the current state
+		if stored := k.GetAttestation(ctx, eventNonce, hash); stored != nil && stored.Observed {
+			observedHash = hash
+		}
+	}
+	if observedHash == nil {
+		return
+	}
+
+	for i, att := range atts {
+		if string(hashes[i]) == string(observedHash) {
+			continue
+		}
+		for _, voter := range att.Votes {
+			val, err := sdk.ValAddressFromBech32(voter)
+			if err != nil {
+				panic(err)
+			}
+			if k.GetConflictingClaim(ctx, eventNonce, val) != nil {
+				continue
+			}
+			k.handleConflictingClaim(ctx, types.ConflictingClaim{
+				EventNonce:        eventNonce,
+				Validator:         voter,
+				ClaimHash:         hashes[i],
+				ObservedClaimHash: observedHash,
+				Height:            uint64(ctx.BlockHeight()),
+			})
+		}
+	}
+}
+
+// flagConflictingVote records a vote cast for a claim at an event nonce that was already checked for
+// conflicts, when another claim was observed at that nonce
+func (k Keeper) flagConflictingVote(ctx sdk.Context, eventNonce uint64, claimHash []byte, validator sdk.ValAddress) {
+	if k.GetConflictingClaim(ctx, eventNonce, validator) != nil {
+		return
+	}
+	nonceKey := append(append([]byte{}, types.OracleAttestationKey...), types.UInt64Bytes(eventNonce)...)
+	prefixStore := prefix.NewStore(k.store(ctx), nonceKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var att types.Attestation
+		k.cdc.MustUnmarshal(iter.Value(), &att)
+		// the rest of the key is the claim hash
+		if !att.Observed || string(iter.Key()) == string(claimHash) {
+			continue
+		}
+		k.handleConflictingClaim(ctx, types.ConflictingClaim{
+			EventNonce:        eventNonce,
+			Validator:         validator.String(),
+			ClaimHash:         claimHash,
+			ObservedClaimHash: iter.Key(),
+			Height:            uint64(ctx.BlockHeight()),
+		})
+		return
+	}
+}
+
+// SetLastConflictCheckedNonce sets the latest observed event nonce checked for conflicting claims
+func (k Keeper) SetLastConflictCheckedNonce(ctx sdk.Context, nonce uint64) {
+	store := k.store(ctx)
+	store.Set(types.LastConflictCheckedNonceKey, types.UInt64Bytes(nonce))
+}
+
+// GetLastConflictCheckedNonce returns the latest observed event nonce checked for conflicting claims
+func (k Keeper) GetLastConflictCheckedNonce(ctx sdk.Context) uint64 {
+	store := k.store(ctx)
+	bytes := store.Get(types.LastConflictCheckedNonceKey)
+
+	if len(bytes) == 0 {
+		return 0
+	}
+	return types.UInt64FromBytes(bytes)
+}
+
+// handleConflictingClaim stores the record, emits an event and slashes the validator if
+// SlashFractionConflictingClaim is set and the chain slashes claims
+func (k Keeper) handleConflictingClaim(ctx sdk.Context, conflict types.ConflictingClaim) {
+	k.SetConflictingClaim(ctx, conflict)
+
+	k.logger(ctx).Error("validator voted for a conflicting claim",
+		"validator", conflict.Validator,
+		"event nonce", fmt.Sprint(conflict.EventNonce),
+	)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConflictingClaim,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(conflict.EventNonce)),
+			sdk.NewAttribute(types.AttributeKeyValidator, conflict.Validator),
+			sdk.NewAttribute(types.AttributeKeyClaimHash, hex.EncodeToString(conflict.ClaimHash)),
+			sdk.NewAttribute(types.AttributeKeyObservedClaimHash, hex.EncodeToString(conflict.ObservedClaimHash)),
+		),
+	)
+	k.emitTypedEvent(ctx, &types.EventConflictingClaim{Conflict: conflict})
+
+	slashFraction := k.GetParams(ctx).SlashFractionConflictingClaim
+	if slashFraction.IsNil() || !slashFraction.IsPositive() || !k.ClaimSlashingEnabled() {
+		return
+	}
+	valAddr, err := sdk.ValAddressFromBech32(conflict.Validator)
+	if err != nil {
+		panic(err)
+	}
+	val, found := k.StakingKeeper.GetValidator(ctx, valAddr)
+	// the validator may have left the set entirely since voting, the staking module can't slash it then
+	if !found || val.IsUnbonded() {
+		return
+	}
+	cons, err := val.GetConsAddr()
+	if err != nil {
+		k.logger(ctx).Error("could not get consensus key address for validator", "validator", conflict.Validator, "err", err)
+		return
+	}
+	powerReduction := k.StakingKeeper.PowerReduction(ctx)
+	k.StakingKeeper.Slash(ctx, cons, ctx.BlockHeight(), val.ConsensusPower(powerReduction), slashFraction)
+	if !val.IsJailed() {
+		k.StakingKeeper.Jail(ctx, cons)
+	}
+}
+
+// SetConflictingClaim records a validator's vote for a conflicting claim
+func (k Keeper) SetConflictingClaim(ctx sdk.Context, conflict types.ConflictingClaim) {
+	valAddr, err := sdk.ValAddressFromBech32(conflict.Validator)
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "invalid validator address in conflicting claim"))
+	}
+	store := k.store(ctx)
+	store.Set(types.GetConflictingClaimKey(conflict.EventNonce, valAddr), k.cdc.MustMarshal(&conflict))
+}
+
+// GetConflictingClaim returns the conflicting claim a validator voted for at an event nonce, nil if there is none
+func (k Keeper) GetConflictingClaim(ctx sdk.Context, eventNonce uint64, validator sdk.ValAddress) *types.ConflictingClaim {
+	store := k.store(ctx)
+	bz := store.Get(types.GetConflictingClaimKey(eventNonce, validator))
+	if bz == nil {
+		return nil
+	}
+	var conflict types.ConflictingClaim
+	k.cdc.MustUnmarshal(bz, &conflict)
+	return &conflict
+}
+
+// IterateConflictingClaims iterates through the conflicting claims under a key prefix in ASC event nonce order
+func (k Keeper) IterateConflictingClaims(ctx sdk.Context, keyPrefix []byte, cb func(key []byte, conflict *types.ConflictingClaim) bool) {
+	prefixStore := prefix.NewStore(k.store(ctx), keyPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var conflict types.ConflictingClaim
+		k.cdc.MustUnmarshal(iter.Value(), &conflict)
+		// cb returns true to stop early
+		if cb(iter.Key(), &conflict) {
+			break
+		}
+	}
+}
+
+// GetConflictingClaims returns all recorded conflicting claims
+func (k Keeper) GetConflictingClaims(ctx sdk.Context) (out []*types.ConflictingClaim) {
+	k.IterateConflictingClaims(ctx, types.ConflictingClaimKey, func(_ []byte, conflict *types.ConflictingClaim) bool {
+		out = append(out, conflict)
+		return false
+	})
+	return
+}
+
+// GetConflictingClaimsByNonce returns the conflicting claims recorded at an event nonce
+func (k Keeper) GetConflictingClaimsByNonce(ctx sdk.Context, eventNonce uint64) (out []*types.ConflictingClaim) {
+	k.IterateConflictingClaims(ctx, types.GetConflictingClaimNonceKey(eventNonce), func(_ []byte, conflict *types.ConflictingClaim) bool {
+		out = append(out, conflict)
+		return false
+	})
+	return
+}
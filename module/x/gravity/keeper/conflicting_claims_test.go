@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+func TestFlagConflictingClaimsSlashing(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+
+	params := k.GetParams(ctx)
+	params.SlashFractionConflictingClaim = sdk.NewDecWithPrec(5, 1)
+	k.SetParams(ctx, params)
+
+	attestation := func(amount int64, observed bool, voters ...sdk.ValAddress) (types.Attestation, []byte) {
+		claim := types.MsgSendToCosmosClaim{
+			EventNonce:     1,
+			BlockHeight:    1,
+			TokenContract:  TokenContractAddrs[0],
+			Amount:         sdk.NewInt(amount),
+			EthereumSender: EthAddrs[0].String(),
+			CosmosReceiver: AccAddrs[0].String(),
+			Orchestrator:   AccAddrs[0].String(),
+		}
+		any, err := codectypes.NewAnyWithValue(&claim)
+		require.NoError(t, err)
+		att := types.Attestation{Observed: observed, Height: uint64(ctx.BlockHeight()), Claim: any}
+		for _, voter := range voters {
+			att.Votes = append(att.Votes, voter.String())
+		}
+		hash, err := claim.ClaimHash()
+		require.NoError(t, err)
+		k.SetAttestation(ctx, 1, hash, &att)
+		return att, hash
+	}
+
+	honest, honestHash := attestation(1000, false, ValAddrs[0], ValAddrs[1], ValAddrs[2], ValAddrs[3])
+	faulty, faultyHash := attestation(2000, false, ValAddrs[4])
+
+	// nothing is flagged until one of the claims is observed
+	k.FlagConflictingClaims(ctx, []types.Attestation{honest, faulty})
+	require.Empty(t, k.GetConflictingClaims(ctx))
+
+	honest.Observed = true
+	k.SetAttestation(ctx, 1, honestHash, &honest)
+	tokensBefore := input.StakingKeeper.Validator(ctx, ValAddrs[4]).GetTokens()
+	k.FlagConflictingClaims(ctx, []types.Attestation{honest, faulty})
+
+	conflict := k.GetConflictingClaim(ctx, 1, ValAddrs[4])
+	require.NotNil(t, conflict)
+	require.Equal(t, faultyHash, conflict.ClaimHash)
+	require.Equal(t, honestHash, conflict.ObservedClaimHash)
+	require.Nil(t, k.GetConflictingClaim(ctx, 1, ValAddrs[0]))
+
+	val := input.StakingKeeper.Validator(ctx, ValAddrs[4])
+	require.True(t, val.IsJailed())
+	require.True(t, val.GetTokens().LT(tokensBefore))
+
+	// a validator is slashed only once per nonce
+	tokensBefore = val.GetTokens()
+	k.FlagConflictingClaims(ctx, []types.Attestation{honest, faulty})
+	require.Equal(t, tokensBefore, input.StakingKeeper.Validator(ctx, ValAddrs[4]).GetTokens())
+	require.Len(t, k.GetConflictingClaims(ctx), 1)
+}
+
+// A conflicting claim on an EVM chain that did not opt in to claim slashing is only recorded
+func TestFlagConflictingClaimsEvmChainOptIn(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	params := input.GravityKeeper.GetParams(ctx)
+	params.SlashFractionConflictingClaim = sdk.NewDecWithPrec(5, 1)
+	input.GravityKeeper.SetParams(ctx, params)
+	k := registerTestEvmChain(t, ctx, input.GravityKeeper)
+
+	attestation := func(amount int64, voter sdk.ValAddress) types.Attestation {
+		claim := types.MsgSendToCosmosClaim{
+			EventNonce:     1,
+			BlockHeight:    1,
+			TokenContract:  TokenContractAddrs[0],
+			Amount:         sdk.NewInt(amount),
+			EthereumSender: EthAddrs[0].String(),
+			CosmosReceiver: AccAddrs[0].String(),
+			Orchestrator:   AccAddrs[0].String(),
+			ChainId:        testEvmChain.ChainId,
+		}
+		any, err := codectypes.NewAnyWithValue(&claim)
+		require.NoError(t, err)
+		att := types.Attestation{Observed: amount == 1000, Height: uint64(ctx.BlockHeight()), Claim: any, Votes: []string{voter.String()}}
+		hash, err := claim.ClaimHash()
+		require.NoError(t, err)
+		k.SetAttestation(ctx, 1, hash, &att)
+		return att
+	}
+	honest := attestation(1000, ValAddrs[0])
+	faulty := attestation(2000, ValAddrs[4])
+	tokensBefore := input.StakingKeeper.Validator(ctx, ValAddrs[4]).GetTokens()
+
+	k.FlagConflictingClaims(ctx, []types.Attestation{honest, faulty})
+	require.NotNil(t, k.GetConflictingClaim(ctx, 1, ValAddrs[4]))
+	val := input.StakingKeeper.Validator(ctx, ValAddrs[4])
+	require.False(t, val.IsJailed())
+	require.Equal(t, tokensBefore, val.GetTokens())
+}
+
+// A vote at a nonce the end blocker already checked is flagged when it is cast
+func TestLateConflictingVote(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+
+	claim := func(amount int64, orchestrator sdk.AccAddress) (*types.MsgSendToCosmosClaim, *codectypes.Any) {
+		msg := &types.MsgSendToCosmosClaim{
+			EventNonce:     1,
+			BlockHeight:    1,
+			TokenContract:  TokenContractAddrs[0],
+			Amount:         sdk.NewInt(amount),
+			EthereumSender: EthAddrs[0].String(),
+			CosmosReceiver: AccAddrs[0].String(),
+			Orchestrator:   orchestrator.String(),
+		}
+		any, err := codectypes.NewAnyWithValue(msg)
+		require.NoError(t, err)
+		return msg, any
+	}
+
+	honest, any := claim(1000, AccAddrs[0])
+	honestHash, err := honest.ClaimHash()
+	require.NoError(t, err)
+	att := types.Attestation{Observed: true, Votes: []string{ValAddrs[0].String()}, Height: uint64(ctx.BlockHeight()), Claim: any}
+	k.SetAttestation(ctx, 1, honestHash, &att)
+	k.SetLastConflictCheckedNonce(ctx, 1)
+
+	// a vote for the observed claim is no conflict
+	k.SetOrchestratorValidator(ctx, ValAddrs[1], AccAddrs[1])
+	honest, any = claim(1000, AccAddrs[1])
+	_, err = k.Attest(ctx, honest, any)
+	require.NoError(t, err)
+	require.Empty(t, k.GetConflictingClaims(ctx))
+
+	k.SetOrchestratorValidator(ctx, ValAddrs[4], AccAddrs[4])
+	faulty, any := claim(2000, AccAddrs[4])
+	_, err = k.Attest(ctx, faulty, any)
+	require.NoError(t, err)
+	conflict := k.GetConflictingClaim(ctx, 1, ValAddrs[4])
+	require.NotNil(t, conflict)
+	require.Equal(t, honestHash, conflict.ObservedClaimHash)
+	require.Len(t, k.GetConflictingClaims(ctx), 1)
+}
@@ -6,12 +6,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
 )
 
 func (k Keeper) GetCosmosOriginatedDenom(ctx sdk.Context, tokenContract types.EthAddress) (string, bool) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	bz := store.Get(types.GetERC20ToDenomKey(tokenContract))
 
 	if bz != nil {
@@ -21,7 +22,7 @@ func (k Keeper) GetCosmosOriginatedDenom(ctx sdk.Context, tokenContract types.Et
 }
 
 func (k Keeper) GetCosmosOriginatedERC20(ctx sdk.Context, denom string) (*types.EthAddress, bool) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	bz := store.Get(types.GetDenomToERC20Key(denom))
 	if bz != nil {
 		ethAddr, err := types.NewEthAddress(string(bz))
@@ -35,7 +36,7 @@ func (k Keeper) GetCosmosOriginatedERC20(ctx sdk.Context, denom string) (*types.
 }
 
 func (k Keeper) setCosmosOriginatedDenomToERC20(ctx sdk.Context, denom string, tokenContract types.EthAddress) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	store.Set(types.GetDenomToERC20Key(denom), []byte(tokenContract.GetAddress()))
 	store.Set(types.GetERC20ToDenomKey(tokenContract), []byte(denom))
 }
@@ -47,7 +48,7 @@ func (k Keeper) setCosmosOriginatedDenomToERC20(ctx sdk.Context, denom string, t
 // in an index of ERC20 contracts deployed on Ethereum to serve as synthetic Cosmos assets.
 func (k Keeper) DenomToERC20Lookup(ctx sdk.Context, denom string) (bool, *types.EthAddress, error) {
 	// First try parsing the ERC20 out of the denom
-	tc1, err := types.GravityDenomToERC20(denom)
+	tc1, err := k.gravityDenomToERC20(denom)
 
 	if err != nil {
 		// Look up ERC20 contract in index and error if it's not in there.
@@ -97,12 +98,30 @@ func (k Keeper) ERC20ToDenomLookup(ctx sdk.Context, tokenContract types.EthAddre
 	}
 
 	// If it is not in there, it is not a cosmos originated token, turn the ERC20 into a gravity denom
-	return false, types.GravityDenom(tokenContract)
+	return false, k.gravityDenom(tokenContract)
+}
+
+// gravityDenom returns the denom of the vouchers of an Ethereum originated token of the chain the keeper
+// is scoped to
+func (k Keeper) gravityDenom(tokenContract types.EthAddress) string {
+	if k.evmChain == nil {
+		return types.GravityDenom(tokenContract)
+	}
+	return types.EvmChainGravityDenom(k.evmChain.ChainId, tokenContract)
+}
+
+// gravityDenomToERC20 parses the token contract out of a voucher denom of the chain the keeper is scoped to,
+// vouchers of other chains are not parsed
+func (k Keeper) gravityDenomToERC20(denom string) (*types.EthAddress, error) {
+	if k.evmChain == nil {
+		return types.GravityDenomToERC20(denom)
+	}
+	return types.EvmChainGravityDenomToERC20(k.evmChain.ChainId, denom)
 }
 
 // IterateERC20ToDenom iterates over erc20 to denom relations
 func (k Keeper) IterateERC20ToDenom(ctx sdk.Context, cb func([]byte, *types.ERC20ToDenom) bool) {
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ERC20ToDenomKey)
+	prefixStore := prefix.NewStore(k.store(ctx), types.ERC20ToDenomKey)
 	iter := prefixStore.Iterator(nil, nil)
 	defer iter.Close()
 
@@ -117,3 +136,73 @@ func (k Keeper) IterateERC20ToDenom(ctx sdk.Context, cb func([]byte, *types.ERC2
 		}
 	}
 }
+
+// GetCosmosOriginatedLocked returns the amount of a Cosmos originated denom locked for the EVM chain the keeper
+// is scoped to, the coins bridged to the chain that its deposits may unlock
+func (k Keeper) GetCosmosOriginatedLocked(ctx sdk.Context, denom string) sdk.Int {
+	bz := k.store(ctx).Get(types.GetCosmosOriginatedLockedKey(denom))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+	var locked sdk.Coin
+	k.cdc.MustUnmarshal(bz, &locked)
+	return locked.Amount
+}
+
+// GetCosmosOriginatedLockedCoins returns every Cosmos originated coin locked for the EVM chain the keeper is
+// scoped to
+func (k Keeper) GetCosmosOriginatedLockedCoins(ctx sdk.Context) sdk.Coins {
+	prefixStore := prefix.NewStore(k.store(ctx), types.CosmosOriginatedLockedKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	locked := sdk.Coins{}
+	for ; iter.Valid(); iter.Next() {
+		var coin sdk.Coin
+		k.cdc.MustUnmarshal(iter.Value(), &coin)
+		locked = locked.Add(coin)
+	}
+	return locked
+}
+
+// setCosmosOriginatedLocked stores the amount of a Cosmos originated denom locked for the EVM chain the keeper
+// is scoped to, a zero amount removes the entry
+func (k Keeper) setCosmosOriginatedLocked(ctx sdk.Context, locked sdk.Coin) {
+	key := types.GetCosmosOriginatedLockedKey(locked.Denom)
+	if locked.IsZero() {
+		k.store(ctx).Delete(key)
+		return
+	}
+	k.store(ctx).Set(key, k.cdc.MustMarshal(&locked))
+}
+
+// lockCosmosOriginated records Cosmos originated coins bridged to the EVM chain the keeper is scoped to,
+// nothing is recorded for the default chain
+func (k Keeper) lockCosmosOriginated(ctx sdk.Context, coin sdk.Coin) {
+	if k.evmChain == nil {
+		return
+	}
+	k.setCosmosOriginatedLocked(ctx, coin.AddAmount(k.GetCosmosOriginatedLocked(ctx, coin.Denom)))
+}
+
+// unlockCosmosOriginated records Cosmos originated coins coming back from the chain the keeper is scoped to.
+// Every chain unlocks its coins from the same module account, so an EVM chain can't unlock more than was
+// locked for it and the default chain, which keeps no record, can't unlock the coins locked for the others.
+func (k Keeper) unlockCosmosOriginated(ctx sdk.Context, coin sdk.Coin) error {
+	var locked sdk.Int
+	if k.evmChain != nil {
+		locked = k.GetCosmosOriginatedLocked(ctx, coin.Denom)
+	} else {
+		locked = k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName)).AmountOf(coin.Denom)
+		for _, chain := range k.EvmChainKeepers(ctx)[1:] {
+			locked = locked.Sub(chain.GetCosmosOriginatedLocked(ctx, coin.Denom))
+		}
+	}
+	if coin.Amount.GT(locked) {
+		return sdkerrors.Wrapf(types.ErrInvalid, "%s exceeds the %s%s locked for the chain", coin, locked, coin.Denom)
+	}
+	if k.evmChain != nil {
+		k.setCosmosOriginatedLocked(ctx, sdk.NewCoin(coin.Denom, locked.Sub(coin.Amount)))
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// SetDepositReceipt stores the receipt of a deposit along with its receiver, Ethereum sender and
+// pruning indexes
+func (k Keeper) SetDepositReceipt(ctx sdk.Context, receipt types.DepositReceipt) {
+	sender, err := types.NewEthAddress(receipt.EthereumSender)
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "invalid ethereum sender in deposit receipt"))
+	}
+	store := k.store(ctx)
+	store.Set(types.GetDepositReceiptKey(receipt.EventNonce), k.cdc.MustMarshal(&receipt))
+	store.Set(types.GetDepositReceiptByReceiverKey(receipt.CosmosReceiver, receipt.EventNonce), []byte{})
+	store.Set(types.GetDepositReceiptByEthereumSenderKey(*sender, receipt.EventNonce), []byte{})
+	store.Set(types.GetDepositReceiptByHeightKey(receipt.Height, receipt.EventNonce), []byte{})
+}
+
+// GetDepositReceipt returns the receipt of the deposit observed at an event nonce, nil if there is none
+func (k Keeper) GetDepositReceipt(ctx sdk.Context, eventNonce uint64) *types.DepositReceipt {
+	bz := k.store(ctx).Get(types.GetDepositReceiptKey(eventNonce))
+	if bz == nil {
+		return nil
+	}
+	var receipt types.DepositReceipt
+	k.cdc.MustUnmarshal(bz, &receipt)
+	return &receipt
+}
+
+// DeleteDepositReceipt removes the receipt of a deposit and its indexes
+func (k Keeper) DeleteDepositReceipt(ctx sdk.Context, receipt types.DepositReceipt) {
+	sender, err := types.NewEthAddress(receipt.EthereumSender)
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "invalid ethereum sender in deposit receipt"))
+	}
+	store := k.store(ctx)
+	store.Delete(types.GetDepositReceiptKey(receipt.EventNonce))
+	store.Delete(types.GetDepositReceiptByReceiverKey(receipt.CosmosReceiver, receipt.EventNonce))
+	store.Delete(types.GetDepositReceiptByEthereumSenderKey(*sender, receipt.EventNonce))
+	store.Delete(types.GetDepositReceiptByHeightKey(receipt.Height, receipt.EventNonce))
+}
+
+// IterateDepositReceipts iterates through all deposit receipts in ASC event nonce order
+func (k Keeper) IterateDepositReceipts(ctx sdk.Context, cb func(key []byte, receipt *types.DepositReceipt) bool) {
+	prefixStore := prefix.NewStore(k.store(ctx), types.DepositReceiptKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var receipt types.DepositReceipt
+		k.cdc.MustUnmarshal(iter.Value(), &receipt)
+		// cb returns true to stop early
+		if cb(iter.Key(), &receipt) {
+			break
+		}
+	}
+}
+
+// GetDepositReceipts returns the receipt of every deposit that has not been pruned
+func (k Keeper) GetDepositReceipts(ctx sdk.Context) (out []*types.DepositReceipt) {
+	k.IterateDepositReceipts(ctx, func(_ []byte, receipt *types.DepositReceipt) bool {
+		out = append(out, receipt)
+		return false
+	})
+	return
+}
+
+// GetDepositReceiptsByReceiver returns the receipt of every deposit to a Cosmos receiver that has not
+// been pruned, in ASC event nonce order
+func (k Keeper) GetDepositReceiptsByReceiver(ctx sdk.Context, receiver string) []*types.DepositReceipt {
+	return k.getIndexedDepositReceipts(ctx, types.GetDepositReceiptByReceiverPrefix(receiver))
+}
+
+// GetDepositReceiptsByEthereumSender returns the receipt of every deposit from an Ethereum sender that
+// has not been pruned, in ASC event nonce order
+func (k Keeper) GetDepositReceiptsByEthereumSender(ctx sdk.Context, sender types.EthAddress) []*types.DepositReceipt {
+	return k.getIndexedDepositReceipts(ctx, types.GetDepositReceiptByEthereumSenderPrefix(sender))
+}
+
+// getIndexedDepositReceipts returns the receipts of an index whose keys end in the event nonce
+func (k Keeper) getIndexedDepositReceipts(ctx sdk.Context, indexPrefix []byte) (out []*types.DepositReceipt) {
+	prefixStore := prefix.NewStore(k.store(ctx), indexPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		receipt := k.GetDepositReceipt(ctx, types.UInt64FromBytes(iter.Key()))
+		if receipt == nil {
+			panic("deposit receipt index points at a missing receipt")
+		}
+		out = append(out, receipt)
+	}
+	return
+}
+
+// PruneDepositReceipts deletes the receipt of every deposit that was observed more than
+// DepositReceiptRetention blocks ago
+func (k Keeper) PruneDepositReceipts(ctx sdk.Context) {
+	retention := k.GetParams(ctx).DepositReceiptRetention
+	if uint64(ctx.BlockHeight()) <= retention {
+		return
+	}
+	cutoff := uint64(ctx.BlockHeight()) - retention
+
+	var nonces []uint64
+	prefixStore := prefix.NewStore(k.store(ctx), types.DepositReceiptByHeightKey)
+	iter := prefixStore.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		// the key is the observed height followed by the event nonce
+		if types.UInt64FromBytes(iter.Key()[:8]) > cutoff {
+			break
+		}
+		nonces = append(nonces, types.UInt64FromBytes(iter.Key()[8:]))
+	}
+	iter.Close()
+
+	for _, nonce := range nonces {
+		receipt := k.GetDepositReceipt(ctx, nonce)
+		if receipt == nil {
+			panic("deposit receipt pruning index points at a missing receipt")
+		}
+		k.DeleteDepositReceipt(ctx, *receipt)
+	}
+}
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+func TestDepositReceipts(t *testing.T) {
+	input := CreateTestEnv(t)
+	orchestrators, _ := setupAttestationVoters(&input, MockStakingValidatorData{Operator: ValAddrs[0], Power: 100})
+	ctx := input.Context
+	k := input.GravityKeeper
+	sender, err := types.NewEthAddress(EthAddrs[0].String())
+	require.NoError(t, err)
+	token, err := types.NewInternalERC20Token(sdk.NewInt(1000), TokenContractAddrs[0])
+	require.NoError(t, err)
+
+	att := attestDeposit(t, input, orchestrators[0])
+	require.True(t, att.Observed)
+	require.Equal(t, &types.DepositReceipt{
+		EventNonce:     1,
+		EthereumHeight: 1,
+		TokenContract:  token.Contract.GetAddress(),
+		Amount:         token.GravityCoin(),
+		EthereumSender: EthAddrs[0].String(),
+		CosmosReceiver: AccAddrs[0].String(),
+		Success:        true,
+		Height:         ctx.BlockHeight(),
+	}, k.GetDepositReceipt(ctx, 1))
+
+	// a deposit that can not be credited is observed all the same and leaves a receipt with the reason
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+	claim := types.MsgSendToCosmosClaim{
+		EventNonce:     2,
+		BlockHeight:    2,
+		TokenContract:  TokenContractAddrs[0],
+		Amount:         sdk.NewInt(500),
+		EthereumSender: EthAddrs[0].String(),
+		CosmosReceiver: "cosmos1invalid",
+		Orchestrator:   orchestrators[0].String(),
+	}
+	require.NoError(t, k.AttestationHandler.Handle(ctx, types.Attestation{}, &claim))
+	failed := k.GetDepositReceipt(ctx, 2)
+	require.NotNil(t, failed)
+	require.False(t, failed.Success)
+	require.Contains(t, failed.Error, "invalid receiver address")
+	require.Equal(t, ctx.BlockHeight(), failed.Height)
+	events := typedEvents(t, ctx, &types.EventDepositFailed{})
+	require.Len(t, events, 1)
+	require.Equal(t, *failed, events[0].(*types.EventDepositFailed).Receipt)
+	// the vouchers minted for the failed deposit are rolled back
+	require.True(t, input.BankKeeper.GetSupply(ctx, token.GravityCoin().Denom).Amount.Equal(sdk.NewInt(1000)))
+
+	res, err := k.DepositReceiptsByReceiver(sdk.WrapSDKContext(ctx), &types.QueryDepositReceiptsByReceiverRequest{Receiver: "cosmos1invalid"})
+	require.NoError(t, err)
+	require.Equal(t, []*types.DepositReceipt{failed}, res.Receipts)
+	require.Len(t, k.GetDepositReceiptsByReceiver(ctx, AccAddrs[0].String()), 1)
+	bySender, err := k.DepositReceiptsByEthereumSender(sdk.WrapSDKContext(ctx), &types.QueryDepositReceiptsByEthereumSenderRequest{EthereumSender: EthAddrs[0].String()})
+	require.NoError(t, err)
+	require.Len(t, bySender.Receipts, 2)
+	require.Equal(t, uint64(1), bySender.Receipts[0].EventNonce)
+	require.Equal(t, uint64(2), bySender.Receipts[1].EventNonce)
+
+	// the first receipt is pruned first
+	retention := int64(k.GetParams(ctx).DepositReceiptRetention)
+	k.PruneDepositReceipts(ctx.WithBlockHeight(ctx.BlockHeight() + retention - 1))
+	require.Nil(t, k.GetDepositReceipt(ctx, 1))
+	require.NotNil(t, k.GetDepositReceipt(ctx, 2))
+	require.Len(t, k.GetDepositReceiptsByEthereumSender(ctx, *sender), 1)
+	require.Empty(t, k.GetDepositReceiptsByReceiver(ctx, AccAddrs[0].String()))
+
+	k.PruneDepositReceipts(ctx.WithBlockHeight(ctx.BlockHeight() + retention))
+	require.Empty(t, k.GetDepositReceipts(ctx))
+	require.Empty(t, k.GetDepositReceiptsByEthereumSender(ctx, *sender))
+}
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// SetERC20Metadata registers the bank denom metadata of the vouchers of an Ethereum originated ERC20 of the chain
+// the keeper is scoped to, replacing any metadata registered before. Cosmos originated tokens keep the metadata
+// of their Cosmos denom.
+func (k Keeper) SetERC20Metadata(ctx sdk.Context, tokenContract types.EthAddress, name, symbol string, decimals uint32, display string, aliases []string) error {
+	isCosmosOriginated, denom := k.ERC20ToDenomLookup(ctx, tokenContract)
+	if isCosmosOriginated {
+		return sdkerrors.Wrapf(types.ErrInvalid, "%s is the representation of cosmos denom %s", tokenContract.GetAddress(), denom)
+	}
+	metadata, err := types.NewERC20DenomMetadata(denom, name, symbol, decimals, display, aliases)
+	if err != nil {
+		return err
+	}
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+
+	k.emitTypedEvent(ctx, &types.EventERC20MetadataSet{
+		TokenContract: tokenContract.GetAddress(),
+		Denom:         denom,
+		Symbol:        metadata.Symbol,
+		Decimals:      decimals,
+		Display:       metadata.Display,
+	})
+	return nil
+}
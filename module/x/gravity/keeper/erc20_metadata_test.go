@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"testing"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+func TestSetERC20Metadata(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	tokenContract, err := types.NewEthAddress(TokenContractAddrs[0])
+	require.NoError(t, err)
+	denom := types.GravityDenom(*tokenContract)
+
+	// the display unit is named after the symbol and worth 10^decimals vouchers
+	require.NoError(t, k.SetERC20Metadata(ctx, *tokenContract, "Dai Stablecoin", "DAI", 18, "", []string{"dai-stablecoin"}))
+	metadata, found := input.BankKeeper.GetDenomMetaData(ctx, denom)
+	require.True(t, found)
+	require.Equal(t, denom, metadata.Base)
+	require.Equal(t, "DAI", metadata.Display)
+	require.Equal(t, "Dai Stablecoin", metadata.Name)
+	require.Equal(t, []*banktypes.DenomUnit{
+		{Denom: denom, Exponent: 0, Aliases: nil},
+		{Denom: "DAI", Exponent: 18, Aliases: []string{"dai-stablecoin"}},
+	}, metadata.DenomUnits)
+
+	// registering again replaces the metadata, tokens without decimals only have the base unit
+	require.NoError(t, k.SetERC20Metadata(ctx, *tokenContract, "Dai Stablecoin", "DAI", 0, "dai", nil))
+	metadata, _ = input.BankKeeper.GetDenomMetaData(ctx, denom)
+	require.Equal(t, denom, metadata.Display)
+	require.Equal(t, []*banktypes.DenomUnit{{Denom: denom, Exponent: 0, Aliases: []string{"dai"}}}, metadata.DenomUnits)
+
+	// invalid metadata is refused
+	require.Error(t, k.SetERC20Metadata(ctx, *tokenContract, "", "DAI", 18, "", nil))
+	require.Error(t, k.SetERC20Metadata(ctx, *tokenContract, "Dai Stablecoin", "DAI", 18, denom, nil))
+	require.Error(t, k.SetERC20Metadata(ctx, *tokenContract, "Dai Stablecoin", "DAI", 256, "", nil))
+
+	// vouchers with metadata can't be deployed as Cosmos originated tokens
+	deployed := types.MsgERC20DeployedClaim{
+		EventNonce:    1,
+		BlockHeight:   1,
+		CosmosDenom:   denom,
+		TokenContract: TokenContractAddrs[2],
+		Name:          denom,
+		Symbol:        denom,
+		Decimals:      0,
+		Orchestrator:  AccAddrs[0].String(),
+	}
+	require.ErrorIs(t, k.AttestationHandler.Handle(ctx, types.Attestation{}, &deployed), types.ErrInvalid)
+	_, exists := k.GetCosmosOriginatedERC20(ctx, denom)
+	require.False(t, exists)
+
+	// Cosmos originated tokens keep the metadata of their denom
+	cosmosContract, err := types.NewEthAddress(TokenContractAddrs[1])
+	require.NoError(t, err)
+	k.setCosmosOriginatedDenomToERC20(ctx, "stake", *cosmosContract)
+	require.ErrorIs(t, k.SetERC20Metadata(ctx, *cosmosContract, "Stake", "STAKE", 18, "", nil), types.ErrInvalid)
+	_, found = input.BankKeeper.GetDenomMetaData(ctx, "stake")
+	require.False(t, found)
+}
@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// SetEthereumHeightVote stores the latest Ethereum height reported by a validator
+func (k Keeper) SetEthereumHeightVote(ctx sdk.Context, vote types.EthereumHeightVote) {
+	val, err := sdk.ValAddressFromBech32(vote.Validator)
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "invalid validator in ethereum height vote"))
+	}
+	k.store(ctx).Set(types.GetEthereumHeightVoteKey(val), k.cdc.MustMarshal(&vote))
+}
+
+// GetEthereumHeightVote returns the latest Ethereum height reported by a validator, nil if there is none
+func (k Keeper) GetEthereumHeightVote(ctx sdk.Context, val sdk.ValAddress) *types.EthereumHeightVote {
+	bz := k.store(ctx).Get(types.GetEthereumHeightVoteKey(val))
+	if bz == nil {
+		return nil
+	}
+	var vote types.EthereumHeightVote
+	k.cdc.MustUnmarshal(bz, &vote)
+	return &vote
+}
+
+// GetEthereumHeightVotes returns the latest Ethereum height reported by every validator
+func (k Keeper) GetEthereumHeightVotes(ctx sdk.Context) (out []*types.EthereumHeightVote) {
+	prefixStore := prefix.NewStore(k.store(ctx), types.EthereumHeightVoteKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var vote types.EthereumHeightVote
+		k.cdc.MustUnmarshal(iter.Value(), &vote)
+		out = append(out, &vote)
+	}
+	return
+}
+
+// TallyEthereumHeightVotes advances the last observed Ethereum height to the power weighted median of the
+// heights reported by the bonded static validator set. Validators that did not report count as reporting
+// zero, so the median never passes a height that less than half of the power has seen, and a minority can
+// not time out batches early. The last observed height only ever moves forward.
+func (k Keeper) TallyEthereumHeightVotes(ctx sdk.Context) {
+	totalPower := k.getStaticTotalPower(ctx)
+	if totalPower == 0 {
+		return
+	}
+	staticValOperAddrsMap := k.GetStaticValOperAddrsAsMap(ctx)
+
+	type weightedHeight struct {
+		height uint64
+		power  uint64
+	}
+	var heights []weightedHeight
+	for _, validator := range k.StakingKeeper.GetBondedValidatorsByPower(ctx) {
+		if _, found := staticValOperAddrsMap[validator.OperatorAddress]; !found {
+			continue
+		}
+		vote := k.GetEthereumHeightVote(ctx, validator.GetOperator())
+		if vote == nil {
+			continue
+		}
+		power := uint64(k.StakingKeeper.GetLastValidatorPower(ctx, validator.GetOperator()))
+		heights = append(heights, weightedHeight{height: vote.EthereumHeight, power: power})
+	}
+	// highest first, the median is the height at which the reports reach more than half of the power
+	sort.SliceStable(heights, func(i, j int) bool { return heights[i].height > heights[j].height })
+
+	var median, power uint64
+	for _, h := range heights {
+		power += h.power
+		if power*2 > totalPower {
+			median = h.height
+			break
+		}
+	}
+
+	previous := k.GetLastObservedEthereumBlockHeight(ctx).EthereumBlockHeight
+	if median <= previous {
+		return
+	}
+	k.SetLastObservedEthereumBlockHeight(ctx, median)
+	k.emitTypedEvent(ctx, &types.EventEthereumHeightUpdated{
+		EthereumHeight:         median,
+		PreviousEthereumHeight: previous,
+	})
+}
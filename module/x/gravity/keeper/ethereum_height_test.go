@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+func TestEthereumHeightVoteMedian(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	for i := range ValAddrs {
+		k.SetOrchestratorValidator(ctx, ValAddrs[i], AccAddrs[i])
+	}
+	msgServer := NewMsgServerImpl(k)
+	report := func(i int, height uint64) {
+		_, err := msgServer.EthereumHeightClaim(sdk.WrapSDKContext(ctx), types.NewMsgEthereumHeightClaim(AccAddrs[i], height))
+		require.NoError(t, err)
+	}
+	lastObserved := func() uint64 {
+		return k.GetLastObservedEthereumBlockHeight(ctx).EthereumBlockHeight
+	}
+
+	// two of five equally powered validators are not enough to move the height
+	report(0, 200)
+	report(1, 100)
+	k.TallyEthereumHeightVotes(ctx)
+	require.Zero(t, lastObserved())
+
+	// with a third report more than half of the power has seen height 100
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	report(2, 150)
+	k.TallyEthereumHeightVotes(ctx)
+	require.Equal(t, uint64(100), lastObserved())
+	require.Equal(t, uint64(ctx.BlockHeight()), k.GetLastObservedEthereumBlockHeight(ctx).CosmosBlockHeight)
+	require.Len(t, typedEvents(t, ctx, &types.EventEthereumHeightUpdated{}), 1)
+
+	report(3, 300)
+	report(4, 300)
+	k.TallyEthereumHeightVotes(ctx)
+	require.Equal(t, uint64(200), lastObserved())
+
+	// lower reports never move the height back
+	report(3, 50)
+	report(4, 50)
+	k.TallyEthereumHeightVotes(ctx)
+	require.Equal(t, uint64(200), lastObserved())
+	require.Equal(t, uint64(50), k.GetEthereumHeightVote(ctx, ValAddrs[4]).EthereumHeight)
+
+	res, err := k.EthereumHeight(sdk.WrapSDKContext(ctx), &types.QueryEthereumHeightRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(200), res.LastObserved.EthereumBlockHeight)
+	require.Len(t, res.Votes, 5)
+
+	// only orchestrators of the validator set can report
+	outsider, err := sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+	require.NoError(t, err)
+	_, err = msgServer.EthereumHeightClaim(sdk.WrapSDKContext(ctx), types.NewMsgEthereumHeightClaim(outsider, 1000))
+	require.Error(t, err)
+}
+
+// The height votes can run ahead of the block a batch was executed at, the transfers record the latter
+func TestBatchExecutedBehindHeightVotes(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	msgServer := NewMsgServerImpl(k)
+	for i := range ValAddrs {
+		k.SetOrchestratorValidator(ctx, ValAddrs[i], AccAddrs[i])
+	}
+	token, err := types.NewInternalERC20Token(sdk.NewInt(99999), TokenContractAddrs[0])
+	require.NoError(t, err)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(token.GravityCoin())))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, AccAddrs[0], sdk.NewCoins(token.GravityCoin())))
+	receiver, err := types.NewEthAddress(EthAddrs[1].String())
+	require.NoError(t, err)
+	amount, err := types.NewInternalERC20Token(sdk.NewInt(100), TokenContractAddrs[0])
+	require.NoError(t, err)
+	txID, err := k.AddToOutgoingPool(ctx, AccAddrs[0], *receiver, amount.GravityCoin(), amount.GravityCoin())
+	require.NoError(t, err)
+	batch, err := k.BuildOutgoingTXBatch(ctx, token.Contract, 1)
+	require.NoError(t, err)
+
+	for i := range ValAddrs {
+		_, err := msgServer.EthereumHeightClaim(sdk.WrapSDKContext(ctx), types.NewMsgEthereumHeightClaim(AccAddrs[i], 2000))
+		require.NoError(t, err)
+	}
+	k.TallyEthereumHeightVotes(ctx)
+	require.Equal(t, uint64(2000), k.GetLastObservedEthereumBlockHeight(ctx).EthereumBlockHeight)
+
+	claim := func(orchestrator sdk.AccAddress) *types.MsgBatchSendToEthClaim {
+		return &types.MsgBatchSendToEthClaim{
+			EventNonce:    1,
+			BlockHeight:   1500,
+			BatchNonce:    batch.BatchNonce,
+			TokenContract: TokenContractAddrs[0],
+			Orchestrator:  orchestrator.String(),
+		}
+	}
+	for i := range ValAddrs {
+		_, err := msgServer.BatchSendToEthClaim(sdk.WrapSDKContext(ctx), claim(AccAddrs[i]))
+		require.NoError(t, err)
+	}
+	hash, err := claim(AccAddrs[0]).ClaimHash()
+	require.NoError(t, err)
+	k.TryAttestation(ctx, k.GetAttestation(ctx, 1, hash))
+
+	require.Nil(t, k.GetOutgoingTXBatch(ctx, token.Contract, batch.BatchNonce))
+	require.Equal(t, uint64(2000), k.GetLastObservedEthereumBlockHeight(ctx).EthereumBlockHeight)
+	history := k.GetTransferHistory(ctx, txID)
+	executed := history.Updates[len(history.Updates)-1]
+	require.Equal(t, types.TRANSFER_STATUS_EXECUTED, executed.Status)
+	require.Equal(t, uint64(1500), executed.EthereumHeight)
+}
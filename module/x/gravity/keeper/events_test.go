@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// typedEvents returns every typed event of the same type as event that was emitted on ctx
+func typedEvents(t *testing.T, ctx sdk.Context, event proto.Message) []proto.Message {
+	var found []proto.Message
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type != proto.MessageName(event) {
+			continue
+		}
+		parsed, err := sdk.ParseTypedEvent(abci.Event(e))
+		require.NoError(t, err)
+		found = append(found, parsed)
+	}
+	return found
+}
+
+func TestTypedEventsSendToEthAndBatches(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	var (
+		mySender, _            = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver, _          = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr, _ = types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		token, err             = types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr.GetAddress())
+		allVouchers            = sdk.NewCoins(token.GravityCoin())
+	)
+	require.NoError(t, err)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
+
+	amount, err := types.NewInternalERC20Token(sdk.NewInt(100), myTokenContractAddr.GetAddress())
+	require.NoError(t, err)
+	fee, err := types.NewInternalERC20Token(sdk.NewInt(2), myTokenContractAddr.GetAddress())
+	require.NoError(t, err)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	txID, err := k.AddToOutgoingPool(ctx, mySender, *myReceiver, amount.GravityCoin(), fee.GravityCoin())
+	require.NoError(t, err)
+	sent := typedEvents(t, ctx, &types.EventSendToEth{})
+	require.Len(t, sent, 1)
+	require.Equal(t, &types.EventSendToEth{
+		Transfer: types.OutgoingTransferTx{
+			Id:          txID,
+			Sender:      mySender.String(),
+			DestAddress: myReceiver.GetAddress(),
+			Erc20Token:  amount.ToExternal(),
+			Erc20Fee:    fee.ToExternal(),
+		},
+		BridgeContract: k.GetBridgeContractAddress(ctx).GetAddress(),
+		BridgeChainId:  k.GetBridgeChainID(ctx),
+	}, sent[0])
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	batch, err := k.BuildOutgoingTXBatch(ctx, *myTokenContractAddr, 10)
+	require.NoError(t, err)
+	created := typedEvents(t, ctx, &types.EventBatchCreated{})
+	require.Len(t, created, 1)
+	require.Equal(t, *batch.ToExternal(), created[0].(*types.EventBatchCreated).Batch)
+	require.Len(t, created[0].(*types.EventBatchCreated).Batch.Transactions, 1)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	k.OutgoingTxBatchExecuted(ctx, *myTokenContractAddr, batch.BatchNonce, 0)
+	executed := typedEvents(t, ctx, &types.EventBatchExecuted{})
+	require.Len(t, executed, 1)
+	require.Equal(t, batch.BatchNonce, executed[0].(*types.EventBatchExecuted).Batch.BatchNonce)
+	require.Equal(t, txID, executed[0].(*types.EventBatchExecuted).Batch.Transactions[0].Id)
+}
+
+// The attestation handler runs in a cache context, its events must still reach the block
+func TestTypedEventsDepositObserved(t *testing.T) {
+	input := CreateTestEnv(t)
+	orchestrators, _ := setupAttestationVoters(&input, MockStakingValidatorData{Operator: ValAddrs[0], Power: 100})
+	input.Context = input.Context.WithEventManager(sdk.NewEventManager())
+	ctx := input.Context
+
+	att := attestDeposit(t, input, orchestrators[0])
+	require.True(t, att.Observed)
+
+	observed := typedEvents(t, ctx, &types.EventAttestationObserved{})
+	require.Len(t, observed, 1)
+	event := observed[0].(*types.EventAttestationObserved)
+	require.Equal(t, types.CLAIM_TYPE_SEND_TO_COSMOS, event.ClaimType)
+	require.Equal(t, uint64(1), event.EventNonce)
+	require.Equal(t, att.Votes, event.Votes)
+
+	credited := typedEvents(t, ctx, &types.EventDepositCredited{})
+	require.Len(t, credited, 1)
+	token, err := types.NewInternalERC20Token(sdk.NewInt(1000), TokenContractAddrs[0])
+	require.NoError(t, err)
+	require.Equal(t, &types.EventDepositCredited{
+		EventNonce:       1,
+		EthereumSender:   EthAddrs[0].String(),
+		CosmosReceiver:   AccAddrs[0].String(),
+		TokenContract:    token.Contract.GetAddress(),
+		Amount:           token.GravityCoin(),
+		CosmosOriginated: false,
+	}, credited[0])
+	require.Equal(t, token.GravityCoin(), input.BankKeeper.GetBalance(ctx, AccAddrs[0], token.GravityCoin().Denom))
+}
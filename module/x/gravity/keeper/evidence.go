@@ -5,12 +5,17 @@ import (
 	"encoding/hex"
 	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
 )
 
+// CheckBadSignatureEvidence slashes, jails and records the validator that produced an Ethereum signature
+// over a checkpoint this chain never created. The submitter is paid BadEthSignatureRewardFraction of the
+// slashed tokens. Evidence is deduplicated by checkpoint and signature, and a validator is only punished
+// once per checkpoint.
 func (k Keeper) CheckBadSignatureEvidence(
 	ctx sdk.Context,
 	msg *types.MsgSubmitBadSignatureEvidence) error {
@@ -24,18 +29,18 @@ func (k Keeper) CheckBadSignatureEvidence(
 
 	switch subject := subject.(type) {
 	case *types.OutgoingTxBatch:
-		return k.checkBadSignatureEvidenceInternal(ctx, subject, msg.Signature)
+		return k.checkBadSignatureEvidenceInternal(ctx, subject, msg.Signature, msg.Sender)
 	case *types.Valset:
-		return k.checkBadSignatureEvidenceInternal(ctx, subject, msg.Signature)
+		return k.checkBadSignatureEvidenceInternal(ctx, subject, msg.Signature, msg.Sender)
 	case *types.OutgoingLogicCall:
-		return k.checkBadSignatureEvidenceInternal(ctx, subject, msg.Signature)
+		return k.checkBadSignatureEvidenceInternal(ctx, subject, msg.Signature, msg.Sender)
 
 	default:
 		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("Bad signature must be over a batch, valset, or logic call got %s", subject))
 	}
 }
 
-func (k Keeper) checkBadSignatureEvidenceInternal(ctx sdk.Context, subject types.EthereumSigned, signature string) error {
+func (k Keeper) checkBadSignatureEvidenceInternal(ctx sdk.Context, subject types.EthereumSigned, signature string, sender string) error {
 	// Get checkpoint of the supposed bad signature (fake valset, batch, or logic call submitted to eth)
 	gravityID := k.GetGravityID(ctx)
 	powerReduction := k.StakingKeeper.PowerReduction(ctx)
@@ -50,7 +55,7 @@ func (k Keeper) checkBadSignatureEvidenceInternal(ctx sdk.Context, subject types
 	// Decode Eth signature to bytes
 
 	// strip 0x prefix if needed
-	if signature[:2] == "0x" {
+	if len(signature) >= 2 && signature[:2] == "0x" {
 		signature = signature[2:]
 	}
 	sigBytes, err := hex.DecodeString(signature)
@@ -58,8 +63,14 @@ func (k Keeper) checkBadSignatureEvidenceInternal(ctx sdk.Context, subject types
 		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("signature decoding %s", signature))
 	}
 
+	// The same evidence may only be used once
+	if k.GetBadSignatureEvidence(ctx, checkpoint, sigBytes) != nil {
+		return sdkerrors.Wrap(types.ErrDuplicate, "bad signature evidence already submitted")
+	}
+
 	// Get eth address of the offending validator using the checkpoint and the signature
-	ethAddress, err := types.EthAddressFromSignature(checkpoint, sigBytes)
+	// EthAddressFromSignature normalizes the V value in place, the evidence is recorded as submitted
+	ethAddress, err := types.EthAddressFromSignature(checkpoint, append([]byte{}, sigBytes...))
 	if err != nil {
 		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("signature to eth address failed with checkpoint %s and signature %s", hex.EncodeToString(checkpoint), signature))
 	}
@@ -70,6 +81,22 @@ func (k Keeper) checkBadSignatureEvidenceInternal(ctx sdk.Context, subject types
 		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("Did not find validator for eth address %s from signature %s with checkpoint %s and GravityID %s", ethAddress, signature, hex.EncodeToString(checkpoint), gravityID))
 	}
 
+	// A signature can be altered into a different valid signature from the same key, so the validator
+	// must not have been punished for this checkpoint already either
+	if k.hasBadSignatureEvidenceForValidator(ctx, checkpoint, val.GetOperator()) {
+		return sdkerrors.Wrap(types.ErrDuplicate, fmt.Sprintf("validator %s already punished for checkpoint %s", val.GetOperator(), hex.EncodeToString(checkpoint)))
+	}
+
+	// the staking module can not slash a validator that has fully unbonded
+	if val.IsUnbonded() {
+		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("validator %s is unbonded, cannot slash", val.GetOperator()))
+	}
+
+	submitter, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+
 	// Slash the offending validator
 	cons, err := val.GetConsAddr()
 	if err != nil {
@@ -77,27 +104,167 @@ func (k Keeper) checkBadSignatureEvidenceInternal(ctx sdk.Context, subject types
 	}
 
 	params := k.GetParams(ctx)
+	tokensBefore := val.GetTokens()
 	k.StakingKeeper.Slash(ctx, cons, ctx.BlockHeight(), val.ConsensusPower(powerReduction), params.SlashFractionBadEthSignature)
 	if !val.IsJailed() {
 		k.StakingKeeper.Jail(ctx, cons)
 	}
 
+	reward, err := k.rewardBadSignatureSubmitter(ctx, val.GetOperator(), tokensBefore, submitter)
+	if err != nil {
+		return err
+	}
+
+	evidence := types.BadSignatureEvidence{
+		Checkpoint:      checkpoint,
+		Signature:       hex.EncodeToString(sigBytes),
+		Validator:       val.GetOperator().String(),
+		EthereumAddress: ethAddress.GetAddress(),
+		Submitter:       submitter.String(),
+		Height:          ctx.BlockHeight(),
+		Reward:          reward,
+	}
+	k.SetBadSignatureEvidence(ctx, evidence)
+	// make the misbehaviour visible alongside the rest of the chain's evidence
+	if k.evidenceKeeper != nil {
+		k.evidenceKeeper.SetEvidence(ctx, &evidence)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBadSignatureEvidence,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyValidator, evidence.Validator),
+			sdk.NewAttribute(types.AttributeKeySubmitter, evidence.Submitter),
+			sdk.NewAttribute(types.AttributeKeyReward, evidence.Reward.String()),
+		),
+	)
+	k.emitTypedEvent(ctx, &types.EventBadSignatureEvidence{Evidence: evidence})
+
 	return nil
 }
 
+// rewardBadSignatureSubmitter pays the submitter BadEthSignatureRewardFraction of the tokens the validator
+// lost to the slash, in the staking bond denom
+func (k Keeper) rewardBadSignatureSubmitter(ctx sdk.Context, valAddr sdk.ValAddress, tokensBefore sdk.Int, submitter sdk.AccAddress) (sdk.Coin, error) {
+	bondDenom := k.StakingKeeper.GetParams(ctx).BondDenom
+	reward := sdk.NewCoin(bondDenom, sdk.ZeroInt())
+
+	fraction := k.GetParams(ctx).BadEthSignatureRewardFraction
+	if fraction.IsNil() || !fraction.IsPositive() {
+		return reward, nil
+	}
+	slashedVal, found := k.StakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return reward, nil
+	}
+	slashed := tokensBefore.Sub(slashedVal.GetTokens())
+	reward.Amount = fraction.MulInt(slashed).TruncateInt()
+	if !reward.IsPositive() {
+		return reward, nil
+	}
+
+	// the slashed tokens are burned by the staking module, the reward is minted back out of them
+	coins := sdk.NewCoins(reward)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return reward, sdkerrors.Wrap(err, "mint bad signature reward")
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, submitter, coins); err != nil {
+		return reward, sdkerrors.Wrap(err, "send bad signature reward")
+	}
+	return reward, nil
+}
+
+// SetBadSignatureEvidence records bad signature evidence that has been acted on
+func (k Keeper) SetBadSignatureEvidence(ctx sdk.Context, evidence types.BadSignatureEvidence) {
+	sigBytes, err := hex.DecodeString(evidence.Signature)
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "invalid signature in bad signature evidence"))
+	}
+	store := k.store(ctx)
+	store.Set(types.GetBadSignatureEvidenceKey(evidence.Checkpoint, sigBytes), k.cdc.MustMarshal(&evidence))
+}
+
+// GetBadSignatureEvidence returns the evidence recorded for a signature over a checkpoint, nil if there is none
+func (k Keeper) GetBadSignatureEvidence(ctx sdk.Context, checkpoint []byte, signature []byte) *types.BadSignatureEvidence {
+	store := k.store(ctx)
+	bz := store.Get(types.GetBadSignatureEvidenceKey(checkpoint, signature))
+	if bz == nil {
+		return nil
+	}
+	var evidence types.BadSignatureEvidence
+	k.cdc.MustUnmarshal(bz, &evidence)
+	return &evidence
+}
+
+// IterateBadSignatureEvidence iterates through the bad signature evidence under a key prefix
+func (k Keeper) IterateBadSignatureEvidence(ctx sdk.Context, keyPrefix []byte, cb func(key []byte, evidence *types.BadSignatureEvidence) bool) {
+	prefixStore := prefix.NewStore(k.store(ctx), keyPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var evidence types.BadSignatureEvidence
+		k.cdc.MustUnmarshal(iter.Value(), &evidence)
+		// cb returns true to stop early
+		if cb(iter.Key(), &evidence) {
+			break
+		}
+	}
+}
+
+// GetAllBadSignatureEvidence returns all recorded bad signature evidence
+func (k Keeper) GetAllBadSignatureEvidence(ctx sdk.Context) (out []*types.BadSignatureEvidence) {
+	k.IterateBadSignatureEvidence(ctx, types.BadSignatureEvidenceKey, func(_ []byte, evidence *types.BadSignatureEvidence) bool {
+		out = append(out, evidence)
+		return false
+	})
+	return
+}
+
+// GetBadSignatureEvidenceByValidator returns the bad signature evidence recorded against a validator
+func (k Keeper) GetBadSignatureEvidenceByValidator(ctx sdk.Context, validator sdk.ValAddress) (out []*types.BadSignatureEvidence) {
+	k.IterateBadSignatureEvidence(ctx, types.BadSignatureEvidenceKey, func(_ []byte, evidence *types.BadSignatureEvidence) bool {
+		if evidence.Validator == validator.String() {
+			out = append(out, evidence)
+		}
+		return false
+	})
+	return
+}
+
+// hasBadSignatureEvidenceForValidator returns true if a validator has already been punished for a signature over checkpoint
+func (k Keeper) hasBadSignatureEvidenceForValidator(ctx sdk.Context, checkpoint []byte, validator sdk.ValAddress) (found bool) {
+	k.IterateBadSignatureEvidence(ctx, types.GetBadSignatureEvidenceCheckpointKey(checkpoint), func(_ []byte, evidence *types.BadSignatureEvidence) bool {
+		found = evidence.Validator == validator.String()
+		return found
+	})
+	return
+}
+
 // SetPastEthSignatureCheckpoint puts the checkpoint of a valset, batch, or logic call into a set
 // in order to prove later that it existed at one point.
 func (k Keeper) SetPastEthSignatureCheckpoint(ctx sdk.Context, checkpoint []byte) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	store.Set(types.GetPastEthSignatureCheckpointKey(checkpoint), []byte{0x1})
 }
 
 // GetPastEthSignatureCheckpoint tells you whether a given checkpoint has ever existed
 func (k Keeper) GetPastEthSignatureCheckpoint(ctx sdk.Context, checkpoint []byte) (found bool) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	if bytes.Equal(store.Get(types.GetPastEthSignatureCheckpointKey(checkpoint)), []byte{0x1}) {
 		return true
 	} else {
 		return false
 	}
 }
+
+// GetPastEthSignatureCheckpoints returns every checkpoint that has ever existed
+func (k Keeper) GetPastEthSignatureCheckpoints(ctx sdk.Context) (out [][]byte) {
+	prefixStore := prefix.NewStore(k.store(ctx), types.PastEthSignatureCheckpointKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		out = append(out, append([]byte{}, iter.Key()...))
+	}
+	return
+}
@@ -142,6 +142,7 @@ func TestSubmitBadSignatureEvidenceSlash(t *testing.T) {
 	msg := types.MsgSubmitBadSignatureEvidence{
 		Subject:   any,
 		Signature: hex.EncodeToString(ethSignature),
+		Sender:    AccAddrs[1].String(),
 	}
 
 	err = input.GravityKeeper.CheckBadSignatureEvidence(ctx, &msg)
@@ -150,3 +151,120 @@ func TestSubmitBadSignatureEvidenceSlash(t *testing.T) {
 	val := input.StakingKeeper.Validator(ctx, ValAddrs[0])
 	require.True(t, val.IsJailed())
 }
+
+// badSignatureEvidenceMsg signs a checkpoint that was never created on this chain with a new eth key assigned
+// to validator 0 and returns the evidence message along with the signature and checkpoint
+//nolint: exhaustivestruct
+func badSignatureEvidenceMsg(t *testing.T, input TestInput, ctx sdk.Context, sender sdk.AccAddress) (types.MsgSubmitBadSignatureEvidence, []byte, []byte) {
+	batch := types.OutgoingTxBatch{
+		TokenContract: "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7",
+		BatchTimeout:  420,
+	}
+	checkpoint := batch.GetCheckpoint(input.GravityKeeper.GetGravityID(ctx))
+
+	any, err := codectypes.NewAnyWithValue(&batch)
+	require.NoError(t, err)
+
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	ethAddress, err := types.NewEthAddress(crypto.PubkeyToAddress(privKey.PublicKey).String())
+	require.NoError(t, err)
+	input.GravityKeeper.SetEthAddressForValidator(ctx, ValAddrs[0], *ethAddress)
+
+	ethSignature, err := types.NewEthereumSignature(checkpoint, privKey)
+	require.NoError(t, err)
+
+	return types.MsgSubmitBadSignatureEvidence{
+		Subject:   any,
+		Signature: hex.EncodeToString(ethSignature),
+		Sender:    sender.String(),
+	}, ethSignature, checkpoint
+}
+
+//nolint: exhaustivestruct
+func TestSubmitBadSignatureEvidenceRecordAndReward(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	submitter := AccAddrs[1]
+	bondDenom := input.StakingKeeper.BondDenom(ctx)
+
+	msg, ethSignature, checkpoint := badSignatureEvidenceMsg(t, input, ctx, submitter)
+
+	tokensBefore := input.StakingKeeper.Validator(ctx, ValAddrs[0]).GetTokens()
+	balanceBefore := input.BankKeeper.GetBalance(ctx, submitter, bondDenom)
+
+	require.NoError(t, input.GravityKeeper.CheckBadSignatureEvidence(ctx, &msg))
+
+	// the submitter is paid the reward fraction of the slashed tokens
+	slashed := tokensBefore.Sub(input.StakingKeeper.Validator(ctx, ValAddrs[0]).GetTokens())
+	require.True(t, slashed.IsPositive())
+	expectedReward := TestingGravityParams.BadEthSignatureRewardFraction.MulInt(slashed).TruncateInt()
+	require.True(t, expectedReward.IsPositive())
+	balanceAfter := input.BankKeeper.GetBalance(ctx, submitter, bondDenom)
+	require.Equal(t, expectedReward, balanceAfter.Amount.Sub(balanceBefore.Amount))
+
+	// the evidence is recorded and queryable
+	evidence := input.GravityKeeper.GetBadSignatureEvidence(ctx, checkpoint, ethSignature)
+	require.NotNil(t, evidence)
+	require.Equal(t, ValAddrs[0].String(), evidence.Validator)
+	require.Equal(t, submitter.String(), evidence.Submitter)
+	require.Equal(t, sdk.NewCoin(bondDenom, expectedReward), evidence.Reward)
+	require.Equal(t, ctx.BlockHeight(), evidence.Height)
+	require.NoError(t, evidence.ValidateBasic())
+
+	res, err := input.GravityKeeper.BadSignatureEvidence(sdk.WrapSDKContext(ctx), &types.QueryBadSignatureEvidenceRequest{Validator: ValAddrs[0].String()})
+	require.NoError(t, err)
+	require.Equal(t, []*types.BadSignatureEvidence{evidence}, res.Evidence)
+	res, err = input.GravityKeeper.BadSignatureEvidence(sdk.WrapSDKContext(ctx), &types.QueryBadSignatureEvidenceRequest{Validator: ValAddrs[1].String()})
+	require.NoError(t, err)
+	require.Empty(t, res.Evidence)
+
+	// and shows up in the evidence module
+	stored, found := input.EvidenceKeeper.GetEvidence(ctx, evidence.Hash())
+	require.True(t, found)
+	require.Equal(t, evidence, stored)
+}
+
+//nolint: exhaustivestruct
+func TestSubmitBadSignatureEvidenceDuplicate(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+
+	msg, ethSignature, _ := badSignatureEvidenceMsg(t, input, ctx, AccAddrs[1])
+	require.NoError(t, input.GravityKeeper.CheckBadSignatureEvidence(ctx, &msg))
+	tokensAfterSlash := input.StakingKeeper.Validator(ctx, ValAddrs[0]).GetTokens()
+
+	// unjail the validator so the resubmission would otherwise slash again
+	cons, err := input.StakingKeeper.Validator(ctx, ValAddrs[0]).GetConsAddr()
+	require.NoError(t, err)
+	input.StakingKeeper.Unjail(ctx, cons)
+
+	// the same evidence is rejected, with or without the 0x prefix
+	err = input.GravityKeeper.CheckBadSignatureEvidence(ctx, &msg)
+	require.True(t, types.ErrDuplicate.Is(err), err)
+	msg.Signature = "0x" + msg.Signature
+	err = input.GravityKeeper.CheckBadSignatureEvidence(ctx, &msg)
+	require.True(t, types.ErrDuplicate.Is(err), err)
+
+	// so is a different encoding of the same signature
+	malleated := append([]byte{}, ethSignature...)
+	malleated[64] += 27
+	msg.Signature = hex.EncodeToString(malleated)
+	err = input.GravityKeeper.CheckBadSignatureEvidence(ctx, &msg)
+	require.True(t, types.ErrDuplicate.Is(err), err)
+
+	require.Equal(t, tokensAfterSlash, input.StakingKeeper.Validator(ctx, ValAddrs[0]).GetTokens())
+	require.Len(t, input.GravityKeeper.GetAllBadSignatureEvidence(ctx), 1)
+}
+
+//nolint: exhaustivestruct
+func TestSubmitBadSignatureEvidenceNoEventsOnError(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	msgServer := NewMsgServerImpl(input.GravityKeeper)
+
+	msg, _, _ := badSignatureEvidenceMsg(t, input, ctx, AccAddrs[1])
+	msg.Signature = "foo"
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err := msgServer.SubmitBadSignatureEvidence(sdk.WrapSDKContext(ctx), &msg)
+	require.Error(t, err)
+	require.Empty(t, ctx.EventManager().Events())
+}
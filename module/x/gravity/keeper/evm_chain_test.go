@@ -0,0 +1,272 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+var testEvmChain = types.EvmChain{
+	ChainId:                  137,
+	BridgeEthereumAddress:    "0x8858eeb3dfffa017d4bce9801d340d36cf895ccf",
+	GravityId:                "polygon-gravity",
+	AverageEthereumBlockTime: 2000,
+}
+
+// registerTestEvmChain adds testEvmChain to the params and returns the keeper scoped to it
+func registerTestEvmChain(t *testing.T, ctx sdk.Context, k Keeper) Keeper {
+	params := k.GetParams(ctx)
+	params.EvmChains = []types.EvmChain{testEvmChain}
+	k.SetParams(ctx, params)
+	evmChain, err := k.EvmChainKeeper(ctx, testEvmChain.ChainId)
+	require.NoError(t, err)
+	return evmChain
+}
+
+//nolint: exhaustivestruct
+func TestEvmChainKeeper(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+
+	_, err := k.EvmChainKeeper(ctx, testEvmChain.ChainId)
+	require.Error(t, err)
+
+	evmChain := registerTestEvmChain(t, ctx, k)
+	require.Equal(t, testEvmChain.ChainId, evmChain.EvmChainID())
+	require.Equal(t, testEvmChain.ChainId, evmChain.GetBridgeChainID(ctx))
+	require.Equal(t, testEvmChain.GravityId, evmChain.GetGravityID(ctx))
+	require.Equal(t, testEvmChain.BridgeEthereumAddress, evmChain.GetBridgeContractAddress(ctx).GetAddress())
+	require.Equal(t, testEvmChain.AverageEthereumBlockTime, evmChain.GetAverageEthereumBlockTime(ctx))
+
+	// zero and the bridge chain id both refer to the default chain
+	for _, chainID := range []uint64{0, k.GetBridgeChainID(ctx)} {
+		defaultChain, err := k.EvmChainKeeper(ctx, chainID)
+		require.NoError(t, err)
+		require.Zero(t, defaultChain.EvmChainID())
+		require.Equal(t, k.GetGravityID(ctx), defaultChain.GetGravityID(ctx))
+	}
+
+	keepers := k.EvmChainKeepers(ctx)
+	require.Len(t, keepers, 2)
+	require.Zero(t, keepers[0].EvmChainID())
+	require.Equal(t, testEvmChain.ChainId, keepers[1].EvmChainID())
+
+	// messages for an unknown chain are turned away
+	_, err = NewMsgServerImpl(k).RequestBatch(sdk.WrapSDKContext(ctx), &types.MsgRequestBatch{
+		Sender:  AccAddrs[0].String(),
+		Denom:   "stake",
+		ChainId: 5,
+	})
+	require.Error(t, err)
+}
+
+func TestEvmChainValsetsAreIsolated(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	evmChain := registerTestEvmChain(t, ctx, k)
+	require.Zero(t, evmChain.GetLatestValsetNonce(ctx))
+
+	// the orchestrators are shared, the Ethereum addresses are set again on every chain
+	for i := range ValAddrs {
+		k.SetOrchestratorValidator(ctx, ValAddrs[i], AccAddrs[i])
+	}
+	_, found := evmChain.GetOrchestratorValidator(ctx, AccAddrs[0])
+	require.True(t, found)
+	_, found = evmChain.GetEthAddressByValidator(ctx, ValAddrs[0])
+	require.False(t, found)
+	for i := range ValAddrs {
+		ethAddr, err := types.NewEthAddress(EthAddrs[len(EthAddrs)-1-i].String())
+		require.NoError(t, err)
+		evmChain.SetEthAddressForValidator(ctx, ValAddrs[i], *ethAddr)
+	}
+
+	valset := k.SetValsetRequest(ctx)
+	require.Zero(t, evmChain.GetLatestValsetNonce(ctx))
+	require.Nil(t, evmChain.GetValset(ctx, valset.Nonce))
+
+	evmChainValset := evmChain.SetValsetRequest(ctx)
+	require.Equal(t, uint64(1), evmChainValset.Nonce)
+	require.Equal(t, valset.Nonce, k.GetLatestValsetNonce(ctx))
+	require.NotEqual(t, valset.GetCheckpoint(k.GetGravityID(ctx)), evmChainValset.GetCheckpoint(evmChain.GetGravityID(ctx)))
+	ethAddr, _ := k.GetEthAddressByValidator(ctx, ValAddrs[0])
+	evmChainEthAddr, _ := evmChain.GetEthAddressByValidator(ctx, ValAddrs[0])
+	require.NotEqual(t, *ethAddr, *evmChainEthAddr)
+}
+
+//nolint: exhaustivestruct
+func TestEvmChainDepositAndSendToEth(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	evmChain := registerTestEvmChain(t, ctx, k)
+	tokenContract, err := types.NewEthAddress(TokenContractAddrs[0])
+	require.NoError(t, err)
+
+	claim := types.MsgSendToCosmosClaim{
+		EventNonce:     1,
+		BlockHeight:    1,
+		TokenContract:  TokenContractAddrs[0],
+		Amount:         sdk.NewInt(500),
+		EthereumSender: EthAddrs[0].String(),
+		CosmosReceiver: AccAddrs[0].String(),
+		Orchestrator:   AccAddrs[0].String(),
+		ChainId:        testEvmChain.ChainId,
+	}
+	require.NoError(t, evmChain.AttestationHandler.Handle(ctx, types.Attestation{}, &claim))
+
+	// the voucher is told apart from the one of the same token on the default chain
+	denom := types.EvmChainGravityDenom(testEvmChain.ChainId, *tokenContract)
+	require.Equal(t, "gravity137/"+TokenContractAddrs[0], denom)
+	require.Equal(t, sdk.NewInt(500), input.BankKeeper.GetBalance(ctx, AccAddrs[0], denom).Amount)
+	require.NotNil(t, evmChain.GetDepositReceipt(ctx, 1))
+	require.Nil(t, k.GetDepositReceipt(ctx, 1))
+
+	isCosmosOriginated, erc20, err := evmChain.DenomToERC20Lookup(ctx, denom)
+	require.NoError(t, err)
+	require.False(t, isCosmosOriginated)
+	require.Equal(t, *tokenContract, *erc20)
+	_, _, err = k.DenomToERC20Lookup(ctx, denom)
+	require.Error(t, err)
+
+	receiver, err := types.NewEthAddress(EthAddrs[1].String())
+	require.NoError(t, err)
+	txID, err := evmChain.AddToOutgoingPool(ctx, AccAddrs[0], *receiver, sdk.NewInt64Coin(denom, 100), sdk.NewInt64Coin(denom, 10))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(390), input.BankKeeper.GetBalance(ctx, AccAddrs[0], denom).Amount)
+	_, err = evmChain.GetUnbatchedTxById(ctx, txID)
+	require.NoError(t, err)
+	_, err = k.GetUnbatchedTxById(ctx, txID)
+	require.Error(t, err)
+
+	// the voucher of the other chain can not be sent to the default chain
+	_, err = k.AddToOutgoingPool(ctx, AccAddrs[0], *receiver, sdk.NewInt64Coin(denom, 100), sdk.NewInt64Coin(denom, 10))
+	require.Error(t, err)
+}
+
+func TestRemoveEvmChain(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	evmChain := registerTestEvmChain(t, ctx, k)
+	previous := k.GetParams(ctx).EvmChains
+	removeChain := func() error {
+		params := k.GetParams(ctx)
+		params.EvmChains = []types.EvmChain{}
+		k.SetParams(ctx, params)
+		err := k.CheckRemovedEvmChains(ctx, previous)
+		params.EvmChains = previous
+		k.SetParams(ctx, params)
+		return err
+	}
+	tokenContract, err := types.NewEthAddress(TokenContractAddrs[0])
+	require.NoError(t, err)
+	denom := types.EvmChainGravityDenom(testEvmChain.ChainId, *tokenContract)
+
+	// a chain nothing was bridged through can be removed
+	require.NoError(t, removeChain())
+
+	claim := types.MsgSendToCosmosClaim{
+		EventNonce:     1,
+		BlockHeight:    1,
+		TokenContract:  TokenContractAddrs[0],
+		Amount:         sdk.NewInt(500),
+		EthereumSender: EthAddrs[0].String(),
+		CosmosReceiver: AccAddrs[0].String(),
+		Orchestrator:   AccAddrs[0].String(),
+		ChainId:        testEvmChain.ChainId,
+	}
+	require.NoError(t, evmChain.AttestationHandler.Handle(ctx, types.Attestation{}, &claim))
+	receiver, err := types.NewEthAddress(EthAddrs[1].String())
+	require.NoError(t, err)
+	txID, err := evmChain.AddToOutgoingPool(ctx, AccAddrs[0], *receiver, sdk.NewInt64Coin(denom, 100), sdk.NewInt64Coin(denom, 10))
+	require.NoError(t, err)
+
+	// not while a transfer waits in the pool
+	require.ErrorIs(t, removeChain(), types.ErrInvalid)
+	require.NoError(t, evmChain.RemoveFromOutgoingPoolAndRefund(ctx, txID, AccAddrs[0]))
+
+	// nor while its vouchers are in circulation
+	require.ErrorIs(t, removeChain(), types.ErrInvalid)
+	vouchers := sdk.NewCoins(sdk.NewInt64Coin(denom, 500))
+	require.NoError(t, input.BankKeeper.SendCoinsFromAccountToModule(ctx, AccAddrs[0], types.ModuleName, vouchers))
+	require.NoError(t, input.BankKeeper.BurnCoins(ctx, types.ModuleName, vouchers))
+	require.NoError(t, removeChain())
+}
+
+// Every chain unlocks Cosmos originated coins from the module account, a chain only unlocks the coins locked
+// for it
+func TestEvmChainCosmosOriginatedLocked(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	evmChain := registerTestEvmChain(t, ctx, k)
+	for i := range ValAddrs {
+		ethAddr, err := types.NewEthAddress(EthAddrs[i].String())
+		require.NoError(t, err)
+		evmChain.SetEthAddressForValidator(ctx, ValAddrs[i], *ethAddr)
+		k.SetOrchestratorValidator(ctx, ValAddrs[i], AccAddrs[i])
+	}
+	denom := TestingStakeParams.BondDenom
+	evmContract, err := types.NewEthAddress(TokenContractAddrs[0])
+	require.NoError(t, err)
+	evmChain.setCosmosOriginatedDenomToERC20(ctx, denom, *evmContract)
+	defaultContract, err := types.NewEthAddress(TokenContractAddrs[1])
+	require.NoError(t, err)
+	k.setCosmosOriginatedDenomToERC20(ctx, denom, *defaultContract)
+	receiver, err := types.NewEthAddress(EthAddrs[1].String())
+	require.NoError(t, err)
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	deposit := func(k Keeper, nonce uint64, contract types.EthAddress, amount sdk.Int) {
+		claim := types.MsgSendToCosmosClaim{
+			EventNonce:     nonce,
+			BlockHeight:    nonce,
+			TokenContract:  contract.GetAddress(),
+			Amount:         amount,
+			EthereumSender: EthAddrs[0].String(),
+			CosmosReceiver: AccAddrs[4].String(),
+			Orchestrator:   AccAddrs[0].String(),
+			ChainId:        k.EvmChainID(),
+		}
+		require.NoError(t, k.AttestationHandler.Handle(ctx, types.Attestation{}, &claim))
+	}
+
+	// transfers to the chain lock their amount and fee, a canceled transfer unlocks them
+	_, err = evmChain.AddToOutgoingPool(ctx, AccAddrs[0], *receiver, sdk.NewInt64Coin(denom, 100), sdk.NewInt64Coin(denom, 10))
+	require.NoError(t, err)
+	txID, err := evmChain.AddToOutgoingPool(ctx, AccAddrs[0], *receiver, sdk.NewInt64Coin(denom, 100), sdk.NewInt64Coin(denom, 10))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(220), evmChain.GetCosmosOriginatedLocked(ctx, denom))
+	require.NoError(t, evmChain.RemoveFromOutgoingPoolAndRefund(ctx, txID, AccAddrs[0]))
+	require.Equal(t, sdk.NewInt(110), evmChain.GetCosmosOriginatedLocked(ctx, denom))
+	require.True(t, k.GetCosmosOriginatedLocked(ctx, denom).IsZero())
+
+	// a deposit beyond the locked coins is held in escrow without a refund
+	balance := input.BankKeeper.GetBalance(ctx, AccAddrs[4], denom)
+	deposit(evmChain, 1, *evmContract, sdk.NewInt(200))
+	require.Equal(t, balance, input.BankKeeper.GetBalance(ctx, AccAddrs[4], denom))
+	failed := evmChain.GetFailedDeposit(ctx, 1)
+	require.NotNil(t, failed)
+	require.Zero(t, failed.RefundTxId)
+	require.Equal(t, sdk.NewInt(110), evmChain.GetCosmosOriginatedLocked(ctx, denom))
+
+	// one within them is credited and unlocks them
+	deposit(evmChain, 2, *evmContract, sdk.NewInt(50))
+	require.Equal(t, balance.AddAmount(sdk.NewInt(50)), input.BankKeeper.GetBalance(ctx, AccAddrs[4], denom))
+	require.Equal(t, sdk.NewInt(60), evmChain.GetCosmosOriginatedLocked(ctx, denom))
+	genesis := ExportGenesis(ctx, k)
+	require.Empty(t, genesis.CosmosOriginatedLocked)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denom, 60)), genesis.EvmChainStates[0].State.CosmosOriginatedLocked)
+
+	// the default chain keeps no record but can't unlock the coins locked for the other chains
+	_, err = k.AddToOutgoingPool(ctx, AccAddrs[0], *receiver, sdk.NewInt64Coin(denom, 100), sdk.NewInt64Coin(denom, 10))
+	require.NoError(t, err)
+	require.True(t, k.GetCosmosOriginatedLocked(ctx, denom).IsZero())
+	available := input.BankKeeper.GetBalance(ctx, moduleAddr, denom).Amount.SubRaw(60)
+	require.Equal(t, sdk.NewInt(110), available)
+	deposit(k, 1, *defaultContract, available.AddRaw(1))
+	require.NotNil(t, k.GetFailedDeposit(ctx, 1))
+	deposit(k, 2, *defaultContract, available)
+	require.Nil(t, k.GetFailedDeposit(ctx, 2))
+	require.Equal(t, sdk.NewInt(60), input.BankKeeper.GetBalance(ctx, moduleAddr, denom).Amount)
+}
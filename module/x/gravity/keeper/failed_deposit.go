@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// escrowFailedDeposit takes a deposit that could not be credited into the module, Cosmos originated
+// coins are already locked there and vouchers for Ethereum originated tokens are minted. A refundable
+// deposit is refunded to its Ethereum sender right away when RefundFailedDeposits is set, otherwise or
+// when the refund can not be queued it is held until governance releases it. The receipt is updated in
+// place.
+func (k Keeper) escrowFailedDeposit(ctx sdk.Context, receipt *types.DepositReceipt, isCosmosOriginated bool, refundable bool) {
+	if !isCosmosOriginated {
+		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.Coins{receipt.Amount}); err != nil {
+			// without the vouchers there is nothing to hold, the deposit is only left in its receipt
+			k.logger(ctx).Error("unable to escrow failed deposit",
+				"cause", err.Error(),
+				"nonce", fmt.Sprint(receipt.EventNonce),
+			)
+			return
+		}
+	}
+
+	if refundable && k.GetParams(ctx).RefundFailedDeposits {
+		xCtx, commit := ctx.CacheContext()
+		txID, err := k.refundFailedDeposit(xCtx, *receipt)
+		if err == nil {
+			commit()
+			ctx.EventManager().EmitEvents(xCtx.EventManager().Events())
+			receipt.RefundTxId = txID
+			return
+		}
+		k.logger(ctx).Error("unable to refund failed deposit, holding it in escrow",
+			"cause", err.Error(),
+			"nonce", fmt.Sprint(receipt.EventNonce),
+		)
+	}
+	k.SetFailedDeposit(ctx, *receipt)
+}
+
+// refundFailedDeposit queues the transfer of an escrowed deposit back to its Ethereum sender, the
+// transfer pays no fee and is sent from the module account
+func (k Keeper) refundFailedDeposit(ctx sdk.Context, deposit types.DepositReceipt) (uint64, error) {
+	sender, err := types.NewEthAddress(deposit.EthereumSender)
+	if err != nil {
+		return 0, sdkerrors.Wrap(err, "invalid ethereum sender")
+	}
+	fee := sdk.NewCoin(deposit.Amount.Denom, sdk.ZeroInt())
+	return k.addToOutgoingPool(ctx, authtypes.NewModuleAddress(types.ModuleName), *sender, deposit.Amount, fee, fee)
+}
+
+// ReleaseFailedDeposit pays a deposit held in escrow to recipient, or refunds it to its Ethereum sender
+// when recipient is empty. The receipt of the deposit is updated if it has not been pruned yet.
+func (k Keeper) ReleaseFailedDeposit(ctx sdk.Context, eventNonce uint64, recipient string) error {
+	deposit := k.GetFailedDeposit(ctx, eventNonce)
+	if deposit == nil {
+		return sdkerrors.Wrapf(types.ErrInvalid, "no failed deposit at event nonce %d", eventNonce)
+	}
+
+	if recipient == "" {
+		txID, err := k.refundFailedDeposit(ctx, *deposit)
+		if err != nil {
+			return sdkerrors.Wrap(err, "refund")
+		}
+		deposit.RefundTxId = txID
+	} else {
+		addr, err := sdk.AccAddressFromBech32(recipient)
+		if err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, recipient)
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, sdk.Coins{deposit.Amount}); err != nil {
+			return sdkerrors.Wrap(err, "release")
+		}
+		deposit.ReleasedTo = recipient
+	}
+
+	k.store(ctx).Delete(types.GetFailedDepositKey(eventNonce))
+	if receipt := k.GetDepositReceipt(ctx, eventNonce); receipt != nil {
+		receipt.RefundTxId = deposit.RefundTxId
+		receipt.ReleasedTo = deposit.ReleasedTo
+		k.SetDepositReceipt(ctx, *receipt)
+	}
+	k.emitTypedEvent(ctx, &types.EventFailedDepositReleased{Deposit: *deposit})
+	return nil
+}
+
+// SetFailedDeposit stores a deposit held in escrow
+func (k Keeper) SetFailedDeposit(ctx sdk.Context, deposit types.DepositReceipt) {
+	k.store(ctx).Set(types.GetFailedDepositKey(deposit.EventNonce), k.cdc.MustMarshal(&deposit))
+}
+
+// GetFailedDeposit returns the deposit held in escrow at an event nonce, nil if there is none
+func (k Keeper) GetFailedDeposit(ctx sdk.Context, eventNonce uint64) *types.DepositReceipt {
+	bz := k.store(ctx).Get(types.GetFailedDepositKey(eventNonce))
+	if bz == nil {
+		return nil
+	}
+	var deposit types.DepositReceipt
+	k.cdc.MustUnmarshal(bz, &deposit)
+	return &deposit
+}
+
+// GetFailedDeposits returns every deposit held in escrow in ASC event nonce order
+func (k Keeper) GetFailedDeposits(ctx sdk.Context) (out []*types.DepositReceipt) {
+	prefixStore := prefix.NewStore(k.store(ctx), types.FailedDepositKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var deposit types.DepositReceipt
+		k.cdc.MustUnmarshal(iter.Value(), &deposit)
+		out = append(out, &deposit)
+	}
+	return
+}
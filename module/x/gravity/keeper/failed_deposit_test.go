@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// failDeposit observes a deposit to a receiver that is not a valid address
+func failDeposit(t *testing.T, input TestInput, eventNonce uint64) *types.DepositReceipt {
+	claim := types.MsgSendToCosmosClaim{
+		EventNonce:     eventNonce,
+		BlockHeight:    eventNonce,
+		TokenContract:  TokenContractAddrs[0],
+		Amount:         sdk.NewInt(500),
+		EthereumSender: EthAddrs[0].String(),
+		CosmosReceiver: "cosmos1typo",
+		Orchestrator:   AccAddrs[0].String(),
+	}
+	require.NoError(t, input.GravityKeeper.AttestationHandler.Handle(input.Context, types.Attestation{}, &claim))
+	receipt := input.GravityKeeper.GetDepositReceipt(input.Context, eventNonce)
+	require.NotNil(t, receipt)
+	require.False(t, receipt.Success)
+	return receipt
+}
+
+func TestFailedDepositRefundedRightAway(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+
+	receipt := failDeposit(t, input, 1)
+	require.NotZero(t, receipt.RefundTxId)
+	require.Nil(t, k.GetFailedDeposit(ctx, 1))
+
+	refund, err := k.GetUnbatchedTxById(ctx, receipt.RefundTxId)
+	require.NoError(t, err)
+	require.Equal(t, EthAddrs[0].String(), refund.DestAddress.GetAddress())
+	require.Equal(t, authtypes.NewModuleAddress(types.ModuleName), refund.Sender)
+	require.Equal(t, sdk.NewInt(500), refund.Erc20Token.Amount)
+	require.True(t, refund.Erc20Fee.Amount.IsZero())
+	// the vouchers minted into escrow are burned again by the refund
+	require.True(t, input.BankKeeper.GetSupply(ctx, receipt.Amount.Denom).Amount.IsZero())
+}
+
+func TestFailedDepositReleasedByGovernance(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	params := k.GetParams(ctx)
+	params.RefundFailedDeposits = false
+	k.SetParams(ctx, params)
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+
+	// the first deposit is paid to an account chosen by governance
+	receipt := failDeposit(t, input, 1)
+	require.Zero(t, receipt.RefundTxId)
+	require.Equal(t, receipt, k.GetFailedDeposit(ctx, 1))
+	require.Equal(t, receipt.Amount, input.BankKeeper.GetBalance(ctx, moduleAddr, receipt.Amount.Denom))
+
+	require.NoError(t, k.ReleaseFailedDeposit(ctx, 1, AccAddrs[1].String()))
+	require.Nil(t, k.GetFailedDeposit(ctx, 1))
+	require.Equal(t, receipt.Amount, input.BankKeeper.GetBalance(ctx, AccAddrs[1], receipt.Amount.Denom))
+	require.Equal(t, AccAddrs[1].String(), k.GetDepositReceipt(ctx, 1).ReleasedTo)
+	require.Error(t, k.ReleaseFailedDeposit(ctx, 1, AccAddrs[1].String()))
+
+	// the second is refunded to its Ethereum sender
+	failDeposit(t, input, 2)
+	require.Len(t, k.GetFailedDeposits(ctx), 1)
+	require.NoError(t, k.ReleaseFailedDeposit(ctx, 2, ""))
+	require.Empty(t, k.GetFailedDeposits(ctx))
+	refundID := k.GetDepositReceipt(ctx, 2).RefundTxId
+	require.NotZero(t, refundID)
+	_, err := k.GetUnbatchedTxById(ctx, refundID)
+	require.NoError(t, err)
+	require.True(t, input.BankKeeper.GetBalance(ctx, moduleAddr, receipt.Amount.Denom).IsZero())
+}
+
+// A deposit to an invalid receiver is submitted by the orchestrators like any other and ends up refunded
+func TestFailedDepositThroughMsgServer(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	msgServer := NewMsgServerImpl(k)
+	claim := func(orchestrator sdk.AccAddress, receiver string) *types.MsgSendToCosmosClaim {
+		return &types.MsgSendToCosmosClaim{
+			EventNonce:     1,
+			BlockHeight:    1,
+			TokenContract:  TokenContractAddrs[0],
+			Amount:         sdk.NewInt(500),
+			EthereumSender: EthAddrs[0].String(),
+			CosmosReceiver: receiver,
+			Orchestrator:   orchestrator.String(),
+		}
+	}
+
+	require.Error(t, claim(AccAddrs[0], "").ValidateBasic())
+	require.Error(t, claim(AccAddrs[0], strings.Repeat("a", 256)).ValidateBasic())
+
+	for i := range ValAddrs {
+		k.SetOrchestratorValidator(ctx, ValAddrs[i], AccAddrs[i])
+		msg := claim(AccAddrs[i], "cosmos1typo")
+		require.NoError(t, msg.ValidateBasic())
+		_, err := msgServer.SendToCosmosClaim(sdk.WrapSDKContext(ctx), msg)
+		require.NoError(t, err)
+	}
+	hash, err := claim(AccAddrs[0], "cosmos1typo").ClaimHash()
+	require.NoError(t, err)
+	k.TryAttestation(ctx, k.GetAttestation(ctx, 1, hash))
+
+	receipt := k.GetDepositReceipt(ctx, 1)
+	require.NotNil(t, receipt)
+	require.False(t, receipt.Success)
+	require.Equal(t, "cosmos1typo", receipt.CosmosReceiver)
+	require.NotZero(t, receipt.RefundTxId)
+	require.Len(t, k.GetDepositReceiptsByReceiver(ctx, "cosmos1typo"), 1)
+}
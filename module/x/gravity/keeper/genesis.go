@@ -13,9 +13,44 @@ import (
 // InitGenesis starts a chain from a genesis state
 func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 	k.SetParams(ctx, *data.Params)
+	initEvmChainGenesis(ctx, k, data)
+
+	// the state shared by all chains is only read from the top level genesis state
+	k.SetLastUnBondingBlockHeight(ctx, data.LastUnBondingBlockHeight)
+
+	for _, cosmosAddr := range data.StaticValCosmosAddrs {
+		k.SetStaticValCosmosAddr(ctx, cosmosAddr)
+	}
+
+	for _, address := range data.Blocklist {
+		normalized, err := types.NormalizeBlocklistAddress(address)
+		if err != nil {
+			panic(sdkerrors.Wrap(err, "blocklist"))
+		}
+		k.SetBlockedAddress(ctx, normalized)
+	}
+
+	var bridgeContractAddress string
+	k.paramSpace.Get(ctx, types.ParamsStoreKeyBridgeContractAddress, &bridgeContractAddress)
+	if bridgeContractAddress == "" {
+		k.paramSpace.Set(ctx, types.ParamsStoreKeyBridgeContractAddress, types.ZeroAddressString)
+	}
+
+	for _, chainState := range data.EvmChainStates {
+		chainKeeper, err := k.EvmChainKeeper(ctx, chainState.ChainId)
+		if err != nil {
+			panic(err)
+		}
+		initEvmChainGenesis(ctx, chainKeeper, *chainState.State)
+	}
+}
+
+// initEvmChainGenesis sets the state of the chain the keeper is scoped to, the params of the genesis state
+// are not used
+func initEvmChainGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 	// reset valsets in state
 	for _, vs := range data.Valsets {
-		// TODO: block height?
+		// the valset keeps the height it was created at
 		k.StoreValsetUnsafe(ctx, vs)
 	}
 
@@ -26,7 +61,7 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 
 	// reset batches in state
 	for _, batch := range data.Batches {
-		// TODO: block height?
+		// the batch keeps the block it was created at, which also indexes it for slashing
 		intBatch, err := batch.ToInternal()
 		if err != nil {
 			panic(sdkerrors.Wrapf(err, "unable to make batch internal: %v", batch))
@@ -70,7 +105,6 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 			panic("couldn't cast to claim")
 		}
 
-		// TODO: block height?
 		hash, err := claim.ClaimHash()
 		if err != nil {
 			panic(fmt.Errorf("error when computing ClaimHash for %v", hash))
@@ -87,15 +121,8 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		if err != nil {
 			panic("couldn't cast to claim")
 		}
-		// reconstruct the latest event nonce for every validator
-		// if somehow this genesis state is saved when all attestations
-		// have been cleaned up GetLastEventNonceByValidator handles that case
-		//
-		// if we where to save and load the last event nonce for every validator
-		// then we would need to carry that state forever across all chain restarts
-		// but since we've already had to handle the edge case of new validators joining
-		// while all attestations have already been cleaned up we can do this instead and
-		// not carry around every validators event nonce counter forever.
+		// reconstruct the latest event nonce for every validator, this is all
+		// older genesis files that predate LastEventNoncesByValidator carry
 		for _, vote := range att.Votes {
 			val, err := sdk.ValAddressFromBech32(vote)
 			if err != nil {
@@ -108,6 +135,16 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		}
 	}
 
+	// restore the stored event nonces, these take precedence over the ones reconstructed
+	// above since the attestations of a validator may all have been pruned
+	for _, lastNonce := range data.LastEventNoncesByValidator {
+		val, err := sdk.ValAddressFromBech32(lastNonce.Validator)
+		if err != nil {
+			panic(err)
+		}
+		k.setLastEventNonceByValidator(ctx, val, lastNonce.EventNonce)
+	}
+
 	// reset delegate keys in state
 	for _, keys := range data.DelegateKeys {
 		err := keys.ValidateBasic()
@@ -142,9 +179,10 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 
 	// now that we have the denom-erc20 mapping we need to validate
 	// that the valset reward is possible and cosmos originated remove
-	// this if you want a non-cosmos originated reward
+	// this if you want a non-cosmos originated reward, the other chains pay no reward
+	// for the denom unless it is mapped
 	valsetReward := k.GetParams(ctx).ValsetReward
-	if valsetReward.IsValid() && !valsetReward.IsZero() {
+	if k.EvmChainID() == 0 && valsetReward.IsValid() && !valsetReward.IsZero() {
 		_, exists := k.GetCosmosOriginatedERC20(ctx, valsetReward.Denom)
 		if !exists {
 			panic("Invalid Cosmos originated denom for valset reward")
@@ -166,26 +204,89 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 	k.SetLastSlashedLogicCallBlock(ctx, data.LastSlashedLogicCallBlock)
 	k.SetLastSlashedBatchBlock(ctx, data.LastSlashedBatchedBlock)
 	k.SetLastSlashedValsetNonce(ctx, data.LastSlashedValsetNonce)
-	k.SetLastUnBondingBlockHeight(ctx, data.LastUnBondingBlockHeight)
 	k.SetLatestValsetNonce(ctx, data.LastLatestValsetNonce)
+	k.SetLastSlashedClaimNonce(ctx, data.LastSlashedClaimNonce)
+	k.SetLastConflictCheckedNonce(ctx, data.LastConflictCheckedNonce)
 
-	for _, cosmosAddr := range data.StaticValCosmosAddrs {
-		k.SetStaticValCosmosAddr(ctx, cosmosAddr)
+	for _, incident := range data.BridgeHijackIncidents {
+		k.SetBridgeHijackIncident(ctx, *incident)
 	}
 
-	var bridgeContractAddress string
-	k.paramSpace.Get(ctx, types.ParamsStoreKeyBridgeContractAddress, &bridgeContractAddress)
-	if bridgeContractAddress == "" {
-		k.paramSpace.Set(ctx, types.ParamsStoreKeyBridgeContractAddress, types.ZeroAddressString)
+	for _, conflict := range data.ConflictingClaims {
+		k.SetConflictingClaim(ctx, *conflict)
+	}
+
+	for _, evidence := range data.BadSignatureEvidence {
+		k.SetBadSignatureEvidence(ctx, *evidence)
+	}
+
+	for _, history := range data.TransferHistory {
+		k.SetTransferHistory(ctx, *history)
+	}
+
+	for _, receipt := range data.DepositReceipts {
+		k.SetDepositReceipt(ctx, *receipt)
+	}
+
+	for _, deposit := range data.FailedDeposits {
+		k.SetFailedDeposit(ctx, *deposit)
+	}
+
+	for _, deposit := range data.QuarantinedDeposits {
+		k.SetQuarantinedDeposit(ctx, *deposit)
 	}
 
+	for _, vote := range data.EthereumHeightVotes {
+		k.SetEthereumHeightVote(ctx, *vote)
+	}
+
+	for _, queued := range data.QueuedTransferHeights {
+		k.SetQueuedTransferHeight(ctx, *queued)
+	}
+
+	for _, locked := range data.CosmosOriginatedLocked {
+		k.setCosmosOriginatedLocked(ctx, locked)
+	}
+
+	// without the checkpoints honest signatures over past valsets and batches could be slashed
+	for _, checkpoint := range data.PastEthSignatureCheckpoints {
+		k.SetPastEthSignatureCheckpoint(ctx, checkpoint)
+	}
+
+	if data.LastObservedEthereumBlockHeight.EthereumBlockHeight != 0 {
+		k.SetLastObservedEthereumBlockHeightUnsafe(ctx, data.LastObservedEthereumBlockHeight)
+	}
+
+	if data.LastObservedValset != nil {
+		k.SetLastObservedValset(ctx, *data.LastObservedValset)
+	}
 }
 
 // ExportGenesis exports all the state needed to restart the chain
 // from the current state of the chain
 func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
+	p := k.GetParams(ctx)
+	state := exportEvmChainGenesis(ctx, k)
+	state.Params = &p
+	state.LastUnBondingBlockHeight = k.GetLastUnBondingBlockHeight(ctx)
+	state.StaticValCosmosAddrs = k.GetStaticValCosmosAddrs(ctx)
+	state.Blocklist = k.GetBlockedAddresses(ctx)
+
+	// the first keeper is the one of the default chain, exported above
+	for _, chainKeeper := range k.EvmChainKeepers(ctx)[1:] {
+		chainState := exportEvmChainGenesis(ctx, chainKeeper)
+		state.EvmChainStates = append(state.EvmChainStates, &types.EvmChainGenesisState{
+			ChainId: chainKeeper.EvmChainID(),
+			State:   &chainState,
+		})
+	}
+	return state
+}
+
+// exportEvmChainGenesis exports the state of the chain the keeper is scoped to, without the params and the
+// state shared by all chains
+func exportEvmChainGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 	var (
-		p                         = k.GetParams(ctx)
 		calls                     = k.GetOutgoingLogicCalls(ctx)
 		batches                   = k.GetOutgoingTxBatches(ctx)
 		valsets                   = k.GetValsets(ctx)
@@ -203,21 +304,33 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 		lastSlashedLogicCallBlock = k.GetLastSlashedLogicCallBlock(ctx)
 		lastSlashedBatchedBlock   = k.GetLastSlashedBatchBlock(ctx)
 		lastSlashedValsetNonce    = k.GetLastSlashedValsetNonce(ctx)
-		lastUnBondingBlockHeight  = k.GetLastUnBondingBlockHeight(ctx)
 		lastLatestValsetNonce     = k.GetLatestValsetNonce(ctx)
-		staticValCosmosAddrs      = k.GetStaticValCosmosAddrs(ctx)
+		bridgeHijackIncidents     = k.GetBridgeHijackIncidents(ctx)
+		conflictingClaims         = k.GetConflictingClaims(ctx)
+		lastSlashedClaimNonce     = k.GetLastSlashedClaimNonce(ctx)
+		lastConflictChecked       = k.GetLastConflictCheckedNonce(ctx)
+		badSignatureEvidence      = k.GetAllBadSignatureEvidence(ctx)
+		pastCheckpoints           = k.GetPastEthSignatureCheckpoints(ctx)
+		lastObservedEthHeight     = k.GetLastObservedEthereumBlockHeight(ctx)
+		lastObservedValset        = k.GetLastObservedValset(ctx)
+		lastEventNonces           = k.GetLastEventNoncesByValidator(ctx)
+		transferHistory           = k.GetTransferHistories(ctx)
+		depositReceipts           = k.GetDepositReceipts(ctx)
+		failedDeposits            = k.GetFailedDeposits(ctx)
+		quarantinedDeposits       = k.GetQuarantinedDeposits(ctx)
+		ethereumHeightVotes       = k.GetEthereumHeightVotes(ctx)
+		queuedTransferHeights     = k.GetQueuedTransferHeights(ctx)
+		cosmosOriginatedLocked    = k.GetCosmosOriginatedLockedCoins(ctx)
 	)
 
 	// export valset confirmations from state
 	for _, vs := range valsets {
-		// TODO: set height = 0?
 		vsconfs = append(vsconfs, k.GetValsetConfirms(ctx, vs.Nonce)...)
 	}
 
 	// export batch confirmations from state
 	extBatches := make([]*types.OutgoingTxBatch, len(batches))
 	for i, batch := range batches {
-		// TODO: set height = 0?
 		batchconfs = append(batchconfs,
 			k.GetBatchConfirmByNonceAndTokenContract(ctx, batch.BatchNonce, batch.TokenContract)...)
 		extBatches[i] = batch.ToExternal()
@@ -225,7 +338,6 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 
 	// export logic call confirmations from state
 	for _, call := range calls {
-		// TODO: set height = 0?
 		callconfs = append(callconfs,
 			k.GetLogicConfirmByInvalidationIDAndNonce(ctx, call.InvalidationId, call.InvalidationNonce)...)
 	}
@@ -257,25 +369,38 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 	}
 
 	return types.GenesisState{
-		Params:                    &p,
-		LastObservedNonce:         lastobserved,
-		Valsets:                   valsets,
-		ValsetConfirms:            vsconfs,
-		Batches:                   extBatches,
-		BatchConfirms:             batchconfs,
-		LogicCalls:                calls,
-		LogicCallConfirms:         callconfs,
-		Attestations:              attestations,
-		DelegateKeys:              delegates,
-		Erc20ToDenoms:             erc20ToDenoms,
-		UnbatchedTransfers:        unbatchedTxs,
-		LastTxPoolId:              lastTxPoolId,
-		LastOutgoingBatchId:       lastOutgoingBatchID,
-		LastSlashedLogicCallBlock: lastSlashedLogicCallBlock,
-		LastSlashedBatchedBlock:   lastSlashedBatchedBlock,
-		LastSlashedValsetNonce:    lastSlashedValsetNonce,
-		LastUnBondingBlockHeight:  lastUnBondingBlockHeight,
-		LastLatestValsetNonce:     lastLatestValsetNonce,
-		StaticValCosmosAddrs:      staticValCosmosAddrs,
+		LastObservedNonce:               lastobserved,
+		Valsets:                         valsets,
+		ValsetConfirms:                  vsconfs,
+		Batches:                         extBatches,
+		BatchConfirms:                   batchconfs,
+		LogicCalls:                      calls,
+		LogicCallConfirms:               callconfs,
+		Attestations:                    attestations,
+		DelegateKeys:                    delegates,
+		Erc20ToDenoms:                   erc20ToDenoms,
+		UnbatchedTransfers:              unbatchedTxs,
+		LastTxPoolId:                    lastTxPoolId,
+		LastOutgoingBatchId:             lastOutgoingBatchID,
+		LastSlashedLogicCallBlock:       lastSlashedLogicCallBlock,
+		LastSlashedBatchedBlock:         lastSlashedBatchedBlock,
+		LastSlashedValsetNonce:          lastSlashedValsetNonce,
+		LastLatestValsetNonce:           lastLatestValsetNonce,
+		BridgeHijackIncidents:           bridgeHijackIncidents,
+		ConflictingClaims:               conflictingClaims,
+		LastSlashedClaimNonce:           lastSlashedClaimNonce,
+		BadSignatureEvidence:            badSignatureEvidence,
+		PastEthSignatureCheckpoints:     pastCheckpoints,
+		LastObservedEthereumBlockHeight: lastObservedEthHeight,
+		LastObservedValset:              lastObservedValset,
+		LastEventNoncesByValidator:      lastEventNonces,
+		TransferHistory:                 transferHistory,
+		DepositReceipts:                 depositReceipts,
+		FailedDeposits:                  failedDeposits,
+		QuarantinedDeposits:             quarantinedDeposits,
+		EthereumHeightVotes:             ethereumHeightVotes,
+		QueuedTransferHeights:           queuedTransferHeights,
+		LastConflictCheckedNonce:        lastConflictChecked,
+		CosmosOriginatedLocked:          cosmosOriginatedLocked,
 	}
 }
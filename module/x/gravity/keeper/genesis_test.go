@@ -1,13 +1,17 @@
 package keeper
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"hash"
 	"sort"
 	"testing"
 	"time"
 
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -237,3 +241,202 @@ func InitGenesisWithFailData(t *testing.T, input *TestInput) {
 	input = &newEnv
 	assert.PanicsWithError(t, expectedPanicMessage, func() { InitGenesis(input.Context, input.GravityKeeper, genesisState) })
 }
+
+// Tests that every store prefix survives an export and import unchanged
+func TestGenesisStoreRoundTrip(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	for i := range ValAddrs {
+		k.SetOrchestratorValidator(ctx, ValAddrs[i], AccAddrs[i])
+	}
+
+	// a valset request with a confirm, its checkpoint is stored as well
+	valset := k.SetValsetRequest(ctx)
+	k.SetValsetConfirm(ctx, types.MsgValsetConfirm{
+		Nonce:        valset.Nonce,
+		Orchestrator: AccAddrs[0].String(),
+		EthAddress:   EthAddrs[0].String(),
+		Signature:    "d34db33f",
+	})
+
+	// a batch with a confirm and a transaction left in the pool
+	token, err := types.NewInternalERC20Token(sdk.NewInt(99999), TokenContractAddrs[0])
+	require.NoError(t, err)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(token.GravityCoin())))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, AccAddrs[0], sdk.NewCoins(token.GravityCoin())))
+	receiver, err := types.NewEthAddress(EthAddrs[1].String())
+	require.NoError(t, err)
+	for i := 1; i <= 3; i++ {
+		amount, err := types.NewInternalERC20Token(sdk.NewInt(int64(100*i)), TokenContractAddrs[0])
+		require.NoError(t, err)
+		fee, err := types.NewInternalERC20Token(sdk.NewInt(int64(i)), TokenContractAddrs[0])
+		require.NoError(t, err)
+		_, err = k.AddToOutgoingPool(ctx, AccAddrs[0], *receiver, amount.GravityCoin(), fee.GravityCoin())
+		require.NoError(t, err)
+	}
+	// a canceled transfer is only left in the transfer history
+	amount, err := types.NewInternalERC20Token(sdk.NewInt(50), TokenContractAddrs[0])
+	require.NoError(t, err)
+	canceledID, err := k.AddToOutgoingPool(ctx, AccAddrs[0], *receiver, amount.GravityCoin(), amount.GravityCoin())
+	require.NoError(t, err)
+	require.NoError(t, k.RemoveFromOutgoingPoolAndRefund(ctx, canceledID, AccAddrs[0]))
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	batch, err := k.BuildOutgoingTXBatch(ctx, token.Contract, 2)
+	require.NoError(t, err)
+	k.SetBatchConfirm(ctx, &types.MsgConfirmBatch{
+		Nonce:         batch.BatchNonce,
+		TokenContract: batch.TokenContract.GetAddress(),
+		EthSigner:     EthAddrs[0].String(),
+		Orchestrator:  AccAddrs[0].String(),
+		Signature:     "d34db33f",
+	})
+
+	// an observed deposit and a pending one, the last validator never submits a claim
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	for nonce := uint64(1); nonce <= 2; nonce++ {
+		for i, orch := range AccAddrs[:4] {
+			if nonce == 2 && i > 0 {
+				break
+			}
+			msg := types.MsgSendToCosmosClaim{
+				EventNonce:     nonce,
+				BlockHeight:    nonce + 100,
+				TokenContract:  TokenContractAddrs[0],
+				Amount:         sdk.NewInt(1000),
+				EthereumSender: EthAddrs[0].String(),
+				CosmosReceiver: AccAddrs[0].String(),
+				Orchestrator:   orch.String(),
+			}
+			any, err := codectypes.NewAnyWithValue(&msg)
+			require.NoError(t, err)
+			att, err := k.Attest(ctx, &msg, any)
+			require.NoError(t, err)
+			k.TryAttestation(ctx, att)
+		}
+	}
+	require.Equal(t, uint64(1), k.GetLastObservedEventNonce(ctx))
+	require.NotZero(t, k.GetLastObservedEthereumBlockHeight(ctx).EthereumBlockHeight)
+
+	// a validator whose claims have all been pruned still has its own event nonce
+	k.setLastEventNonceByValidator(ctx, ValAddrs[4], 1)
+
+	k.SetLastObservedValset(ctx, *valset)
+	erc20, err := types.NewEthAddress(TokenContractAddrs[1])
+	require.NoError(t, err)
+	k.setCosmosOriginatedDenomToERC20(ctx, "ualtg", *erc20)
+	k.SetBridgeHijackIncident(ctx, types.BridgeHijackIncident{
+		ValsetNonce:     valset.Nonce,
+		EventNonce:      3,
+		EthereumHeight:  200,
+		CosmosHeight:    uint64(ctx.BlockHeight()),
+		ExpectedMembers: valset.Members,
+		ObservedMembers: valset.Members[:1],
+	})
+	k.SetConflictingClaim(ctx, types.ConflictingClaim{
+		EventNonce:        1,
+		Validator:         ValAddrs[4].String(),
+		ClaimHash:         []byte{0x1},
+		ObservedClaimHash: []byte{0x2},
+		Height:            uint64(ctx.BlockHeight()),
+	})
+	k.SetBadSignatureEvidence(ctx, types.BadSignatureEvidence{
+		Checkpoint:      []byte{0x1, 0x2},
+		Signature:       "d34db33f",
+		Validator:       ValAddrs[3].String(),
+		EthereumAddress: EthAddrs[3].String(),
+		Submitter:       AccAddrs[0].String(),
+		Height:          ctx.BlockHeight(),
+		Reward:          sdk.NewInt64Coin("stake", 10),
+	})
+	k.SetFailedDeposit(ctx, types.DepositReceipt{
+		EventNonce:     3,
+		EthereumHeight: 103,
+		TokenContract:  TokenContractAddrs[0],
+		Amount:         token.GravityCoin(),
+		EthereumSender: EthAddrs[0].String(),
+		CosmosReceiver: "cosmos1typo",
+		Error:          "invalid receiver address",
+		Height:         ctx.BlockHeight(),
+	})
+	k.SetQuarantinedDeposit(ctx, types.DepositReceipt{
+		EventNonce:     4,
+		EthereumHeight: 104,
+		TokenContract:  TokenContractAddrs[0],
+		Amount:         token.GravityCoin(),
+		EthereumSender: EthAddrs[1].String(),
+		CosmosReceiver: AccAddrs[1].String(),
+		Error:          types.ErrBlocked.Error(),
+		Height:         ctx.BlockHeight(),
+		Quarantined:    true,
+	})
+	require.NoError(t, k.UpdateBlocklist(ctx, []string{EthAddrs[1].String(), AccAddrs[2].String()}, nil))
+	k.SetEthereumHeightVote(ctx, types.EthereumHeightVote{
+		Validator:         ValAddrs[2].String(),
+		EthereumHeight:    150,
+		CosmosBlockHeight: uint64(ctx.BlockHeight()),
+		CosmosBlockTimeMs: 1234,
+	})
+	k.SetQueuedTransferHeight(ctx, types.QueuedTransferHeight{
+		TxId:          1,
+		Height:        ctx.BlockHeight(),
+		TokenContract: TokenContractAddrs[0],
+		Fee:           sdk.NewInt(10),
+	})
+
+	// a second EVM chain started from an empty state, with delegate keys and a valset request of its own
+	evmChain := registerTestEvmChain(t, ctx, k)
+	initEvmChainGenesis(ctx, evmChain, *types.DefaultGenesisState())
+	for i := range ValAddrs {
+		ethAddr, err := types.NewEthAddress(EthAddrs[len(EthAddrs)-1-i].String())
+		require.NoError(t, err)
+		evmChain.SetEthAddressForValidator(ctx, ValAddrs[i], *ethAddr)
+	}
+	evmChain.SetValsetRequest(ctx)
+	evmChain.SetLastSlashedValsetNonce(ctx, 1)
+	evmChain.lockCosmosOriginated(ctx, sdk.NewInt64Coin("stake", 5))
+
+	k.SetLastSlashedValsetNonce(ctx, 1)
+	k.SetLastSlashedBatchBlock(ctx, 10)
+	k.SetLastSlashedLogicCallBlock(ctx, 11)
+	k.SetLastUnBondingBlockHeight(ctx, 12)
+	k.SetLastSlashedClaimNonce(ctx, 1)
+	k.SetLastConflictCheckedNonce(ctx, 1)
+
+	// export through JSON like a real upgrade would
+	genesis := ExportGenesis(ctx, k)
+	bz := input.Marshaler.MustMarshalJSON(&genesis)
+	var imported types.GenesisState
+	input.Marshaler.MustUnmarshalJSON(bz, &imported)
+	require.NoError(t, imported.ValidateBasic())
+
+	newInput := CreateTestEnv(t)
+	newCtx := newInput.Context
+	InitGenesis(newCtx, newInput.GravityKeeper, imported)
+
+	expected := gravityStorePrefixHashes(ctx, k)
+	actual := gravityStorePrefixHashes(newCtx, newInput.GravityKeeper)
+	for prefix, hash := range expected {
+		require.Equal(t, hash, actual[prefix], "store prefix 0x%x differs after import", prefix)
+	}
+	require.Equal(t, len(expected), len(actual))
+}
+
+// gravityStorePrefixHashes hashes the gravity store entries grouped by their first key byte
+func gravityStorePrefixHashes(ctx sdk.Context, k Keeper) map[byte]string {
+	hashes := make(map[byte]hash.Hash)
+	iter := ctx.KVStore(k.storeKey).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		prefix := iter.Key()[0]
+		if _, ok := hashes[prefix]; !ok {
+			hashes[prefix] = sha256.New()
+		}
+		hashes[prefix].Write(iter.Key())
+		hashes[prefix].Write(iter.Value())
+	}
+	out := make(map[byte]string, len(hashes))
+	for prefix, h := range hashes {
+		out[prefix] = hex.EncodeToString(h.Sum(nil))
+	}
+	return out
+}
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
@@ -35,33 +36,53 @@ func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types
 func (k Keeper) CurrentValset(
 	c context.Context,
 	req *types.QueryCurrentValsetRequest) (*types.QueryCurrentValsetResponse, error) {
-	return &types.QueryCurrentValsetResponse{Valset: k.GetCurrentValset(sdk.UnwrapSDKContext(c))}, nil
+	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.EvmChainKeeper(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryCurrentValsetResponse{Valset: k.GetCurrentValset(ctx)}, nil
 }
 
 // ValsetRequest queries the ValsetRequest of the gravity module
 func (k Keeper) ValsetRequest(
 	c context.Context,
 	req *types.QueryValsetRequestRequest) (*types.QueryValsetRequestResponse, error) {
-	return &types.QueryValsetRequestResponse{Valset: k.GetValset(sdk.UnwrapSDKContext(c), req.Nonce)}, nil
+	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.EvmChainKeeper(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryValsetRequestResponse{Valset: k.GetValset(ctx, req.Nonce)}, nil
 }
 
 // ValsetConfirm queries the ValsetConfirm of the gravity module
 func (k Keeper) ValsetConfirm(
 	c context.Context,
 	req *types.QueryValsetConfirmRequest) (*types.QueryValsetConfirmResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.EvmChainKeeper(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
 	addr, err := sdk.AccAddressFromBech32(req.Address)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "address invalid")
 	}
-	return &types.QueryValsetConfirmResponse{Confirm: k.GetValsetConfirm(sdk.UnwrapSDKContext(c), req.Nonce, addr)}, nil
+	return &types.QueryValsetConfirmResponse{Confirm: k.GetValsetConfirm(ctx, req.Nonce, addr)}, nil
 }
 
 // ValsetConfirmsByNonce queries the ValsetConfirmsByNonce of the gravity module
 func (k Keeper) ValsetConfirmsByNonce(
 	c context.Context,
 	req *types.QueryValsetConfirmsByNonceRequest) (*types.QueryValsetConfirmsByNonceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.EvmChainKeeper(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
 	var confirms []*types.MsgValsetConfirm
-	k.IterateValsetConfirmByNonce(sdk.UnwrapSDKContext(c), req.Nonce, func(_ []byte, c types.MsgValsetConfirm) bool {
+	k.IterateValsetConfirmByNonce(ctx, req.Nonce, func(_ []byte, c types.MsgValsetConfirm) bool {
 		confirms = append(confirms, &c)
 		return false
 	})
@@ -72,7 +93,12 @@ func (k Keeper) ValsetConfirmsByNonce(
 func (k Keeper) LastValsetRequests(
 	c context.Context,
 	req *types.QueryLastValsetRequestsRequest) (*types.QueryLastValsetRequestsResponse, error) {
-	valReq := k.GetValsets(sdk.UnwrapSDKContext(c))
+	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.EvmChainKeeper(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
+	valReq := k.GetValsets(ctx)
 	valReqLen := len(valReq)
 	retLen := 0
 	if valReqLen < maxValsetRequestsReturned {
@@ -87,15 +113,20 @@ func (k Keeper) LastValsetRequests(
 func (k Keeper) LastPendingValsetRequestByAddr(
 	c context.Context,
 	req *types.QueryLastPendingValsetRequestByAddrRequest) (*types.QueryLastPendingValsetRequestByAddrResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.EvmChainKeeper(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
 	addr, err := sdk.AccAddressFromBech32(req.Address)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "address invalid")
 	}
 
 	var pendingValsetReq []*types.Valset
-	k.IterateValsets(sdk.UnwrapSDKContext(c), func(_ []byte, val *types.Valset) bool {
+	k.IterateValsets(ctx, func(_ []byte, val *types.Valset) bool {
 		// foundConfirm is true if the operatorAddr has signed the valset we are currently looking at
-		foundConfirm := k.GetValsetConfirm(sdk.UnwrapSDKContext(c), val.Nonce, addr) != nil
+		foundConfirm := k.GetValsetConfirm(ctx, val.Nonce, addr) != nil
 		// if this valset has NOT been signed by operatorAddr, store it in pendingValsetReq
 		// and exit the loop
 		if !foundConfirm {
@@ -116,21 +147,31 @@ func (k Keeper) LastPendingValsetRequestByAddr(
 func (k Keeper) BatchFees(
 	c context.Context,
 	req *types.QueryBatchFeeRequest) (*types.QueryBatchFeeResponse, error) {
-	return &types.QueryBatchFeeResponse{BatchFees: k.GetAllBatchFees(sdk.UnwrapSDKContext(c), OutgoingTxBatchSize)}, nil
+	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.EvmChainKeeper(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryBatchFeeResponse{BatchFees: k.GetAllBatchFees(ctx, OutgoingTxBatchSize)}, nil
 }
 
 // LastPendingBatchRequestByAddr queries the LastPendingBatchRequestByAddr of the gravity module
 func (k Keeper) LastPendingBatchRequestByAddr(
 	c context.Context,
 	req *types.QueryLastPendingBatchRequestByAddrRequest) (*types.QueryLastPendingBatchRequestByAddrResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.EvmChainKeeper(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
 	addr, err := sdk.AccAddressFromBech32(req.Address)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "address invalid")
 	}
 
 	var pendingBatchReq *types.InternalOutgoingTxBatch
-	k.IterateOutgoingTXBatches(sdk.UnwrapSDKContext(c), func(_ []byte, batch *types.InternalOutgoingTxBatch) bool {
-		foundConfirm := k.GetBatchConfirm(sdk.UnwrapSDKContext(c), batch.BatchNonce, batch.TokenContract, addr) != nil
+	k.IterateOutgoingTXBatches(ctx, func(_ []byte, batch *types.InternalOutgoingTxBatch) bool {
+		foundConfirm := k.GetBatchConfirm(ctx, batch.BatchNonce, batch.TokenContract, addr) != nil
 		if !foundConfirm {
 			pendingBatchReq = batch
 			return true
@@ -138,20 +179,28 @@ func (k Keeper) LastPendingBatchRequestByAddr(
 		return false
 	})
 
+	if pendingBatchReq == nil {
+		return &types.QueryLastPendingBatchRequestByAddrResponse{Batch: nil}, nil
+	}
 	return &types.QueryLastPendingBatchRequestByAddrResponse{Batch: pendingBatchReq.ToExternal()}, nil
 }
 
 func (k Keeper) LastPendingLogicCallByAddr(
 	c context.Context,
 	req *types.QueryLastPendingLogicCallByAddrRequest) (*types.QueryLastPendingLogicCallByAddrResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.EvmChainKeeper(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
 	addr, err := sdk.AccAddressFromBech32(req.Address)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "address invalid")
 	}
 
 	var pendingLogicReq *types.OutgoingLogicCall
-	k.IterateOutgoingLogicCalls(sdk.UnwrapSDKContext(c), func(_ []byte, logic *types.OutgoingLogicCall) bool {
-		foundConfirm := k.GetLogicCallConfirm(sdk.UnwrapSDKContext(c),
+	k.IterateOutgoingLogicCalls(ctx, func(_ []byte, logic *types.OutgoingLogicCall) bool {
+		foundConfirm := k.GetLogicCallConfirm(ctx,
 			logic.InvalidationId, logic.InvalidationNonce, addr) != nil
 		if !foundConfirm {
 			pendingLogicReq = logic
@@ -166,8 +215,13 @@ func (k Keeper) LastPendingLogicCallByAddr(
 func (k Keeper) OutgoingTxBatches(
 	c context.Context,
 	req *types.QueryOutgoingTxBatchesRequest) (*types.QueryOutgoingTxBatchesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.EvmChainKeeper(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
 	var batches []*types.OutgoingTxBatch
-	k.IterateOutgoingTXBatches(sdk.UnwrapSDKContext(c), func(_ []byte, batch *types.InternalOutgoingTxBatch) bool {
+	k.IterateOutgoingTXBatches(ctx, func(_ []byte, batch *types.InternalOutgoingTxBatch) bool {
 		batches = append(batches, batch.ToExternal())
 		return len(batches) == MaxResults
 	})
@@ -178,23 +232,48 @@ func (k Keeper) OutgoingTxBatches(
 func (k Keeper) OutgoingLogicCalls(
 	c context.Context,
 	req *types.QueryOutgoingLogicCallsRequest) (*types.QueryOutgoingLogicCallsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.EvmChainKeeper(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
 	var calls []*types.OutgoingLogicCall
-	k.IterateOutgoingLogicCalls(sdk.UnwrapSDKContext(c), func(_ []byte, call *types.OutgoingLogicCall) bool {
+	k.IterateOutgoingLogicCalls(ctx, func(_ []byte, call *types.OutgoingLogicCall) bool {
 		calls = append(calls, call)
 		return len(calls) == MaxResults
 	})
 	return &types.QueryOutgoingLogicCallsResponse{Calls: calls}, nil
 }
 
+// OutgoingLogicCall queries a single outgoing logic call by invalidation id and nonce
+func (k Keeper) OutgoingLogicCall(
+	c context.Context,
+	req *types.QueryOutgoingLogicCallRequest) (*types.QueryOutgoingLogicCallResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.EvmChainKeeper(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
+	if !k.HasOutgoingLogicCall(ctx, req.InvalidationId, req.InvalidationNonce) {
+		return &types.QueryOutgoingLogicCallResponse{}, nil
+	}
+	return &types.QueryOutgoingLogicCallResponse{Call: k.GetOutgoingLogicCall(ctx, req.InvalidationId, req.InvalidationNonce)}, nil
+}
+
 // BatchRequestByNonce queries the BatchRequestByNonce of the gravity module
 func (k Keeper) BatchRequestByNonce(
 	c context.Context,
 	req *types.QueryBatchRequestByNonceRequest) (*types.QueryBatchRequestByNonceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.EvmChainKeeper(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
 	addr, err := types.NewEthAddress(req.ContractAddress)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, err.Error())
 	}
-	foundBatch := k.GetOutgoingTXBatch(sdk.UnwrapSDKContext(c), *addr, req.Nonce)
+	foundBatch := k.GetOutgoingTXBatch(ctx, *addr, req.Nonce)
 	if foundBatch == nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Can not find tx batch")
 	}
@@ -205,12 +284,17 @@ func (k Keeper) BatchRequestByNonce(
 func (k Keeper) BatchConfirms(
 	c context.Context,
 	req *types.QueryBatchConfirmsRequest) (*types.QueryBatchConfirmsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.EvmChainKeeper(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
 	var confirms []*types.MsgConfirmBatch
 	contract, err := types.NewEthAddress(req.ContractAddress)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "invalid contract address in request")
 	}
-	k.IterateBatchConfirmByNonceAndTokenContract(sdk.UnwrapSDKContext(c),
+	k.IterateBatchConfirmByNonceAndTokenContract(ctx,
 		req.Nonce, *contract, func(_ []byte, c types.MsgConfirmBatch) bool {
 			confirms = append(confirms, &c)
 			return false
@@ -222,8 +306,13 @@ func (k Keeper) BatchConfirms(
 func (k Keeper) LogicConfirms(
 	c context.Context,
 	req *types.QueryLogicConfirmsRequest) (*types.QueryLogicConfirmsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.EvmChainKeeper(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
 	var confirms []*types.MsgConfirmLogicCall
-	k.IterateLogicConfirmByInvalidationIDAndNonce(sdk.UnwrapSDKContext(c), req.InvalidationId,
+	k.IterateLogicConfirmByInvalidationIDAndNonce(ctx, req.InvalidationId,
 		req.InvalidationNonce, func(_ []byte, c *types.MsgConfirmLogicCall) bool {
 			confirms = append(confirms, c)
 			return false
@@ -238,6 +327,10 @@ func (k Keeper) LastEventNonceByAddr(
 	c context.Context,
 	req *types.QueryLastEventNonceByAddrRequest) (*types.QueryLastEventNonceByAddrResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.EvmChainKeeper(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
 	var ret types.QueryLastEventNonceByAddrResponse
 	addr, err := sdk.AccAddressFromBech32(req.Address)
 	if err != nil {
@@ -257,6 +350,10 @@ func (k Keeper) DenomToERC20(
 	c context.Context,
 	req *types.QueryDenomToERC20Request) (*types.QueryDenomToERC20Response, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.EvmChainKeeper(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
 	cosmosOriginated, erc20, err := k.DenomToERC20Lookup(ctx, req.Denom)
 	var ret types.QueryDenomToERC20Response
 	ret.Erc20 = erc20.GetAddress()
@@ -270,6 +367,10 @@ func (k Keeper) ERC20ToDenom(
 	c context.Context,
 	req *types.QueryERC20ToDenomRequest) (*types.QueryERC20ToDenomResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.EvmChainKeeper(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
 	ethAddr, err := types.NewEthAddress(req.Erc20)
 	if err != nil {
 		return nil, sdkerrors.Wrapf(err, "invalid Erc20 in request: %s", req.Erc20)
@@ -287,6 +388,10 @@ func (k Keeper) GetAttestations(
 	c context.Context,
 	req *types.QueryAttestationsRequest) (*types.QueryAttestationsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.EvmChainKeeper(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
 	limit := req.Limit
 	if limit > QUERY_ATTESTATIONS_LIMIT {
 		limit = QUERY_ATTESTATIONS_LIMIT
@@ -300,6 +405,10 @@ func (k Keeper) GetDelegateKeyByValidator(
 	c context.Context,
 	req *types.QueryDelegateKeysByValidatorAddress) (*types.QueryDelegateKeysByValidatorAddressResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.EvmChainKeeper(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
 	keys := k.GetDelegateKeys(ctx)
 	reqValidator, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
 	if err != nil {
@@ -323,6 +432,10 @@ func (k Keeper) GetDelegateKeyByOrchestrator(
 	c context.Context,
 	req *types.QueryDelegateKeysByOrchestratorAddress) (*types.QueryDelegateKeysByOrchestratorAddressResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.EvmChainKeeper(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
 	keys := k.GetDelegateKeys(ctx)
 	reqOrchestrator, err := sdk.AccAddressFromBech32(req.OrchestratorAddress)
 	if err != nil {
@@ -346,6 +459,10 @@ func (k Keeper) GetDelegateKeyByEth(
 	c context.Context,
 	req *types.QueryDelegateKeysByEthAddress) (*types.QueryDelegateKeysByEthAddressResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.EvmChainKeeper(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
 	keys := k.GetDelegateKeys(ctx)
 	if err := types.ValidateEthAddress(req.EthAddress); err != nil {
 		return nil, sdkerrors.Wrap(err, "invalid eth address")
@@ -366,6 +483,10 @@ func (k Keeper) GetPendingSendToEth(
 	c context.Context,
 	req *types.QueryPendingSendToEth) (*types.QueryPendingSendToEthResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.EvmChainKeeper(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
 	batches := k.GetOutgoingTxBatches(ctx)
 	unbatched_tx := k.GetUnbatchedTransactions(ctx)
 	sender_address := req.GetSenderAddress()
@@ -388,3 +509,204 @@ func (k Keeper) GetPendingSendToEth(
 
 	return &res, nil
 }
+
+// BridgeHijackIncidents returns every recorded bridge hijack incident and whether the bridge is paused
+func (k Keeper) BridgeHijackIncidents(
+	c context.Context,
+	req *types.QueryBridgeHijackIncidentsRequest) (*types.QueryBridgeHijackIncidentsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.EvmChainKeeper(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
+	incidents := k.GetBridgeHijackIncidents(ctx)
+	return &types.QueryBridgeHijackIncidentsResponse{
+		Incidents: incidents,
+		Paused:    len(incidents) > 0,
+	}, nil
+}
+
+// ConflictingClaims returns the recorded conflicting claims, optionally filtered by event nonce
+func (k Keeper) ConflictingClaims(
+	c context.Context,
+	req *types.QueryConflictingClaimsRequest) (*types.QueryConflictingClaimsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.EvmChainKeeper(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
+	var conflicts []*types.ConflictingClaim
+	if req.EventNonce == 0 {
+		conflicts = k.GetConflictingClaims(ctx)
+	} else {
+		conflicts = k.GetConflictingClaimsByNonce(ctx, req.EventNonce)
+	}
+	return &types.QueryConflictingClaimsResponse{ConflictingClaims: conflicts}, nil
+}
+
+// BadSignatureEvidence returns the recorded bad signature evidence, optionally filtered by validator
+func (k Keeper) BadSignatureEvidence(
+	c context.Context,
+	req *types.QueryBadSignatureEvidenceRequest) (*types.QueryBadSignatureEvidenceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.EvmChainKeeper(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
+	if req.Validator == "" {
+		return &types.QueryBadSignatureEvidenceResponse{Evidence: k.GetAllBadSignatureEvidence(ctx)}, nil
+	}
+	valAddr, err := sdk.ValAddressFromBech32(req.Validator)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "validator address invalid")
+	}
+	return &types.QueryBadSignatureEvidenceResponse{Evidence: k.GetBadSignatureEvidenceByValidator(ctx, valAddr)}, nil
+}
+
+// TransferHistory returns the history of a transfer to Ethereum by its tx id, nil once it has been pruned
+func (k Keeper) TransferHistory(
+	c context.Context,
+	req *types.QueryTransferHistoryRequest) (*types.QueryTransferHistoryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.EvmChainKeeper(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryTransferHistoryResponse{History: k.GetTransferHistory(ctx, req.TxId)}, nil
+}
+
+// TransferHistoryBySender returns the history of every transfer to Ethereum of a sender that has not been pruned
+func (k Keeper) TransferHistoryBySender(
+	c context.Context,
+	req *types.QueryTransferHistoryBySenderRequest) (*types.QueryTransferHistoryBySenderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.EvmChainKeeper(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, req.Sender)
+	}
+	return &types.QueryTransferHistoryBySenderResponse{
+		Histories: k.GetTransferHistoriesBySender(ctx, sender),
+	}, nil
+}
+
+// DepositReceipt returns the receipt of the deposit from Ethereum observed at an event nonce, nil once it
+// has been pruned
+func (k Keeper) DepositReceipt(
+	c context.Context,
+	req *types.QueryDepositReceiptRequest) (*types.QueryDepositReceiptResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.EvmChainKeeper(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryDepositReceiptResponse{Receipt: k.GetDepositReceipt(ctx, req.EventNonce)}, nil
+}
+
+// DepositReceiptsByReceiver returns the receipt of every deposit from Ethereum to a Cosmos receiver that has
+// not been pruned, the receiver is matched as it was claimed so failed deposits to invalid addresses are found too
+func (k Keeper) DepositReceiptsByReceiver(
+	c context.Context,
+	req *types.QueryDepositReceiptsByReceiverRequest) (*types.QueryDepositReceiptsByReceiverResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.EvmChainKeeper(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
+	if req.Receiver == "" || len(req.Receiver) > address.MaxAddrLen {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "receiver")
+	}
+	return &types.QueryDepositReceiptsByReceiverResponse{
+		Receipts: k.GetDepositReceiptsByReceiver(ctx, req.Receiver),
+	}, nil
+}
+
+// DepositReceiptsByEthereumSender returns the receipt of every deposit from an Ethereum sender that has not
+// been pruned
+func (k Keeper) DepositReceiptsByEthereumSender(
+	c context.Context,
+	req *types.QueryDepositReceiptsByEthereumSenderRequest) (*types.QueryDepositReceiptsByEthereumSenderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.EvmChainKeeper(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
+	sender, err := types.NewEthAddress(req.EthereumSender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, req.EthereumSender)
+	}
+	return &types.QueryDepositReceiptsByEthereumSenderResponse{
+		Receipts: k.GetDepositReceiptsByEthereumSender(ctx, *sender),
+	}, nil
+}
+
+// FailedDeposits returns every deposit from Ethereum held in escrow because it could not be credited
+func (k Keeper) FailedDeposits(
+	c context.Context,
+	req *types.QueryFailedDepositsRequest) (*types.QueryFailedDepositsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.EvmChainKeeper(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryFailedDepositsResponse{Deposits: k.GetFailedDeposits(ctx)}, nil
+}
+
+// EthereumHeight returns the last observed Ethereum height and the height votes of the orchestrators
+func (k Keeper) EthereumHeight(
+	c context.Context,
+	req *types.QueryEthereumHeightRequest) (*types.QueryEthereumHeightResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.EvmChainKeeper(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryEthereumHeightResponse{
+		LastObserved: k.GetLastObservedEthereumBlockHeight(ctx),
+		Votes:        k.GetEthereumHeightVotes(ctx),
+	}, nil
+}
+
+// ProtocolFee returns the protocol fee a transfer of the given amount to Ethereum pays on top of its bridge fee
+func (k Keeper) ProtocolFee(
+	c context.Context,
+	req *types.QueryProtocolFeeRequest) (*types.QueryProtocolFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.EvmChainKeeper(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := sdk.ParseCoinNormalized(req.Amount)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	// only coins that can be sent to the chain pay a protocol fee
+	if _, _, err := k.DenomToERC20Lookup(ctx, amount.Denom); err != nil {
+		return nil, err
+	}
+	return &types.QueryProtocolFeeResponse{ProtocolFee: k.GetProtocolFee(ctx, amount)}, nil
+}
+
+// Blocklist returns every blocked Ethereum and Cosmos address
+func (k Keeper) Blocklist(
+	c context.Context,
+	req *types.QueryBlocklistRequest) (*types.QueryBlocklistResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryBlocklistResponse{Addresses: k.GetBlockedAddresses(ctx)}, nil
+}
+
+// QuarantinedDeposits returns every deposit from Ethereum held in quarantine because its sender or receiver
+// is blocked
+func (k Keeper) QuarantinedDeposits(
+	c context.Context,
+	req *types.QueryQuarantinedDepositsRequest) (*types.QueryQuarantinedDepositsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.EvmChainKeeper(ctx, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryQuarantinedDepositsResponse{Deposits: k.GetQuarantinedDeposits(ctx)}, nil
+}
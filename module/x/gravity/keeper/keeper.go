@@ -3,12 +3,15 @@ package keeper
 import (
 	"fmt"
 	"sort"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/gogo/protobuf/proto"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
@@ -21,17 +24,26 @@ type Keeper struct {
 	storeKey   sdk.StoreKey // Unexposed key to access store from sdk.Context
 	paramSpace paramtypes.Subspace
 
-	cdc            codec.BinaryCodec // The wire codec for binary encoding/decoding.
-	bankKeeper     types.BankKeeper
-	SlashingKeeper types.SlashingKeeper
+	cdc                codec.BinaryCodec // The wire codec for binary encoding/decoding.
+	bankKeeper         types.BankKeeper
+	SlashingKeeper     types.SlashingKeeper
+	evidenceKeeper     types.EvidenceKeeper
+	distributionKeeper types.DistributionKeeper
 
 	AttestationHandler interface {
 		Handle(sdk.Context, types.Attestation, types.EthereumClaim) error
 	}
+
+	// evmChain is the chain of Params.EvmChains the keeper is scoped to, nil for the default chain
+	evmChain *types.EvmChain
+
+	// reportedGauges remembers the labels the per token and per validator gauges were set for, it is shared
+	// by the keepers of every chain
+	reportedGauges gaugeLabels
 }
 
 // NewKeeper returns a new instance of the gravity keeper
-func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, stakingKeeper types.StakingKeeper, bankKeeper types.BankKeeper, slashingKeeper types.SlashingKeeper) Keeper {
+func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, stakingKeeper types.StakingKeeper, bankKeeper types.BankKeeper, slashingKeeper types.SlashingKeeper, evidenceKeeper types.EvidenceKeeper, distributionKeeper types.DistributionKeeper) Keeper {
 	// set KeyTable if it has not already been set
 	if !paramSpace.HasKeyTable() {
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
@@ -44,7 +56,11 @@ func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, paramSpace paramtyp
 		cdc:                cdc,
 		bankKeeper:         bankKeeper,
 		SlashingKeeper:     slashingKeeper,
+		evidenceKeeper:     evidenceKeeper,
+		distributionKeeper: distributionKeeper,
 		AttestationHandler: nil,
+		evmChain:           nil,
+		reportedGauges:     make(gaugeLabels),
 	}
 	k.AttestationHandler = AttestationHandler{
 		keeper:     k,
@@ -54,6 +70,131 @@ func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, paramSpace paramtyp
 	return k
 }
 
+/////////////////////////////
+//        EVM CHAINS       //
+/////////////////////////////
+
+// EvmChainKeeper returns a keeper scoped to the EVM chain with the given chain id, every keeper method
+// acting on the state of a bridged chain acts on the state of that chain. Zero and the bridge_chain_id
+// param refer to the default chain, any other chain id must be in Params.EvmChains.
+func (k Keeper) EvmChainKeeper(ctx sdk.Context, chainID uint64) (Keeper, error) {
+	if chainID == 0 || chainID == k.GetBridgeChainID(ctx) {
+		return k.withEvmChain(nil), nil
+	}
+	for _, chain := range k.GetParams(ctx).EvmChains {
+		if chain.ChainId == chainID {
+			chain := chain
+			return k.withEvmChain(&chain), nil
+		}
+	}
+	return k, sdkerrors.Wrapf(types.ErrInvalid, "unknown evm chain %d", chainID)
+}
+
+// EvmChainKeepers returns a keeper scoped to the default chain followed by one scoped to each chain in
+// Params.EvmChains, in the order of the param
+func (k Keeper) EvmChainKeepers(ctx sdk.Context) []Keeper {
+	keepers := []Keeper{k.withEvmChain(nil)}
+	for _, chain := range k.GetParams(ctx).EvmChains {
+		chain := chain
+		keepers = append(keepers, k.withEvmChain(&chain))
+	}
+	return keepers
+}
+
+// CheckRemovedEvmChains returns an error if a chain of previous is no longer in Params.EvmChains while it still
+// has transfers, batches or logic calls in flight, deposits held in escrow, vouchers in circulation or Cosmos
+// originated tokens deployed on it or locked for it, dropping such a chain would orphan its state and the funds
+// it escrows
+func (k Keeper) CheckRemovedEvmChains(ctx sdk.Context, previous []types.EvmChain) error {
+	current := make(map[uint64]bool)
+	for _, chain := range k.GetParams(ctx).EvmChains {
+		current[chain.ChainId] = true
+	}
+	for _, chain := range previous {
+		if current[chain.ChainId] {
+			continue
+		}
+		chain := chain
+		if err := k.withEvmChain(&chain).checkEvmChainDrained(ctx); err != nil {
+			return sdkerrors.Wrapf(err, "remove evm chain %d", chain.ChainId)
+		}
+	}
+	return nil
+}
+
+// checkEvmChainDrained returns an error if the chain the keeper is scoped to still holds state or funds
+func (k Keeper) checkEvmChainDrained(ctx sdk.Context) error {
+	held := []struct {
+		prefix []byte
+		what   string
+	}{
+		{types.OutgoingTXPoolKey, "unbatched transfers"},
+		{types.OutgoingTXBatchKey, "outgoing batches"},
+		{types.KeyOutgoingLogicCall, "outgoing logic calls"},
+		{types.FailedDepositKey, "failed deposits"},
+		{types.QuarantinedDepositKey, "quarantined deposits"},
+		{types.ERC20ToDenomKey, "cosmos originated tokens"},
+		{types.CosmosOriginatedLockedKey, "locked cosmos originated coins"},
+	}
+	for _, h := range held {
+		iter := sdk.KVStorePrefixIterator(k.store(ctx), h.prefix)
+		found := iter.Valid()
+		iter.Close()
+		if found {
+			return sdkerrors.Wrapf(types.ErrInvalid, "chain still has %s", h.what)
+		}
+	}
+	voucherPrefix := fmt.Sprintf("%s%d%s", types.GravityDenomPrefix, k.evmChain.ChainId, types.EvmChainGravityDenomSeparator)
+	var voucher string
+	k.bankKeeper.IterateTotalSupply(ctx, func(coin sdk.Coin) bool {
+		if strings.HasPrefix(coin.Denom, voucherPrefix) && coin.IsPositive() {
+			voucher = coin.Denom
+			return true
+		}
+		return false
+	})
+	if voucher != "" {
+		return sdkerrors.Wrapf(types.ErrInvalid, "chain still has %s in circulation", voucher)
+	}
+	return nil
+}
+
+// EvmChainID returns the chain id messages and queries use to refer to the chain the keeper is scoped to,
+// zero for the default chain
+func (k Keeper) EvmChainID() uint64 {
+	if k.evmChain == nil {
+		return 0
+	}
+	return k.evmChain.ChainId
+}
+
+// ClaimSlashingEnabled tells whether validators are slashed for the claims they miss or the conflicting
+// claims they vote for on the chain the keeper is scoped to, the other chains opt in with claim_slashing
+func (k Keeper) ClaimSlashingEnabled() bool {
+	return k.evmChain == nil || k.evmChain.ClaimSlashing
+}
+
+func (k Keeper) withEvmChain(chain *types.EvmChain) Keeper {
+	k.evmChain = chain
+	// the attestation handler applies observed claims through its own copy of the keeper
+	if handler, ok := k.AttestationHandler.(AttestationHandler); ok {
+		handler.keeper = k
+		k.AttestationHandler = handler
+	}
+	return k
+}
+
+// store returns the store of the chain the keeper is scoped to. The state of the default chain is kept at
+// the root of the module store and the state of the other chains under their chain id, with the same keys.
+// The static validator set, the orchestrator addresses and the last unbonding height are shared by all
+// chains and always kept at the root.
+func (k Keeper) store(ctx sdk.Context) sdk.KVStore {
+	if k.evmChain == nil {
+		return ctx.KVStore(k.storeKey)
+	}
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.GetEvmChainStoreKey(k.evmChain.ChainId))
+}
+
 /////////////////////////////
 //       PARAMETERS        //
 /////////////////////////////
@@ -72,7 +213,11 @@ func (k Keeper) SetParams(ctx sdk.Context, ps types.Params) {
 // GetBridgeContractAddress returns the bridge contract address on ETH
 func (k Keeper) GetBridgeContractAddress(ctx sdk.Context) *types.EthAddress {
 	var a string
-	k.paramSpace.Get(ctx, types.ParamsStoreKeyBridgeContractAddress, &a)
+	if k.evmChain != nil {
+		a = k.evmChain.BridgeEthereumAddress
+	} else {
+		k.paramSpace.Get(ctx, types.ParamsStoreKeyBridgeContractAddress, &a)
+	}
 	addr, err := types.NewEthAddress(a)
 	if err != nil {
 		panic(sdkerrors.Wrapf(err, "found invalid bridge contract address in store: %v", a))
@@ -82,6 +227,9 @@ func (k Keeper) GetBridgeContractAddress(ctx sdk.Context) *types.EthAddress {
 
 // GetBridgeChainID returns the chain id of the ETH chain we are running against
 func (k Keeper) GetBridgeChainID(ctx sdk.Context) uint64 {
+	if k.evmChain != nil {
+		return k.evmChain.ChainId
+	}
 	var a uint64
 	k.paramSpace.Get(ctx, types.ParamsStoreKeyBridgeContractChainID, &a)
 	return a
@@ -98,6 +246,9 @@ func (k Keeper) GetBridgeChainID(ctx sdk.Context) uint64 {
 // same as the chain id since the chain id may be changed many times with each
 // successive chain in charge of the same bridge
 func (k Keeper) GetGravityID(ctx sdk.Context) string {
+	if k.evmChain != nil {
+		return k.evmChain.GravityId
+	}
 	var a string
 	k.paramSpace.Get(ctx, types.ParamsStoreKeyGravityID, &a)
 	return a
@@ -124,6 +275,17 @@ func (k Keeper) GetMinimumTransferToEth(ctx sdk.Context) sdk.Int {
 	return a
 }
 
+// GetAverageEthereumBlockTime returns the average block time of the ETH chain we are running against
+// in milliseconds
+func (k Keeper) GetAverageEthereumBlockTime(ctx sdk.Context) uint64 {
+	if k.evmChain != nil {
+		return k.evmChain.AverageEthereumBlockTime
+	}
+	var a uint64
+	k.paramSpace.Get(ctx, types.ParamsStoreKeyAverageEthereumBlockTime, &a)
+	return a
+}
+
 // SetMinimumTransferToEth SETs the minimum one-way transfer amount CUDOS -> ETH
 func (k Keeper) SetMinimumTransferToEth(ctx sdk.Context, mt sdk.Int) {
 	k.paramSpace.Set(ctx, types.ParamsStoreKeyMinimumTransferToEth, mt)
@@ -146,6 +308,14 @@ func (k Keeper) logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
+// emitTypedEvent emits one of the typed events in events.proto. Our own events always marshal,
+// a failure is logged rather than aborting the state transition the event describes.
+func (k Keeper) emitTypedEvent(ctx sdk.Context, event proto.Message) {
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		k.logger(ctx).Error("unable to emit typed event", "event", proto.MessageName(event), "cause", err.Error())
+	}
+}
+
 func (k Keeper) UnpackAttestationClaim(att *types.Attestation) (types.EthereumClaim, error) {
 	var msg types.EthereumClaim
 	err := k.cdc.UnpackAny(att.Claim, &msg)
@@ -167,7 +337,7 @@ func (k Keeper) UnpackAttestationClaim(att *types.Attestation) (types.EthereumCl
 //
 // For the time being this will serve
 func (k Keeper) GetDelegateKeys(ctx sdk.Context) []*types.MsgSetOrchestratorAddress {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	prefix := []byte(types.EthAddressByValidatorKey)
 	iter := store.Iterator(prefixRange(prefix))
 	defer iter.Close()
@@ -246,13 +416,13 @@ func (k Keeper) GetDelegateKeys(ctx sdk.Context) []*types.MsgSetOrchestratorAddr
 
 // SetLastSlashedLogicCallBlock sets the latest slashed logic call block height
 func (k Keeper) SetLastSlashedLogicCallBlock(ctx sdk.Context, blockHeight uint64) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	store.Set(types.LastSlashedLogicCallBlock, types.UInt64Bytes(blockHeight))
 }
 
 // GetLastSlashedLogicCallBlock returns the latest slashed logic call block
 func (k Keeper) GetLastSlashedLogicCallBlock(ctx sdk.Context) uint64 {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	bytes := store.Get(types.LastSlashedLogicCallBlock)
 
 	if len(bytes) == 0 {
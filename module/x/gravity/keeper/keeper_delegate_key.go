@@ -103,14 +103,14 @@ func (k Keeper) GetOrchestratorValidator(ctx sdk.Context, orch sdk.AccAddress) (
 
 // SetEthAddress sets the ethereum address for a given validator
 func (k Keeper) SetEthAddressForValidator(ctx sdk.Context, validator sdk.ValAddress, ethAddr types.EthAddress) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	store.Set(types.GetEthAddressByValidatorKey(validator), []byte(ethAddr.GetAddress()))
 	store.Set(types.GetValidatorByEthAddressKey(ethAddr), []byte(validator))
 }
 
 // GetEthAddressByValidator returns the eth address for a given gravity validator
 func (k Keeper) GetEthAddressByValidator(ctx sdk.Context, validator sdk.ValAddress) (ethAddress *types.EthAddress, found bool) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	ethAddr := store.Get(types.GetEthAddressByValidatorKey(validator))
 	if ethAddr == nil {
 		return nil, false
@@ -125,7 +125,7 @@ func (k Keeper) GetEthAddressByValidator(ctx sdk.Context, validator sdk.ValAddre
 
 // GetValidatorByEthAddress returns the validator for a given eth address
 func (k Keeper) GetValidatorByEthAddress(ctx sdk.Context, ethAddr types.EthAddress) (validator stakingtypes.Validator, found bool) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	valAddr := store.Get(types.GetValidatorByEthAddressKey(ethAddr))
 	if valAddr == nil {
 		return stakingtypes.Validator{
@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// CheckValsetUpdatedClaim compares the members of an observed validator set update against the
+// validator set this chain requested at the same nonce. If they differ someone other than this
+// chain's validators controls the bridge contract, the incident is recorded, which pauses the bridge,
+// and false is returned. Nonce zero is the set the contract was deployed with and is never stored
+// on this chain, so it can not be checked.
+func (k Keeper) CheckValsetUpdatedClaim(ctx sdk.Context, claim *types.MsgValsetUpdatedClaim) bool {
+	if claim.ValsetNonce == 0 {
+		return true
+	}
+
+	var expected types.BridgeValidators
+	if stored := k.GetValset(ctx, claim.ValsetNonce); stored != nil {
+		expected = stored.Members
+		if expected.Equal(claim.Members) {
+			return true
+		}
+	}
+
+	incident := types.BridgeHijackIncident{
+		ValsetNonce:     claim.ValsetNonce,
+		EventNonce:      claim.EventNonce,
+		EthereumHeight:  claim.BlockHeight,
+		CosmosHeight:    uint64(ctx.BlockHeight()),
+		ExpectedMembers: expected,
+		ObservedMembers: claim.Members,
+	}
+	k.SetBridgeHijackIncident(ctx, incident)
+
+	k.logger(ctx).Error("bridge hijack detected, pausing the bridge",
+		"valset nonce", fmt.Sprint(claim.ValsetNonce),
+		"event nonce", fmt.Sprint(claim.EventNonce),
+	)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBridgeHijackDetected,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeySeverity, types.SeverityCritical),
+			sdk.NewAttribute(types.AttributeKeyContract, k.GetBridgeContractAddress(ctx).GetAddress()),
+			sdk.NewAttribute(types.AttributeKeyValsetNonce, fmt.Sprint(claim.ValsetNonce)),
+			sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(claim.EventNonce)),
+			sdk.NewAttribute(types.AttributeKeyExpectedMembers, fmt.Sprint(expected)),
+			sdk.NewAttribute(types.AttributeKeyObservedMembers, fmt.Sprint(types.BridgeValidators(claim.Members))),
+		),
+	)
+	k.emitTypedEvent(ctx, &types.EventBridgeHijackDetected{
+		Incident:       incident,
+		BridgeContract: k.GetBridgeContractAddress(ctx).GetAddress(),
+	})
+	return false
+}
+
+// SetBridgeHijackIncident records a hijack incident, as long as any incident is stored the bridge is paused
+func (k Keeper) SetBridgeHijackIncident(ctx sdk.Context, incident types.BridgeHijackIncident) {
+	store := k.store(ctx)
+	store.Set(types.GetBridgeHijackIncidentKey(incident.ValsetNonce), k.cdc.MustMarshal(&incident))
+}
+
+// GetBridgeHijackIncident returns the incident recorded for a valset nonce, nil if there is none
+func (k Keeper) GetBridgeHijackIncident(ctx sdk.Context, valsetNonce uint64) *types.BridgeHijackIncident {
+	store := k.store(ctx)
+	bz := store.Get(types.GetBridgeHijackIncidentKey(valsetNonce))
+	if bz == nil {
+		return nil
+	}
+	var incident types.BridgeHijackIncident
+	k.cdc.MustUnmarshal(bz, &incident)
+	return &incident
+}
+
+// IterateBridgeHijackIncidents iterates through all recorded incidents in ASC valset nonce order
+func (k Keeper) IterateBridgeHijackIncidents(ctx sdk.Context, cb func(key []byte, incident *types.BridgeHijackIncident) bool) {
+	prefixStore := prefix.NewStore(k.store(ctx), types.BridgeHijackIncidentKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var incident types.BridgeHijackIncident
+		k.cdc.MustUnmarshal(iter.Value(), &incident)
+		// cb returns true to stop early
+		if cb(iter.Key(), &incident) {
+			break
+		}
+	}
+}
+
+// GetBridgeHijackIncidents returns all recorded incidents
+func (k Keeper) GetBridgeHijackIncidents(ctx sdk.Context) (out []*types.BridgeHijackIncident) {
+	k.IterateBridgeHijackIncidents(ctx, func(_ []byte, incident *types.BridgeHijackIncident) bool {
+		out = append(out, incident)
+		return false
+	})
+	return
+}
+
+// IsBridgePaused returns true while there is at least one uncleared hijack incident
+func (k Keeper) IsBridgePaused(ctx sdk.Context) bool {
+	paused := false
+	k.IterateBridgeHijackIncidents(ctx, func(_ []byte, _ *types.BridgeHijackIncident) bool {
+		paused = true
+		return true
+	})
+	return paused
+}
+
+// ClearBridgeHijackIncidents removes every recorded incident, resuming the bridge. This should
+// only ever be reached through governance once the bridge contract is back under control.
+func (k Keeper) ClearBridgeHijackIncidents(ctx sdk.Context) {
+	store := k.store(ctx)
+	var keys [][]byte
+	k.IterateBridgeHijackIncidents(ctx, func(key []byte, _ *types.BridgeHijackIncident) bool {
+		keys = append(keys, types.GetBridgeHijackIncidentKey(types.UInt64FromBytes(key)))
+		return false
+	})
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBridgeHijackCleared,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyContract, k.GetBridgeContractAddress(ctx).GetAddress()),
+		),
+	)
+	k.emitTypedEvent(ctx, &types.EventBridgeHijackCleared{
+		BridgeContract: k.GetBridgeContractAddress(ctx).GetAddress(),
+	})
+}
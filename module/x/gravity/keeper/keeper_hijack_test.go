@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+func TestCheckValsetUpdatedClaim(t *testing.T) {
+	input := CreateTestEnv(t)
+	k := input.GravityKeeper
+	ctx := input.Context
+
+	members := []*types.BridgeValidator{
+		{Power: 2147483648, EthereumAddress: EthAddrs[0].String()},
+		{Power: 2147483647, EthereumAddress: EthAddrs[1].String()},
+	}
+	k.StoreValset(ctx, &types.Valset{Nonce: 1, Members: members, RewardAmount: sdk.ZeroInt(), RewardToken: types.ZeroAddressString})
+
+	claim := func(valsetNonce uint64, members []*types.BridgeValidator) *types.MsgValsetUpdatedClaim {
+		return &types.MsgValsetUpdatedClaim{EventNonce: 1, ValsetNonce: valsetNonce, Members: members}
+	}
+
+	// the deployment valset and a valset matching ours are both accepted
+	require.True(t, k.CheckValsetUpdatedClaim(ctx, claim(0, members[:1])))
+	require.True(t, k.CheckValsetUpdatedClaim(ctx, claim(1, members)))
+	require.False(t, k.IsBridgePaused(ctx))
+
+	// a reordered valset produces a different checkpoint
+	require.False(t, k.CheckValsetUpdatedClaim(ctx, claim(1, []*types.BridgeValidator{members[1], members[0]})))
+	require.True(t, k.IsBridgePaused(ctx))
+
+	// a valset nonce we never requested can only come from a hijacked contract
+	require.False(t, k.CheckValsetUpdatedClaim(ctx, claim(5, members)))
+	incident := k.GetBridgeHijackIncident(ctx, 5)
+	require.NotNil(t, incident)
+	require.Empty(t, incident.ExpectedMembers)
+	require.Len(t, k.GetBridgeHijackIncidents(ctx), 2)
+
+	// batches can not be built while paused
+	_, err := k.BuildOutgoingTXBatch(ctx, *types.ZeroAddress(), OutgoingTxBatchSize)
+	require.ErrorIs(t, err, types.ErrBridgePaused)
+
+	k.ClearBridgeHijackIncidents(ctx)
+	require.False(t, k.IsBridgePaused(ctx))
+	require.Empty(t, k.GetBridgeHijackIncidents(ctx))
+}
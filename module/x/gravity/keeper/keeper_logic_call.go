@@ -16,7 +16,7 @@ import (
 
 // GetOutgoingLogicCall gets an outgoing logic call
 func (k Keeper) GetOutgoingLogicCall(ctx sdk.Context, invalidationID []byte, invalidationNonce uint64) *types.OutgoingLogicCall {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	call := types.OutgoingLogicCall{
 		Transfers:            []*types.ERC20Token{},
 		Fees:                 []*types.ERC20Token{},
@@ -31,6 +31,12 @@ func (k Keeper) GetOutgoingLogicCall(ctx sdk.Context, invalidationID []byte, inv
 	return &call
 }
 
+// HasOutgoingLogicCall returns true when an outgoing logic call is stored at an invalidation id and nonce,
+// unlike GetOutgoingLogicCall which returns an empty call for any other
+func (k Keeper) HasOutgoingLogicCall(ctx sdk.Context, invalidationID []byte, invalidationNonce uint64) bool {
+	return k.store(ctx).Has(types.GetOutgoingLogicCallKey(invalidationID, invalidationNonce))
+}
+
 // SetOutogingLogicCall sets an outgoing logic call
 func (k Keeper) SetOutgoingLogicCall(ctx sdk.Context, call *types.OutgoingLogicCall) {
 	ctx.Logger().Error("SetOutgoingLogicCall is not supported", "module", types.ModuleName)
@@ -46,12 +52,12 @@ func (k Keeper) SetOutgoingLogicCall(ctx sdk.Context, call *types.OutgoingLogicC
 
 // DeleteOutgoingLogicCall deletes outgoing logic calls
 func (k Keeper) DeleteOutgoingLogicCall(ctx sdk.Context, invalidationID []byte, invalidationNonce uint64) {
-	ctx.KVStore(k.storeKey).Delete(types.GetOutgoingLogicCallKey(invalidationID, invalidationNonce))
+	k.store(ctx).Delete(types.GetOutgoingLogicCallKey(invalidationID, invalidationNonce))
 }
 
 // IterateOutgoingLogicCalls iterates over outgoing logic calls
 func (k Keeper) IterateOutgoingLogicCalls(ctx sdk.Context, cb func([]byte, *types.OutgoingLogicCall) bool) {
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyOutgoingLogicCall)
+	prefixStore := prefix.NewStore(k.store(ctx), types.KeyOutgoingLogicCall)
 	iter := prefixStore.Iterator(nil, nil)
 	defer iter.Close()
 	for ; iter.Valid(); iter.Next() {
@@ -90,6 +96,10 @@ func (k Keeper) CancelOutgoingLogicCall(ctx sdk.Context, invalidationId []byte,
 		sdk.NewAttribute(types.AttributeKeyInvalidationNonce, fmt.Sprint(call.InvalidationNonce)),
 	)
 	ctx.EventManager().EmitEvent(batchEvent)
+	k.emitTypedEvent(ctx, &types.EventLogicCallCanceled{
+		InvalidationId:    hex.EncodeToString(call.InvalidationId),
+		InvalidationNonce: call.InvalidationNonce,
+	})
 	return nil
 }
 
@@ -109,13 +119,13 @@ func (k Keeper) SetLogicCallConfirm(ctx sdk.Context, msg *types.MsgConfirmLogicC
 		panic(err)
 	}
 
-	ctx.KVStore(k.storeKey).
+	k.store(ctx).
 		Set(types.GetLogicConfirmKey(bytes, msg.InvalidationNonce, acc), k.cdc.MustMarshal(msg))
 }
 
 // GetLogicCallConfirm gets a logic confirm from the store
 func (k Keeper) GetLogicCallConfirm(ctx sdk.Context, invalidationId []byte, invalidationNonce uint64, val sdk.AccAddress) *types.MsgConfirmLogicCall {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	data := store.Get(types.GetLogicConfirmKey(invalidationId, invalidationNonce, val))
 	if data == nil {
 		return nil
@@ -137,7 +147,7 @@ func (k Keeper) DeleteLogicCallConfirm(
 	invalidationID []byte,
 	invalidationNonce uint64,
 	val sdk.AccAddress) {
-	ctx.KVStore(k.storeKey).Delete(types.GetLogicConfirmKey(invalidationID, invalidationNonce, val))
+	k.store(ctx).Delete(types.GetLogicConfirmKey(invalidationID, invalidationNonce, val))
 }
 
 // IterateLogicConfirmByInvalidationIDAndNonce iterates over all logic confirms stored by nonce
@@ -146,7 +156,7 @@ func (k Keeper) IterateLogicConfirmByInvalidationIDAndNonce(
 	invalidationID []byte,
 	invalidationNonce uint64,
 	cb func([]byte, *types.MsgConfirmLogicCall) bool) {
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyOutgoingLogicConfirm)
+	prefixStore := prefix.NewStore(k.store(ctx), types.KeyOutgoingLogicConfirm)
 	iter := prefixStore.Iterator(prefixRange(append(invalidationID, types.UInt64Bytes(invalidationNonce)...)))
 	defer iter.Close()
 
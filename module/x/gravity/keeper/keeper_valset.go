@@ -42,13 +42,18 @@ func (k Keeper) SetValsetRequest(ctx sdk.Context) *types.Valset {
 			sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(valset.Nonce)),
 		),
 	)
+	k.emitTypedEvent(ctx, &types.EventValsetRequest{
+		Valset:         *valset,
+		BridgeContract: bridgeAddr.GetAddress(),
+		BridgeChainId:  k.GetBridgeChainID(ctx),
+	})
 
 	return valset
 }
 
 // StoreValset is for storing a valiator set at a given height
 func (k Keeper) StoreValset(ctx sdk.Context, valset *types.Valset) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	valset.Height = uint64(ctx.BlockHeight())
 	store.Set(types.GetValsetKey(valset.Nonce), k.cdc.MustMarshal(valset))
 	k.SetLatestValsetNonce(ctx, valset.Nonce)
@@ -56,25 +61,25 @@ func (k Keeper) StoreValset(ctx sdk.Context, valset *types.Valset) {
 
 // StoreValsetUnsafe is for storing a valiator set at a given height
 func (k Keeper) StoreValsetUnsafe(ctx sdk.Context, valset *types.Valset) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	store.Set(types.GetValsetKey(valset.Nonce), k.cdc.MustMarshal(valset))
 	k.SetLatestValsetNonce(ctx, valset.Nonce)
 }
 
 // HasValsetRequest returns true if a valset defined by a nonce exists
 func (k Keeper) HasValsetRequest(ctx sdk.Context, nonce uint64) bool {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	return store.Has(types.GetValsetKey(nonce))
 }
 
 // DeleteValset deletes the valset at a given nonce from state
 func (k Keeper) DeleteValset(ctx sdk.Context, nonce uint64) {
-	ctx.KVStore(k.storeKey).Delete(types.GetValsetKey(nonce))
+	k.store(ctx).Delete(types.GetValsetKey(nonce))
 }
 
 // GetLatestValsetNonce returns the latest valset nonce
 func (k Keeper) GetLatestValsetNonce(ctx sdk.Context) uint64 {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	bytes := store.Get(types.LatestValsetNonce)
 
 	if len(bytes) == 0 {
@@ -85,13 +90,13 @@ func (k Keeper) GetLatestValsetNonce(ctx sdk.Context) uint64 {
 
 //  SetLatestValsetNonce sets the latest valset nonce
 func (k Keeper) SetLatestValsetNonce(ctx sdk.Context, nonce uint64) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	store.Set(types.LatestValsetNonce, types.UInt64Bytes(nonce))
 }
 
 // GetValset returns a valset by nonce
 func (k Keeper) GetValset(ctx sdk.Context, nonce uint64) *types.Valset {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	bz := store.Get(types.GetValsetKey(nonce))
 	if bz == nil {
 		return nil
@@ -103,7 +108,7 @@ func (k Keeper) GetValset(ctx sdk.Context, nonce uint64) *types.Valset {
 
 // IterateValsets retruns all valsetRequests
 func (k Keeper) IterateValsets(ctx sdk.Context, cb func(key []byte, val *types.Valset) bool) {
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValsetRequestKey)
+	prefixStore := prefix.NewStore(k.store(ctx), types.ValsetRequestKey)
 	iter := prefixStore.ReverseIterator(nil, nil)
 	defer iter.Close()
 	for ; iter.Valid(); iter.Next() {
@@ -138,13 +143,13 @@ func (k Keeper) GetLatestValset(ctx sdk.Context) (out *types.Valset) {
 
 // setLastSlashedValsetNonce sets the latest slashed valset nonce
 func (k Keeper) SetLastSlashedValsetNonce(ctx sdk.Context, nonce uint64) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	store.Set(types.LastSlashedValsetNonce, types.UInt64Bytes(nonce))
 }
 
 // GetLastSlashedValsetNonce returns the latest slashed valset nonce
 func (k Keeper) GetLastSlashedValsetNonce(ctx sdk.Context) uint64 {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	bytes := store.Get(types.LastSlashedValsetNonce)
 
 	if len(bytes) == 0 {
@@ -153,7 +158,7 @@ func (k Keeper) GetLastSlashedValsetNonce(ctx sdk.Context) uint64 {
 	return types.UInt64FromBytes(bytes)
 }
 
-// SetLastUnBondingBlockHeight sets the last unbonding block height
+// SetLastUnBondingBlockHeight sets the last unbonding block height, it is shared by all chains
 func (k Keeper) SetLastUnBondingBlockHeight(ctx sdk.Context, unbondingBlockHeight uint64) {
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.LastUnBondingBlockHeight, types.UInt64Bytes(unbondingBlockHeight))
@@ -187,7 +192,7 @@ func (k Keeper) GetUnSlashedValsets(ctx sdk.Context, signedValsetsWindow uint64)
 
 // IterateValsetBySlashedValsetNonce iterates through all valset by last slashed valset nonce in ASC order
 func (k Keeper) IterateValsetBySlashedValsetNonce(ctx sdk.Context, lastSlashedValsetNonce uint64, cb func([]byte, *types.Valset) bool) {
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValsetRequestKey)
+	prefixStore := prefix.NewStore(k.store(ctx), types.ValsetRequestKey)
 	// Consider all valsets, including the most recent one
 	cutoffNonce := k.GetLatestValsetNonce(ctx) + 1
 	iter := prefixStore.Iterator(types.UInt64Bytes(lastSlashedValsetNonce), types.UInt64Bytes(cutoffNonce))
@@ -277,6 +282,10 @@ func (k Keeper) GetCurrentValset(ctx sdk.Context) *types.Valset {
 		rewardToken = types.ZeroAddress()
 		rewardAmount = sdk.NewIntFromUint64(0)
 
+	} else if _, _, err := k.DenomToERC20Lookup(ctx, reward.Denom); err != nil && k.evmChain != nil {
+		// the reward is only paid on the chains of Params.EvmChains the reward denom was bridged to
+		rewardToken = types.ZeroAddress()
+		rewardAmount = sdk.NewIntFromUint64(0)
 	} else {
 		rewardToken, rewardAmount = k.RewardToERC20Lookup(ctx, reward)
 	}
@@ -298,7 +307,7 @@ func (k Keeper) GetCurrentValset(ctx sdk.Context) *types.Valset {
 
 // GetValsetConfirm returns a valset confirmation by a nonce and validator address
 func (k Keeper) GetValsetConfirm(ctx sdk.Context, nonce uint64, validator sdk.AccAddress) *types.MsgValsetConfirm {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	entity := store.Get(types.GetValsetConfirmKey(nonce, validator))
 	if entity == nil {
 		return nil
@@ -315,7 +324,7 @@ func (k Keeper) GetValsetConfirm(ctx sdk.Context, nonce uint64, validator sdk.Ac
 
 // SetValsetConfirm sets a valset confirmation
 func (k Keeper) SetValsetConfirm(ctx sdk.Context, valsetConf types.MsgValsetConfirm) []byte {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	addr, err := sdk.AccAddressFromBech32(valsetConf.Orchestrator)
 	if err != nil {
 		panic(err)
@@ -327,7 +336,7 @@ func (k Keeper) SetValsetConfirm(ctx sdk.Context, valsetConf types.MsgValsetConf
 
 // GetValsetConfirms returns all validator set confirmations by nonce
 func (k Keeper) GetValsetConfirms(ctx sdk.Context, nonce uint64) (confirms []*types.MsgValsetConfirm) {
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValsetConfirmKey)
+	prefixStore := prefix.NewStore(k.store(ctx), types.ValsetConfirmKey)
 	start, end := prefixRange(types.UInt64Bytes(nonce))
 	iterator := prefixStore.Iterator(start, end)
 
@@ -349,7 +358,7 @@ func (k Keeper) GetValsetConfirms(ctx sdk.Context, nonce uint64) (confirms []*ty
 
 // IterateValsetConfirmByNonce iterates through all valset confirms by validator set nonce in ASC order
 func (k Keeper) IterateValsetConfirmByNonce(ctx sdk.Context, nonce uint64, cb func([]byte, types.MsgValsetConfirm) bool) {
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValsetConfirmKey)
+	prefixStore := prefix.NewStore(k.store(ctx), types.ValsetConfirmKey)
 	iter := prefixStore.Iterator(prefixRange(types.UInt64Bytes(nonce)))
 	defer iter.Close()
 
@@ -44,13 +44,29 @@ func (k msgServer) SetOrchestratorAddress(c context.Context, msg *types.MsgSetOr
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
+	k, err = k.evmChain(ctx, msg.ChainId)
+	if err != nil {
+		return nil, err
+	}
 	val, _ := sdk.ValAddressFromBech32(msg.Validator)
 	orch, _ := sdk.AccAddressFromBech32(msg.Orchestrator)
 	addr, _ := types.NewEthAddress(msg.EthAddress)
 
-	_, foundExistingOrchestratorKey := k.GetOrchestratorValidator(ctx, orch)
+	orchValidator, foundExistingOrchestratorKey := k.GetOrchestratorValidator(ctx, orch)
 	_, foundExistingEthAddress := k.GetEthAddressByValidator(ctx, val)
 
+	// the orchestrator is shared by all chains, it is set along with the Ethereum address of the default
+	// chain and only the Ethereum address is new on the other chains
+	if k.EvmChainID() != 0 {
+		if !foundExistingOrchestratorKey {
+			return nil, sdkerrors.Wrapf(types.ErrInvalid, "orchestrator %s is not set on the default chain", orch)
+		}
+		if !orchValidator.GetOperator().Equals(val) {
+			return nil, sdkerrors.Wrap(types.ErrResetDelegateKeys, val.String())
+		}
+		foundExistingOrchestratorKey = false
+	}
+
 	// ensure that the validator exists
 	if k.Keeper.StakingKeeper.Validator(ctx, val) == nil {
 		return nil, sdkerrors.Wrap(stakingtypes.ErrNoValidatorFound, val.String())
@@ -72,6 +88,11 @@ func (k msgServer) SetOrchestratorAddress(c context.Context, msg *types.MsgSetOr
 			sdk.NewAttribute(types.AttributeKeySetOperatorAddr, orch.String()),
 		),
 	)
+	k.emitTypedEvent(ctx, &types.EventSetOrchestratorAddress{
+		Validator:       val.String(),
+		Orchestrator:    orch.String(),
+		EthereumAddress: addr.GetAddress(),
+	})
 
 	return &types.MsgSetOrchestratorAddressResponse{}, nil
 
@@ -80,6 +101,10 @@ func (k msgServer) SetOrchestratorAddress(c context.Context, msg *types.MsgSetOr
 // ValsetConfirm handles MsgValsetConfirm
 func (k msgServer) ValsetConfirm(c context.Context, msg *types.MsgValsetConfirm) (*types.MsgValsetConfirmResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.evmChain(ctx, msg.ChainId)
+	if err != nil {
+		return nil, err
+	}
 	valset := k.GetValset(ctx, msg.Nonce)
 	if valset == nil {
 		return nil, sdkerrors.Wrap(types.ErrInvalid, "couldn't find valset")
@@ -88,7 +113,7 @@ func (k msgServer) ValsetConfirm(c context.Context, msg *types.MsgValsetConfirm)
 	gravityID := k.GetGravityID(ctx)
 	checkpoint := valset.GetCheckpoint(gravityID)
 	orchaddr, _ := sdk.AccAddressFromBech32(msg.Orchestrator)
-	err := k.confirmHandlerCommon(ctx, msg.Orchestrator, msg.Signature, checkpoint)
+	ethAddress, err := k.confirmHandlerCommon(ctx, msg.Orchestrator, msg.Signature, checkpoint)
 	if err != nil {
 		return nil, err
 	}
@@ -103,9 +128,16 @@ func (k msgServer) ValsetConfirm(c context.Context, msg *types.MsgValsetConfirm)
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
-			sdk.NewAttribute(types.AttributeKeyValsetConfirmKey, string(key)),
+			sdk.NewAttribute(types.AttributeKeyValsetConfirmKey, hex.EncodeToString(key)),
 		),
 	)
+	k.emitTypedEvent(ctx, &types.EventValsetConfirm{
+		ValsetNonce:     msg.Nonce,
+		Orchestrator:    msg.Orchestrator,
+		EthereumAddress: ethAddress.GetAddress(),
+		Signature:       msg.Signature,
+		ConfirmKey:      hex.EncodeToString(key),
+	})
 
 	return &types.MsgValsetConfirmResponse{}, nil
 }
@@ -118,6 +150,21 @@ func (k msgServer) SendToEth(c context.Context, msg *types.MsgSendToEth) (*types
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
+	k, err = k.evmChain(ctx, msg.ChainId)
+	if err != nil {
+		return nil, err
+	}
+
+	if k.IsBridgePaused(ctx) {
+		return nil, sdkerrors.Wrap(types.ErrBridgePaused, "can not send to eth")
+	}
+
+	if k.IsBlockedAddress(ctx, msg.Sender) {
+		return nil, sdkerrors.Wrapf(types.ErrBlocked, "sender %s", msg.Sender)
+	}
+	if k.IsBlockedAddress(ctx, msg.EthDest) {
+		return nil, sdkerrors.Wrapf(types.ErrBlocked, "eth dest %s", msg.EthDest)
+	}
 
 	mte := k.GetMinimumTransferToEth(ctx)
 	if msg.Amount.Amount.LT(mte) {
@@ -184,6 +231,10 @@ func (k msgServer) SetMinFeeTransferToEth(c context.Context, msg *types.MsgSetMi
 // RequestBatch handles MsgRequestBatch
 func (k msgServer) RequestBatch(c context.Context, msg *types.MsgRequestBatch) (*types.MsgRequestBatchResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.evmChain(ctx, msg.ChainId)
+	if err != nil {
+		return nil, err
+	}
 
 	// Check if the denom is a gravity coin, if not, check if there is a deployed ERC20 representing it.
 	// If not, error out
@@ -216,6 +267,10 @@ func (k msgServer) ConfirmBatch(c context.Context, msg *types.MsgConfirmBatch) (
 	}
 	contract, _ := types.NewEthAddress(msg.TokenContract)
 	ctx := sdk.UnwrapSDKContext(c)
+	k, err = k.evmChain(ctx, msg.ChainId)
+	if err != nil {
+		return nil, err
+	}
 
 	// fetch the outgoing batch given the nonce
 	batch := k.GetOutgoingTXBatch(ctx, *contract, msg.Nonce)
@@ -226,7 +281,7 @@ func (k msgServer) ConfirmBatch(c context.Context, msg *types.MsgConfirmBatch) (
 	gravityID := k.GetGravityID(ctx)
 	checkpoint := batch.GetCheckpoint(gravityID)
 	orchaddr, _ := sdk.AccAddressFromBech32(msg.Orchestrator)
-	err = k.confirmHandlerCommon(ctx, msg.Orchestrator, msg.Signature, checkpoint)
+	ethAddress, err := k.confirmHandlerCommon(ctx, msg.Orchestrator, msg.Signature, checkpoint)
 	if err != nil {
 		return nil, err
 	}
@@ -241,9 +296,17 @@ func (k msgServer) ConfirmBatch(c context.Context, msg *types.MsgConfirmBatch) (
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
-			sdk.NewAttribute(types.AttributeKeyBatchConfirmKey, string(key)),
+			sdk.NewAttribute(types.AttributeKeyBatchConfirmKey, hex.EncodeToString(key)),
 		),
 	)
+	k.emitTypedEvent(ctx, &types.EventBatchConfirm{
+		BatchNonce:      msg.Nonce,
+		TokenContract:   contract.GetAddress(),
+		Orchestrator:    msg.Orchestrator,
+		EthereumAddress: ethAddress.GetAddress(),
+		Signature:       msg.Signature,
+		ConfirmKey:      hex.EncodeToString(key),
+	})
 
 	return nil, nil
 }
@@ -251,6 +314,10 @@ func (k msgServer) ConfirmBatch(c context.Context, msg *types.MsgConfirmBatch) (
 // ConfirmLogicCall handles MsgConfirmLogicCall
 func (k msgServer) ConfirmLogicCall(c context.Context, msg *types.MsgConfirmLogicCall) (*types.MsgConfirmLogicCallResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.evmChain(ctx, msg.ChainId)
+	if err != nil {
+		return nil, err
+	}
 	invalidationIdBytes, err := hex.DecodeString(msg.InvalidationId)
 	if err != nil {
 		return nil, sdkerrors.Wrap(types.ErrInvalid, "invalidation id encoding")
@@ -265,7 +332,7 @@ func (k msgServer) ConfirmLogicCall(c context.Context, msg *types.MsgConfirmLogi
 	gravityID := k.GetGravityID(ctx)
 	checkpoint := logic.GetCheckpoint(gravityID)
 	orchaddr, _ := sdk.AccAddressFromBech32(msg.Orchestrator)
-	err = k.confirmHandlerCommon(ctx, msg.Orchestrator, msg.Signature, checkpoint)
+	ethAddress, err := k.confirmHandlerCommon(ctx, msg.Orchestrator, msg.Signature, checkpoint)
 	if err != nil {
 		return nil, err
 	}
@@ -283,13 +350,20 @@ func (k msgServer) ConfirmLogicCall(c context.Context, msg *types.MsgConfirmLogi
 			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
 		),
 	)
+	k.emitTypedEvent(ctx, &types.EventLogicCallConfirm{
+		InvalidationId:    msg.InvalidationId,
+		InvalidationNonce: msg.InvalidationNonce,
+		Orchestrator:      msg.Orchestrator,
+		EthereumAddress:   ethAddress.GetAddress(),
+		Signature:         msg.Signature,
+	})
 
 	return nil, nil
 }
 
 // checkOrchestratorValidatorInSet checks that the orchestrator refers to a validator that is
 // currently in the set
-func (k msgServer) checkOrchestratorValidatorInSet(ctx sdk.Context, orchestrator string) error {
+func (k Keeper) checkOrchestratorValidatorInSet(ctx sdk.Context, orchestrator string) error {
 	orchaddr, _ := sdk.AccAddressFromBech32(orchestrator)
 	validator, found := k.GetOrchestratorValidator(ctx, orchaddr)
 	if !found {
@@ -318,43 +392,55 @@ func (k msgServer) claimHandlerCommon(ctx sdk.Context, msgAny *codectypes.Any, m
 		return sdkerrors.Wrap(err, "unable to compute claim hash")
 	}
 
+	attestationID := hex.EncodeToString(types.GetAttestationKey(msg.GetEventNonce(), hash))
+
 	// Emit the handle message event
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
-			sdk.NewAttribute(sdk.AttributeKeyModule, string(msg.GetType())),
-			// TODO: maybe return something better here? is this the right string representation?
-			sdk.NewAttribute(types.AttributeKeyAttestationID, string(types.GetAttestationKey(msg.GetEventNonce(), hash))),
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.GetType().String()),
+			sdk.NewAttribute(types.AttributeKeyAttestationID, attestationID),
 		),
 	)
+	validator, _ := k.GetOrchestratorValidator(ctx, msg.GetClaimer())
+	k.emitTypedEvent(ctx, &types.EventClaim{
+		ClaimType:     msg.GetType(),
+		EventNonce:    msg.GetEventNonce(),
+		BlockHeight:   msg.GetBlockHeight(),
+		ClaimHash:     hex.EncodeToString(hash),
+		AttestationId: attestationID,
+		Orchestrator:  msg.GetClaimer().String(),
+		Validator:     validator.OperatorAddress,
+	})
 
 	return nil
 }
 
 // confirmHandlerCommon is an internal function that provides common code for processing claim messages
-func (k msgServer) confirmHandlerCommon(ctx sdk.Context, orchestrator string, signature string, checkpoint []byte) error {
+// and returns the Ethereum address the signature was checked against
+func (k Keeper) confirmHandlerCommon(ctx sdk.Context, orchestrator string, signature string, checkpoint []byte) (*types.EthAddress, error) {
 	sigBytes, err := hex.DecodeString(signature)
 	if err != nil {
-		return sdkerrors.Wrap(types.ErrInvalid, "signature decoding")
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "signature decoding")
 	}
 
 	orchaddr, _ := sdk.AccAddressFromBech32(orchestrator)
 	validator, found := k.GetOrchestratorValidator(ctx, orchaddr)
 	if !found {
-		return sdkerrors.Wrap(types.ErrUnknown, "validator")
+		return nil, sdkerrors.Wrap(types.ErrUnknown, "validator")
 	}
 
 	ethAddress, found := k.GetEthAddressByValidator(ctx, validator.GetOperator())
 	if !found {
-		return sdkerrors.Wrap(types.ErrEmpty, "eth address")
+		return nil, sdkerrors.Wrap(types.ErrEmpty, "eth address")
 	}
 
 	err = types.ValidateEthereumSignature(checkpoint, sigBytes, *ethAddress)
 	if err != nil {
-		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("signature verification failed expected sig by %s with checkpoint %s found %s", ethAddress, hex.EncodeToString(checkpoint), signature))
+		return nil, sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("signature verification failed expected sig by %s with checkpoint %s found %s", ethAddress, hex.EncodeToString(checkpoint), signature))
 	}
 
-	return nil
+	return ethAddress, nil
 }
 
 // DepositClaim handles MsgSendToCosmosClaim
@@ -363,8 +449,12 @@ func (k msgServer) confirmHandlerCommon(ctx sdk.Context, orchestrator string, si
 // should not be a security risk as 'old' events can never execute but it does store spam in the chain.
 func (k msgServer) SendToCosmosClaim(c context.Context, msg *types.MsgSendToCosmosClaim) (*types.MsgSendToCosmosClaimResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.evmChain(ctx, msg.ChainId)
+	if err != nil {
+		return nil, err
+	}
 
-	err := k.checkOrchestratorValidatorInSet(ctx, msg.Orchestrator)
+	err = k.checkOrchestratorValidatorInSet(ctx, msg.Orchestrator)
 	if err != nil {
 		return nil, err
 	}
@@ -386,8 +476,12 @@ func (k msgServer) SendToCosmosClaim(c context.Context, msg *types.MsgSendToCosm
 // should not be a security risk as 'old' events can never execute but it does store spam in the chain.
 func (k msgServer) BatchSendToEthClaim(c context.Context, msg *types.MsgBatchSendToEthClaim) (*types.MsgBatchSendToEthClaimResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.evmChain(ctx, msg.ChainId)
+	if err != nil {
+		return nil, err
+	}
 
-	err := k.checkOrchestratorValidatorInSet(ctx, msg.Orchestrator)
+	err = k.checkOrchestratorValidatorInSet(ctx, msg.Orchestrator)
 	if err != nil {
 		return nil, err
 	}
@@ -406,8 +500,12 @@ func (k msgServer) BatchSendToEthClaim(c context.Context, msg *types.MsgBatchSen
 // ERC20Deployed handles MsgERC20Deployed
 func (k msgServer) ERC20DeployedClaim(c context.Context, msg *types.MsgERC20DeployedClaim) (*types.MsgERC20DeployedClaimResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.evmChain(ctx, msg.ChainId)
+	if err != nil {
+		return nil, err
+	}
 
-	err := k.checkOrchestratorValidatorInSet(ctx, msg.Orchestrator)
+	err = k.checkOrchestratorValidatorInSet(ctx, msg.Orchestrator)
 	if err != nil {
 		return nil, err
 	}
@@ -426,8 +524,12 @@ func (k msgServer) ERC20DeployedClaim(c context.Context, msg *types.MsgERC20Depl
 // LogicCallExecutedClaim handles claims for executing a logic call on Ethereum
 func (k msgServer) LogicCallExecutedClaim(c context.Context, msg *types.MsgLogicCallExecutedClaim) (*types.MsgLogicCallExecutedClaimResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.evmChain(ctx, msg.ChainId)
+	if err != nil {
+		return nil, err
+	}
 
-	err := k.checkOrchestratorValidatorInSet(ctx, msg.Orchestrator)
+	err = k.checkOrchestratorValidatorInSet(ctx, msg.Orchestrator)
 	if err != nil {
 		return nil, err
 	}
@@ -446,8 +548,12 @@ func (k msgServer) LogicCallExecutedClaim(c context.Context, msg *types.MsgLogic
 // ValsetUpdatedClaim handles claims for executing a validator set update on Ethereum
 func (k msgServer) ValsetUpdateClaim(c context.Context, msg *types.MsgValsetUpdatedClaim) (*types.MsgValsetUpdatedClaimResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.evmChain(ctx, msg.ChainId)
+	if err != nil {
+		return nil, err
+	}
 
-	err := k.checkOrchestratorValidatorInSet(ctx, msg.Orchestrator)
+	err = k.checkOrchestratorValidatorInSet(ctx, msg.Orchestrator)
 	if err != nil {
 		return nil, err
 	}
@@ -465,6 +571,10 @@ func (k msgServer) ValsetUpdateClaim(c context.Context, msg *types.MsgValsetUpda
 
 func (k msgServer) CancelSendToEth(c context.Context, msg *types.MsgCancelSendToEth) (*types.MsgCancelSendToEthResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.evmChain(ctx, msg.ChainId)
+	if err != nil {
+		return nil, err
+	}
 	sender, err := sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
 		return nil, err
@@ -487,8 +597,15 @@ func (k msgServer) CancelSendToEth(c context.Context, msg *types.MsgCancelSendTo
 
 func (k msgServer) SubmitBadSignatureEvidence(c context.Context, msg *types.MsgSubmitBadSignatureEvidence) (*types.MsgSubmitBadSignatureEvidenceResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.evmChain(ctx, msg.ChainId)
+	if err != nil {
+		return nil, err
+	}
 
-	err := k.CheckBadSignatureEvidence(ctx, msg)
+	err = k.CheckBadSignatureEvidence(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -499,5 +616,126 @@ func (k msgServer) SubmitBadSignatureEvidence(c context.Context, msg *types.MsgS
 		),
 	)
 
-	return &types.MsgSubmitBadSignatureEvidenceResponse{}, err
+	return &types.MsgSubmitBadSignatureEvidenceResponse{}, nil
+}
+
+// EthereumHeightClaim records the latest Ethereum height seen by the orchestrator of a static set validator,
+// the votes are tallied in the EndBlocker
+func (k msgServer) EthereumHeightClaim(c context.Context, msg *types.MsgEthereumHeightClaim) (*types.MsgEthereumHeightClaimResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.evmChain(ctx, msg.ChainId)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.checkOrchestratorValidatorInSet(ctx, msg.Orchestrator); err != nil {
+		return nil, err
+	}
+	orchaddr, _ := sdk.AccAddressFromBech32(msg.Orchestrator)
+	validator, _ := k.GetOrchestratorValidator(ctx, orchaddr)
+	if !k.IsStaticValByValAddress(ctx, validator.GetOperator()) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrorInvalidSigner, "validator not in static set")
+	}
+
+	k.SetEthereumHeightVote(ctx, types.EthereumHeightVote{
+		Validator:         validator.OperatorAddress,
+		EthereumHeight:    msg.EthereumHeight,
+		CosmosBlockHeight: uint64(ctx.BlockHeight()),
+		CosmosBlockTimeMs: uint64(ctx.BlockTime().UnixNano() / 1000000),
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeyValidator, validator.OperatorAddress),
+		),
+	)
+
+	return &types.MsgEthereumHeightClaimResponse{}, nil
+}
+
+// SubmitConfirmations handles MsgSubmitConfirmations, every confirm goes through the handler of its own message
+// and is applied on its own, so that a confirm that fails does not hold back the others
+func (k msgServer) SubmitConfirmations(c context.Context, msg *types.MsgSubmitConfirmations) (*types.MsgSubmitConfirmationsResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid MsgSubmitConfirmations")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := &types.MsgSubmitConfirmationsResponse{}
+	for i := range msg.ValsetConfirms {
+		confirm := &msg.ValsetConfirms[i]
+		res.ValsetConfirmResults = append(res.ValsetConfirmResults, applySubmission(ctx, func(xCtx sdk.Context) error {
+			_, err := k.ValsetConfirm(sdk.WrapSDKContext(xCtx), confirm)
+			return err
+		}))
+	}
+	for i := range msg.BatchConfirms {
+		confirm := &msg.BatchConfirms[i]
+		res.BatchConfirmResults = append(res.BatchConfirmResults, applySubmission(ctx, func(xCtx sdk.Context) error {
+			_, err := k.ConfirmBatch(sdk.WrapSDKContext(xCtx), confirm)
+			return err
+		}))
+	}
+	for i := range msg.LogicCallConfirms {
+		confirm := &msg.LogicCallConfirms[i]
+		res.LogicCallConfirmResults = append(res.LogicCallConfirmResults, applySubmission(ctx, func(xCtx sdk.Context) error {
+			_, err := k.ConfirmLogicCall(sdk.WrapSDKContext(xCtx), confirm)
+			return err
+		}))
+	}
+
+	return res, nil
+}
+
+// SubmitClaims handles MsgSubmitClaims, the claims are applied in order and each on its own, a claim that fails
+// does not hold back the others although later claims fail with it when they depend on its event nonce
+func (k msgServer) SubmitClaims(c context.Context, msg *types.MsgSubmitClaims) (*types.MsgSubmitClaimsResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid MsgSubmitClaims")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.evmChain(ctx, msg.ChainId)
+	if err != nil {
+		return nil, err
+	}
+	claims, err := msg.EthereumClaims()
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.MsgSubmitClaimsResponse{}
+	for i, claim := range claims {
+		claimAny, claim := msg.Claims[i], claim
+		res.Results = append(res.Results, applySubmission(ctx, func(xCtx sdk.Context) error {
+			if err := k.checkOrchestratorValidatorInSet(xCtx, msg.Orchestrator); err != nil {
+				return err
+			}
+			return k.claimHandlerCommon(xCtx, claimAny, claim)
+		}))
+	}
+
+	return res, nil
+}
+
+// evmChain returns the msg server scoped to the EVM chain a message refers to
+func (k msgServer) evmChain(ctx sdk.Context, chainID uint64) (msgServer, error) {
+	chainKeeper, err := k.EvmChainKeeper(ctx, chainID)
+	if err != nil {
+		return k, err
+	}
+	return msgServer{Keeper: chainKeeper}, nil
+}
+
+// applySubmission applies one entry of a MsgSubmitConfirmations or MsgSubmitClaims in a cache context that is
+// only written, along with its events, when the entry succeeds
+func applySubmission(ctx sdk.Context, apply func(xCtx sdk.Context) error) types.SubmissionResult {
+	xCtx, commit := ctx.CacheContext()
+	if err := apply(xCtx); err != nil {
+		codespace, code, log := sdkerrors.ABCIInfo(err, false)
+		return types.SubmissionResult{Code: code, Codespace: codespace, Log: log}
+	}
+	commit()
+	ctx.EventManager().EmitEvents(xCtx.EventManager().Events())
+	return types.SubmissionResult{}
 }
@@ -0,0 +1,161 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// ValidateOrchestratorMsg runs the checks a confirm or claim goes through when it is delivered without changing
+// any state, so that messages that can only fail, such as confirms with a bad signature and duplicate or stale
+// claims, are turned away before they reach a block. Aggregated confirms and claims are turned away when any of
+// their entries would not be applied.
+func (k Keeper) ValidateOrchestratorMsg(ctx sdk.Context, msg sdk.Msg) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	// the checks run against the chain the message is for
+	if chainMsg, ok := msg.(interface{ GetChainId() uint64 }); ok {
+		chainKeeper, err := k.EvmChainKeeper(ctx, chainMsg.GetChainId())
+		if err != nil {
+			return err
+		}
+		k = chainKeeper
+	}
+
+	switch msg := msg.(type) {
+	case *types.MsgValsetConfirm:
+		valset := k.GetValset(ctx, msg.Nonce)
+		if valset == nil {
+			return sdkerrors.Wrap(types.ErrInvalid, "couldn't find valset")
+		}
+		if _, err := k.confirmHandlerCommon(ctx, msg.Orchestrator, msg.Signature, valset.GetCheckpoint(k.GetGravityID(ctx))); err != nil {
+			return err
+		}
+		orchaddr, _ := sdk.AccAddressFromBech32(msg.Orchestrator)
+		if k.GetValsetConfirm(ctx, msg.Nonce, orchaddr) != nil {
+			return sdkerrors.Wrap(types.ErrDuplicate, "signature duplicate")
+		}
+	case *types.MsgConfirmBatch:
+		contract, _ := types.NewEthAddress(msg.TokenContract)
+		batch := k.GetOutgoingTXBatch(ctx, *contract, msg.Nonce)
+		if batch == nil {
+			return sdkerrors.Wrap(types.ErrInvalid, "couldn't find batch")
+		}
+		if _, err := k.confirmHandlerCommon(ctx, msg.Orchestrator, msg.Signature, batch.GetCheckpoint(k.GetGravityID(ctx))); err != nil {
+			return err
+		}
+		orchaddr, _ := sdk.AccAddressFromBech32(msg.Orchestrator)
+		if k.GetBatchConfirm(ctx, msg.Nonce, *contract, orchaddr) != nil {
+			return sdkerrors.Wrap(types.ErrDuplicate, "duplicate signature")
+		}
+	case *types.MsgConfirmLogicCall:
+		invalidationID, err := hex.DecodeString(msg.InvalidationId)
+		if err != nil {
+			return sdkerrors.Wrap(types.ErrInvalid, "invalidation id encoding")
+		}
+		logic := k.GetOutgoingLogicCall(ctx, invalidationID, msg.InvalidationNonce)
+		if logic == nil {
+			return sdkerrors.Wrap(types.ErrInvalid, "couldn't find logic")
+		}
+		if _, err := k.confirmHandlerCommon(ctx, msg.Orchestrator, msg.Signature, logic.GetCheckpoint(k.GetGravityID(ctx))); err != nil {
+			return err
+		}
+		orchaddr, _ := sdk.AccAddressFromBech32(msg.Orchestrator)
+		if k.GetLogicCallConfirm(ctx, invalidationID, msg.InvalidationNonce, orchaddr) != nil {
+			return sdkerrors.Wrap(types.ErrDuplicate, "duplicate signature")
+		}
+	case *types.MsgEthereumHeightClaim:
+		if err := k.checkOrchestratorValidatorInSet(ctx, msg.Orchestrator); err != nil {
+			return err
+		}
+		// the msg server takes any height, but only a report that moves the validator's vote forward is
+		// worth admitting for free
+		orchaddr, _ := sdk.AccAddressFromBech32(msg.Orchestrator)
+		validator, _ := k.GetOrchestratorValidator(ctx, orchaddr)
+		if vote := k.GetEthereumHeightVote(ctx, validator.GetOperator()); vote != nil && msg.EthereumHeight <= vote.EthereumHeight {
+			return sdkerrors.Wrapf(types.ErrInvalid, "ethereum height %d is not above the last reported %d", msg.EthereumHeight, vote.EthereumHeight)
+		}
+	case *types.MsgSubmitConfirmations:
+		return k.validateSubmitConfirmations(ctx, msg)
+	case *types.MsgSubmitClaims:
+		return k.validateSubmitClaims(ctx, msg)
+	case types.EthereumClaim:
+		if err := k.checkOrchestratorValidatorInSet(ctx, msg.GetClaimer().String()); err != nil {
+			return err
+		}
+		// the same check Attest makes, a claim for a nonce the validator already claimed can only fail
+		validator, _ := k.GetOrchestratorValidator(ctx, msg.GetClaimer())
+		if msg.GetEventNonce() != k.GetLastEventNonceByValidator(ctx, validator.GetOperator())+1 {
+			return types.ErrNonContiguousEventNonce
+		}
+	default:
+		return sdkerrors.Wrapf(types.ErrInvalid, "not an orchestrator message: %s", sdk.MsgTypeURL(msg))
+	}
+	return nil
+}
+
+// validateSubmitConfirmations returns an error when any of the confirms of msg would not be applied, a confirm
+// repeated in msg is only applied once
+func (k Keeper) validateSubmitConfirmations(ctx sdk.Context, msg *types.MsgSubmitConfirmations) error {
+	seen := make(map[string]bool)
+	validate := func(key string, confirm sdk.Msg) error {
+		if seen[key] {
+			return sdkerrors.Wrap(types.ErrDuplicate, "repeated confirm")
+		}
+		seen[key] = true
+		return k.ValidateOrchestratorMsg(ctx, confirm)
+	}
+	for i := range msg.ValsetConfirms {
+		confirm := &msg.ValsetConfirms[i]
+		if err := validate(fmt.Sprintf("valset/%d", confirm.Nonce), confirm); err != nil {
+			return sdkerrors.Wrapf(err, "valset confirm %d", i)
+		}
+	}
+	for i := range msg.BatchConfirms {
+		confirm := &msg.BatchConfirms[i]
+		if err := validate(fmt.Sprintf("batch/%s/%d", strings.ToLower(confirm.TokenContract), confirm.Nonce), confirm); err != nil {
+			return sdkerrors.Wrapf(err, "batch confirm %d", i)
+		}
+	}
+	for i := range msg.LogicCallConfirms {
+		confirm := &msg.LogicCallConfirms[i]
+		if err := validate(fmt.Sprintf("logic/%s/%d", strings.ToLower(confirm.InvalidationId), confirm.InvalidationNonce), confirm); err != nil {
+			return sdkerrors.Wrapf(err, "logic call confirm %d", i)
+		}
+	}
+	return nil
+}
+
+// validateSubmitClaims returns an error when any of the claims of msg would not be applied, the first claim must
+// carry the next event nonce of the validator and every other claim the nonce following the claim before it
+func (k Keeper) validateSubmitClaims(ctx sdk.Context, msg *types.MsgSubmitClaims) error {
+	claims, err := msg.EthereumClaims()
+	if err != nil {
+		return err
+	}
+	if err := k.ValidateOrchestratorMsg(ctx, claims[0].(sdk.Msg)); err != nil {
+		return sdkerrors.Wrap(err, "claim 0")
+	}
+	for i := 1; i < len(claims); i++ {
+		if claims[i].GetEventNonce() != claims[i-1].GetEventNonce()+1 {
+			return sdkerrors.Wrapf(types.ErrNonContiguousEventNonce, "claim %d", i)
+		}
+	}
+	return nil
+}
+
+// IsBondedStaticOrchestrator returns true when orchestrator is the delegate key of a bonded validator of the
+// static validator set
+func (k Keeper) IsBondedStaticOrchestrator(ctx sdk.Context, orchestrator sdk.AccAddress) bool {
+	if k.checkOrchestratorValidatorInSet(ctx, orchestrator.String()) != nil {
+		return false
+	}
+	validator, _ := k.GetOrchestratorValidator(ctx, orchestrator)
+	return k.IsStaticValByValAddress(ctx, validator.GetOperator())
+}
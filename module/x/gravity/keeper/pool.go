@@ -15,6 +15,7 @@ import (
 // AddToOutgoingPool creates a transaction and adds it to the pool, returns the id of the unbatched transaction
 // - checks a counterpart denominator exists for the given voucher type
 // - burns the voucher for transfer amount and fees
+// - holds the protocol fee in the module
 // - persists an OutgoingTx
 // - adds the TX to the `available` TX pool
 func (k Keeper) AddToOutgoingPool(
@@ -23,9 +24,26 @@ func (k Keeper) AddToOutgoingPool(
 	counterpartReceiver types.EthAddress,
 	amount sdk.Coin,
 	fee sdk.Coin,
+) (uint64, error) {
+	if !amount.IsValid() {
+		return 0, sdkerrors.Wrap(types.ErrInvalid, "arguments")
+	}
+	return k.addToOutgoingPool(ctx, sender, counterpartReceiver, amount, fee, k.GetProtocolFee(ctx, amount))
+}
+
+// addToOutgoingPool is AddToOutgoingPool with the protocol fee given, transfers the module makes itself
+// pay none
+func (k Keeper) addToOutgoingPool(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	counterpartReceiver types.EthAddress,
+	amount sdk.Coin,
+	fee sdk.Coin,
+	protocolFee sdk.Coin,
 ) (uint64, error) {
 	if ctx.IsZero() || sender.Empty() || counterpartReceiver.ValidateBasic() != nil ||
-		!amount.IsValid() || !fee.IsValid() || fee.Denom != amount.Denom {
+		!amount.IsValid() || !fee.IsValid() || fee.Denom != amount.Denom ||
+		!protocolFee.IsValid() || protocolFee.Denom != amount.Denom {
 		return 0, sdkerrors.Wrap(types.ErrInvalid, "arguments")
 	}
 	totalAmount := amount.Add(fee)
@@ -39,12 +57,23 @@ func (k Keeper) AddToOutgoingPool(
 		return 0, err
 	}
 
+	// the protocol fee is held in the module, whatever the origin of the coin, until the transfer is
+	// executed or canceled
+	var heldProtocolFee *sdk.Coin
+	if protocolFee.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(protocolFee)); err != nil {
+			return 0, sdkerrors.Wrap(err, "protocol fee")
+		}
+		heldProtocolFee = &protocolFee
+	}
+
 	// If it is a cosmos-originated asset we lock it
 	if isCosmosOriginated {
 		// lock coins in module
 		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, totalInVouchers); err != nil {
 			return 0, err
 		}
+		k.lockCosmosOriginated(ctx, totalAmount)
 	} else {
 		// If it is an ethereum-originated asset we burn it
 		// send coins to module in prep for burn
@@ -80,6 +109,7 @@ func (k Keeper) AddToOutgoingPool(
 		DestAddress: counterpartReceiver.GetAddress(),
 		Erc20Token:  erc20Token.ToExternal(),
 		Erc20Fee:    erc20Fee.ToExternal(),
+		ProtocolFee: heldProtocolFee,
 	}.ToInternal()
 	if err != nil { // This should never happen since all the components are validated
 		panic(sdkerrors.Wrap(err, "unable to create InternalOutgoingTransferTx"))
@@ -90,6 +120,12 @@ func (k Keeper) AddToOutgoingPool(
 	if err != nil {
 		panic(err)
 	}
+	k.SetQueuedTransferHeight(ctx, types.QueuedTransferHeight{
+		TxId:          nextID,
+		Height:        ctx.BlockHeight(),
+		TokenContract: tokenContract.GetAddress(),
+		Fee:           erc20Fee.Amount,
+	})
 
 	// todo: add second index for sender so that we can easily query: give pending Tx by sender
 	// todo: what about a second index for receiver?
@@ -103,6 +139,12 @@ func (k Keeper) AddToOutgoingPool(
 		sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(nextID)),
 	)
 	ctx.EventManager().EmitEvent(poolEvent)
+	k.emitTypedEvent(ctx, &types.EventSendToEth{
+		Transfer:       *outgoing.ToExternal(),
+		BridgeContract: k.GetBridgeContractAddress(ctx).GetAddress(),
+		BridgeChainId:  k.GetBridgeChainID(ctx),
+	})
+	k.recordTransferStatus(ctx, *outgoing.ToExternal(), types.TransferStatusUpdate{Status: types.TRANSFER_STATUS_QUEUED})
 
 	return nextID, nil
 }
@@ -138,6 +180,7 @@ func (k Keeper) RemoveFromOutgoingPoolAndRefund(ctx sdk.Context, txId uint64, se
 	if err != nil {
 		return sdkerrors.Wrapf(types.ErrInvalid, "txId %d not in unbatched index! Must be in a batch!", txId)
 	}
+	k.DeleteQueuedTransferHeight(ctx, tx.Erc20Fee.Contract, txId)
 	// Make sure the tx was removed
 	oldTx, oldTxErr := k.GetUnbatchedTxByFeeAndId(ctx, *tx.Erc20Fee, tx.Id)
 	if oldTx != nil || oldTxErr == nil {
@@ -145,14 +188,15 @@ func (k Keeper) RemoveFromOutgoingPoolAndRefund(ctx sdk.Context, txId uint64, se
 	}
 
 	// reissue the amount and the fee
-	totalToRefund := tx.Erc20Token.GravityCoin()
-	totalToRefund.Amount = totalToRefund.Amount.Add(tx.Erc20Fee.Amount)
+	isCosmosOriginated, denom := k.ERC20ToDenomLookup(ctx, tx.Erc20Token.Contract)
+	totalToRefund := sdk.NewCoin(denom, tx.Erc20Token.Amount.Add(tx.Erc20Fee.Amount))
 	totalToRefundCoins := sdk.NewCoins(totalToRefund)
 
-	isCosmosOriginated, _ := k.ERC20ToDenomLookup(ctx, tx.Erc20Token.Contract)
-
 	// If it is a cosmos-originated the coins are in the module (see AddToOutgoingPool) so we can just take them out
 	if isCosmosOriginated {
+		if err := k.unlockCosmosOriginated(ctx, totalToRefund); err != nil {
+			return err
+		}
 		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, totalToRefundCoins); err != nil {
 			return err
 		}
@@ -166,6 +210,12 @@ func (k Keeper) RemoveFromOutgoingPoolAndRefund(ctx sdk.Context, txId uint64, se
 			return sdkerrors.Wrap(err, "transfer vouchers")
 		}
 	}
+	if err := k.refundProtocolFee(ctx, tx); err != nil {
+		return sdkerrors.Wrap(err, "refund protocol fee")
+	}
+	if tx.ProtocolFee != nil {
+		totalToRefundCoins = totalToRefundCoins.Add(*tx.ProtocolFee)
+	}
 
 	poolEvent := sdk.NewEvent(
 		types.EventTypeBridgeWithdrawCanceled,
@@ -174,6 +224,13 @@ func (k Keeper) RemoveFromOutgoingPoolAndRefund(ctx sdk.Context, txId uint64, se
 		sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(k.GetBridgeChainID(ctx)))),
 	)
 	ctx.EventManager().EmitEvent(poolEvent)
+	k.emitTypedEvent(ctx, &types.EventSendToEthCanceled{
+		Transfer:       *tx.ToExternal(),
+		Refund:         totalToRefundCoins,
+		BridgeContract: k.GetBridgeContractAddress(ctx).GetAddress(),
+		BridgeChainId:  k.GetBridgeChainID(ctx),
+	})
+	k.recordTransferStatus(ctx, *tx.ToExternal(), types.TransferStatusUpdate{Status: types.TRANSFER_STATUS_CANCELED})
 
 	return nil
 }
@@ -181,7 +238,7 @@ func (k Keeper) RemoveFromOutgoingPoolAndRefund(ctx sdk.Context, txId uint64, se
 // addUnbatchedTx creates a new transaction in the pool
 // WARNING: Do not make this function public
 func (k Keeper) addUnbatchedTX(ctx sdk.Context, val *types.InternalOutgoingTransferTx) error {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	idxKey := types.GetOutgoingTxPoolKey(*val.Erc20Fee, val.Id)
 	if store.Has(idxKey) {
 		return sdkerrors.Wrap(types.ErrDuplicate, "transaction already in pool")
@@ -201,7 +258,7 @@ func (k Keeper) addUnbatchedTX(ctx sdk.Context, val *types.InternalOutgoingTrans
 // removeUnbatchedTXIndex removes the tx from the pool
 // WARNING: Do not make this function public
 func (k Keeper) removeUnbatchedTX(ctx sdk.Context, fee types.InternalERC20Token, txID uint64) error {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	idxKey := types.GetOutgoingTxPoolKey(fee, txID)
 	if !store.Has(idxKey) {
 		return sdkerrors.Wrap(types.ErrUnknown, "pool transaction")
@@ -212,7 +269,7 @@ func (k Keeper) removeUnbatchedTX(ctx sdk.Context, fee types.InternalERC20Token,
 
 // GetUnbatchedTxByFeeAndId grabs a tx from the pool given its fee and txID
 func (k Keeper) GetUnbatchedTxByFeeAndId(ctx sdk.Context, fee types.InternalERC20Token, txID uint64) (*types.InternalOutgoingTransferTx, error) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	bz := store.Get(types.GetOutgoingTxPoolKey(fee, txID))
 	if bz == nil {
 		return nil, sdkerrors.Wrap(types.ErrUnknown, "pool transaction")
@@ -273,7 +330,7 @@ func (k Keeper) IterateUnbatchedTransactionsByContract(ctx sdk.Context, contract
 
 // IterateUnbatchedTransactions iterates through all unbatched transactions whose keys begin with prefixKey in DESC order
 func (k Keeper) IterateUnbatchedTransactions(ctx sdk.Context, prefixKey []byte, cb func(key []byte, tx *types.InternalOutgoingTransferTx) bool) {
-	prefixStore := ctx.KVStore(k.storeKey)
+	prefixStore := k.store(ctx)
 	iter := prefixStore.ReverseIterator(prefixRange(prefixKey))
 	defer iter.Close()
 	for ; iter.Valid(); iter.Next() {
@@ -296,17 +353,15 @@ func (k Keeper) IterateUnbatchedTransactions(ctx sdk.Context, prefixKey []byte,
 // a new batch (fees must be increasing)
 func (k Keeper) GetBatchFeeByTokenType(ctx sdk.Context, tokenContractAddr types.EthAddress, maxElements uint) *types.BatchFees {
 	batchFee := types.BatchFees{Token: tokenContractAddr.GetAddress(), TotalFees: sdk.NewInt(0)}
-	txCount := 0
 
-	k.IterateUnbatchedTransactions(ctx, types.GetOutgoingTxPoolContractPrefix(tokenContractAddr), func(_ []byte, tx *types.InternalOutgoingTransferTx) bool {
+	// the same transactions pickUnbatchedTX would put in the batch
+	for _, tx := range k.selectUnbatchedTXs(ctx, tokenContractAddr, maxElements) {
 		fee := tx.Erc20Fee
 		if fee.Contract.GetAddress() != tokenContractAddr.GetAddress() {
 			panic(fmt.Errorf("unexpected fee contract %s when getting batch fees for contract %s", fee.Contract, tokenContractAddr))
 		}
 		batchFee.TotalFees = batchFee.TotalFees.Add(fee.Amount)
-		txCount += 1
-		return txCount == int(maxElements)
-	})
+	}
 	return &batchFee
 }
 
@@ -340,37 +395,25 @@ func (k Keeper) GetAllBatchFees(ctx sdk.Context, maxElements uint) (batchFees []
 }
 
 // createBatchFees iterates over the unbatched transaction pool and creates batch token fee map
-// Implicitly creates batches with the highest potential fee because the transaction keys enforce an order which goes
-// fee contract address -> fee amount -> transaction nonce
+// holding the fees of the batch GetBatchFeeByTokenType predicts for every token in the pool
 func (k Keeper) createBatchFees(ctx sdk.Context, maxElements uint) map[string]*types.BatchFees {
 	batchFeesMap := make(map[string]*types.BatchFees)
-	txCountMap := make(map[string]int)
+	var tokens []types.EthAddress
 
 	k.IterateUnbatchedTransactions(ctx, types.OutgoingTXPoolKey, func(_ []byte, tx *types.InternalOutgoingTransferTx) bool {
-		if txCountMap[tx.Erc20Fee.Contract.GetAddress()] < int(maxElements) {
-			addFeeToMap(tx.Erc20Fee, batchFeesMap, txCountMap)
+		if _, ok := batchFeesMap[tx.Erc20Fee.Contract.GetAddress()]; !ok {
+			batchFeesMap[tx.Erc20Fee.Contract.GetAddress()] = nil
+			tokens = append(tokens, tx.Erc20Fee.Contract)
 		}
 		return false
 	})
+	for _, token := range tokens {
+		batchFeesMap[token.GetAddress()] = k.GetBatchFeeByTokenType(ctx, token, maxElements)
+	}
 
 	return batchFeesMap
 }
 
-// Helper method for creating batch fees
-func addFeeToMap(fee *types.InternalERC20Token, batchFeesMap map[string]*types.BatchFees, txCountMap map[string]int) {
-	feeAddrStr := fee.Contract.GetAddress()
-	txCountMap[feeAddrStr] = txCountMap[feeAddrStr] + 1
-
-	// add fee amount
-	if _, ok := batchFeesMap[feeAddrStr]; ok {
-		batchFeesMap[feeAddrStr].TotalFees = batchFeesMap[feeAddrStr].TotalFees.Add(fee.Amount)
-	} else {
-		batchFeesMap[feeAddrStr] = &types.BatchFees{
-			Token:     feeAddrStr,
-			TotalFees: fee.Amount}
-	}
-}
-
 func (k Keeper) autoIncrementID(ctx sdk.Context, idKey []byte) uint64 {
 	// store := ctx.KVStore(k.storeKey)
 	// bz := store.Get(idKey)
@@ -387,14 +430,14 @@ func (k Keeper) autoIncrementID(ctx sdk.Context, idKey []byte) uint64 {
 }
 
 func (k Keeper) setIncrementID(ctx sdk.Context, idKey []byte, id uint64) uint64 {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	bz := sdk.Uint64ToBigEndian(id)
 	store.Set(idKey, bz)
 	return id
 }
 
 func (k Keeper) GetIncrementID(ctx sdk.Context, idKey []byte) uint64 {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	bz := store.Get(idKey)
 	var id uint64 = 1
 	if bz != nil {
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// GetProtocolFee returns the protocol fee a transfer of amount to Ethereum pays on top of its bridge fee:
+// ProtocolFeeBasisPoints of the amount, rounded down, and at least the entry of ProtocolFeeMinimums for
+// the denom of the amount
+func (k Keeper) GetProtocolFee(ctx sdk.Context, amount sdk.Coin) sdk.Coin {
+	params := k.GetParams(ctx)
+	fee := amount.Amount.Mul(sdk.NewIntFromUint64(params.ProtocolFeeBasisPoints)).Quo(sdk.NewInt(10000))
+	if minimum := params.ProtocolFeeMinimums.AmountOf(amount.Denom); fee.LT(minimum) {
+		fee = minimum
+	}
+	return sdk.NewCoin(amount.Denom, fee)
+}
+
+// payProtocolFee pays the protocol fee held with an executed transfer to the ProtocolFeeTreasury account,
+// or to the community pool when no treasury is set
+func (k Keeper) payProtocolFee(ctx sdk.Context, tx *types.InternalOutgoingTransferTx) error {
+	if tx.ProtocolFee == nil || !tx.ProtocolFee.IsPositive() {
+		return nil
+	}
+	fee := sdk.NewCoins(*tx.ProtocolFee)
+	moduleAddress := authtypes.NewModuleAddress(types.ModuleName)
+	treasury := k.GetParams(ctx).ProtocolFeeTreasury
+	if treasury == "" {
+		return k.distributionKeeper.FundCommunityPool(ctx, fee, moduleAddress)
+	}
+	treasuryAddress, err := sdk.AccAddressFromBech32(treasury)
+	if err != nil {
+		return sdkerrors.Wrap(err, "protocol fee treasury")
+	}
+	return k.bankKeeper.SendCoins(ctx, moduleAddress, treasuryAddress, fee)
+}
+
+// refundProtocolFee returns the protocol fee held with a canceled transfer to its sender
+func (k Keeper) refundProtocolFee(ctx sdk.Context, tx *types.InternalOutgoingTransferTx) error {
+	if tx.ProtocolFee == nil || !tx.ProtocolFee.IsPositive() {
+		return nil
+	}
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, tx.Sender, sdk.NewCoins(*tx.ProtocolFee))
+}
@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+func TestGetProtocolFee(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+
+	// no fee by default
+	require.True(t, k.GetProtocolFee(ctx, sdk.NewInt64Coin("stake", 1000000)).IsZero())
+
+	params := k.GetParams(ctx)
+	params.ProtocolFeeBasisPoints = 25
+	params.ProtocolFeeMinimums = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	k.SetParams(ctx, params)
+
+	// a quarter of a percent, rounded down
+	require.Equal(t, sdk.NewInt64Coin("stake", 2500), k.GetProtocolFee(ctx, sdk.NewInt64Coin("stake", 1000000)))
+	require.Equal(t, sdk.NewInt64Coin("stake", 2500), k.GetProtocolFee(ctx, sdk.NewInt64Coin("stake", 1000399)))
+	// but never less than the minimum of the denom
+	require.Equal(t, sdk.NewInt64Coin("stake", 100), k.GetProtocolFee(ctx, sdk.NewInt64Coin("stake", 1000)))
+	require.Equal(t, sdk.NewInt64Coin("other", 2), k.GetProtocolFee(ctx, sdk.NewInt64Coin("other", 1000)))
+}
+
+//nolint: exhaustivestruct
+func TestProtocolFee(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	var (
+		mySender, _            = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver, _          = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr, _ = types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5") // Pickle
+		token, err             = types.NewInternalERC20Token(sdk.NewInt(10000), myTokenContractAddr.GetAddress())
+		allVouchers            = sdk.NewCoins(token.GravityCoin())
+		denom                  = token.GravityCoin().Denom
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
+
+	params := k.GetParams(ctx)
+	params.ProtocolFeeBasisPoints = 100
+	k.SetParams(ctx, params)
+
+	// the protocol fee is taken on top of the amount and the bridge fee and held in the module
+	for i := 0; i < 3; i++ {
+		_, err := k.AddToOutgoingPool(ctx, mySender, *myReceiver, sdk.NewInt64Coin(denom, 1000), sdk.NewInt64Coin(denom, int64(10+i)))
+		require.NoError(t, err)
+	}
+	require.Equal(t, sdk.NewInt(10000-3*1000-10-11-12-30), input.BankKeeper.GetBalance(ctx, mySender, denom).Amount)
+	require.Equal(t, sdk.NewInt(30), input.BankKeeper.GetBalance(ctx, input.AccountKeeper.GetModuleAddress(types.ModuleName), denom).Amount)
+	tx, err := k.GetUnbatchedTxById(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(denom, 10), *tx.ProtocolFee)
+
+	// a canceled transfer gets its protocol fee back
+	require.NoError(t, k.RemoveFromOutgoingPoolAndRefund(ctx, 1, mySender))
+	require.Equal(t, sdk.NewInt(10000-2*1000-11-12-20), input.BankKeeper.GetBalance(ctx, mySender, denom).Amount)
+
+	// an executed transfer pays its protocol fee to the community pool
+	batch, err := k.BuildOutgoingTXBatch(ctx, *myTokenContractAddr, 1)
+	require.NoError(t, err)
+	require.Len(t, batch.Transactions, 1)
+	k.OutgoingTxBatchExecuted(ctx, *myTokenContractAddr, batch.BatchNonce, 0)
+	require.Equal(t, sdk.NewDec(10), input.DistKeeper.GetFeePool(ctx).CommunityPool.AmountOf(denom))
+
+	// or to the treasury once one is set
+	params.ProtocolFeeTreasury = AccAddrs[0].String()
+	k.SetParams(ctx, params)
+	batch, err = k.BuildOutgoingTXBatch(ctx, *myTokenContractAddr, 1)
+	require.NoError(t, err)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	k.OutgoingTxBatchExecuted(ctx, *myTokenContractAddr, batch.BatchNonce, 0)
+	require.Equal(t, sdk.NewInt(10), input.BankKeeper.GetBalance(ctx, AccAddrs[0], denom).Amount)
+	// the events of the payment reach the block
+	paid := false
+	for _, event := range ctx.EventManager().Events() {
+		for _, attr := range event.Attributes {
+			if event.Type == banktypes.EventTypeTransfer && string(attr.Key) == banktypes.AttributeKeyRecipient && string(attr.Value) == AccAddrs[0].String() {
+				paid = true
+			}
+		}
+	}
+	require.True(t, paid)
+	require.Equal(t, sdk.NewDec(10), input.DistKeeper.GetFeePool(ctx).CommunityPool.AmountOf(denom))
+	require.True(t, input.BankKeeper.GetBalance(ctx, input.AccountKeeper.GetModuleAddress(types.ModuleName), denom).IsZero())
+
+	// a fee that can not be paid is left behind without failing the execution of the batch
+	_, err = k.AddToOutgoingPool(ctx, mySender, *myReceiver, sdk.NewInt64Coin(denom, 1000), sdk.NewInt64Coin(denom, 10))
+	require.NoError(t, err)
+	batch, err = k.BuildOutgoingTXBatch(ctx, *myTokenContractAddr, 1)
+	require.NoError(t, err)
+	require.NoError(t, input.BankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(denom, 10))))
+	k.OutgoingTxBatchExecuted(ctx, *myTokenContractAddr, batch.BatchNonce, 0)
+	require.Nil(t, k.GetOutgoingTXBatch(ctx, *myTokenContractAddr, batch.BatchNonce))
+	require.Equal(t, sdk.NewInt(10), input.BankKeeper.GetBalance(ctx, AccAddrs[0], denom).Amount)
+
+	// the fee a transfer will pay can be queried ahead of time
+	res, err := k.ProtocolFee(sdk.WrapSDKContext(ctx), &types.QueryProtocolFeeRequest{Amount: "5000" + denom})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(denom, 50), res.ProtocolFee)
+	_, err = k.ProtocolFee(sdk.WrapSDKContext(ctx), &types.QueryProtocolFeeRequest{Amount: "5000unknown"})
+	require.Error(t, err)
+}
@@ -151,24 +151,34 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 	}
 }
 
+// The legacy queries below only parse their path and delegate to the gRPC query service in
+// grpc_query.go, so that both return the same data. What the gRPC service returns is marshalled
+// into the amino JSON the legacy querier has always returned, and an empty result is returned as
+// nil rather than as an empty list.
+
+// legacyJSON marshals a value taken from a gRPC response with the module amino codec
+func legacyJSON(v interface{}) ([]byte, error) {
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, v)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return res, nil
+}
+
 func queryValsetRequest(ctx sdk.Context, path []string, keeper Keeper) ([]byte, error) {
 	nonce, err := types.UInt64FromString(path[0])
 	if err != nil {
 		return nil, err
 	}
 
-	valset := keeper.GetValset(ctx, nonce)
-	if valset == nil {
-		return nil, nil
-	}
-	// TODO: replace these with the GRPC response types
-	// TODO: fix the use of module codec here
-	res, err := codec.MarshalJSONIndent(types.ModuleCdc, valset)
+	res, err := keeper.ValsetRequest(sdk.WrapSDKContext(ctx), &types.QueryValsetRequestRequest{Nonce: nonce})
 	if err != nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+		return nil, err
 	}
-
-	return res, nil
+	if res.Valset == nil {
+		return nil, nil
+	}
+	return legacyJSON(res.Valset)
 }
 
 // allValsetConfirmsByNonce returns all the confirm messages for a given nonce
@@ -179,20 +189,14 @@ func queryAllValsetConfirms(ctx sdk.Context, nonceStr string, keeper Keeper) ([]
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
-	var confirms []*types.MsgValsetConfirm
-	keeper.IterateValsetConfirmByNonce(ctx, nonce, func(_ []byte, c types.MsgValsetConfirm) bool {
-		confirms = append(confirms, &c)
-		return false
-	})
-	if len(confirms) == 0 {
-		return nil, nil
-	}
-	res, err := codec.MarshalJSONIndent(types.ModuleCdc, confirms)
+	res, err := keeper.ValsetConfirmsByNonce(sdk.WrapSDKContext(ctx), &types.QueryValsetConfirmsByNonceRequest{Nonce: nonce})
 	if err != nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+		return nil, err
 	}
-
-	return res, nil
+	if len(res.Confirms) == 0 {
+		return nil, nil
+	}
+	return legacyJSON(res.Confirms)
 }
 
 // allBatchConfirms returns all the confirm messages for a given nonce
@@ -202,88 +206,54 @@ func queryAllBatchConfirms(ctx sdk.Context, nonceStr string, tokenContract strin
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
-	contract, err := types.NewEthAddress(tokenContract)
 
-	var confirms []types.MsgConfirmBatch
-	keeper.IterateBatchConfirmByNonceAndTokenContract(ctx, nonce, *contract, func(_ []byte, c types.MsgConfirmBatch) bool {
-		confirms = append(confirms, c)
-		return false
+	res, err := keeper.BatchConfirms(sdk.WrapSDKContext(ctx), &types.QueryBatchConfirmsRequest{
+		Nonce:           nonce,
+		ContractAddress: tokenContract,
 	})
-	if len(confirms) == 0 {
-		return nil, nil
-	}
-	res, err := codec.MarshalJSONIndent(types.ModuleCdc, confirms)
 	if err != nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
-
-	return res, nil
+	if len(res.Confirms) == 0 {
+		return nil, nil
+	}
+	return legacyJSON(res.Confirms)
 }
 
 const maxValsetRequestsReturned = 5
 
 // lastValsetRequests returns up to maxValsetRequestsReturned valsets from the store
 func lastValsetRequests(ctx sdk.Context, keeper Keeper) ([]byte, error) {
-	var counter int
-	var valReq []*types.Valset
-	keeper.IterateValsets(ctx, func(_ []byte, val *types.Valset) bool {
-		valReq = append(valReq, val)
-		counter++
-		return counter >= maxValsetRequestsReturned
-	})
-	if len(valReq) == 0 {
-		return nil, nil
-	}
-	res, err := codec.MarshalJSONIndent(types.ModuleCdc, valReq)
+	res, err := keeper.LastValsetRequests(sdk.WrapSDKContext(ctx), &types.QueryLastValsetRequestsRequest{})
 	if err != nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+		return nil, err
 	}
-	return res, nil
+	if len(res.Valsets) == 0 {
+		return nil, nil
+	}
+	return legacyJSON(res.Valsets)
 }
 
 // lastPendingValsetRequest gets a list of validator sets that this validator has not signed
 // limited by 100 sets per request.
 func lastPendingValsetRequest(ctx sdk.Context, operatorAddr string, keeper Keeper) ([]byte, error) {
-	addr, err := sdk.AccAddressFromBech32(operatorAddr)
+	res, err := keeper.LastPendingValsetRequestByAddr(sdk.WrapSDKContext(ctx),
+		&types.QueryLastPendingValsetRequestByAddrRequest{Address: operatorAddr})
 	if err != nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "address invalid")
+		return nil, err
 	}
-
-	var pendingValsetReq []*types.Valset
-	keeper.IterateValsets(ctx, func(_ []byte, val *types.Valset) bool {
-		// foundConfirm is true if the operatorAddr has signed the valset we are currently looking at
-		foundConfirm := keeper.GetValsetConfirm(ctx, val.Nonce, addr) != nil
-		// if this valset has NOT been signed by operatorAddr, store it in pendingValsetReq
-		// and exit the loop
-		if !foundConfirm {
-			pendingValsetReq = append(pendingValsetReq, val)
-		}
-		// if we have more than 100 unconfirmed requests in
-		// our array we should exit, TODO pagination
-		if len(pendingValsetReq) > 100 {
-			return true
-		}
-		// return false to continue the loop
-		return false
-	})
-	if len(pendingValsetReq) == 0 {
+	if len(res.Valsets) == 0 {
 		return nil, nil
 	}
-	res, err := codec.MarshalJSONIndent(types.ModuleCdc, pendingValsetReq)
-	if err != nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
-	}
-	return res, nil
+	return legacyJSON(res.Valsets)
 }
 
 func queryCurrentValset(ctx sdk.Context, keeper Keeper) ([]byte, error) {
-	valset := keeper.GetCurrentValset(ctx)
-	res, err := codec.MarshalJSONIndent(types.ModuleCdc, valset)
+	res, err := keeper.CurrentValset(sdk.WrapSDKContext(ctx), &types.QueryCurrentValsetRequest{})
 	if err != nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+		return nil, err
 	}
-
-	return res, nil
+	return legacyJSON(res.Valset)
 }
 
 // queryValsetConfirm returns the confirm msg for single orchestrator address and nonce
@@ -294,21 +264,17 @@ func queryValsetConfirm(ctx sdk.Context, path []string, keeper Keeper) ([]byte,
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
-	accAddress, err := sdk.AccAddressFromBech32(path[1])
+	res, err := keeper.ValsetConfirm(sdk.WrapSDKContext(ctx), &types.QueryValsetConfirmRequest{
+		Nonce:   nonce,
+		Address: path[1],
+	})
 	if err != nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		return nil, err
 	}
-
-	valset := keeper.GetValsetConfirm(ctx, nonce, accAddress)
-	if valset == nil {
+	if res.Confirm == nil {
 		return nil, nil
 	}
-	res, err := codec.MarshalJSONIndent(types.ModuleCdc, *valset)
-	if err != nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
-	}
-
-	return res, nil
+	return legacyJSON(*res.Confirm)
 }
 
 type MultiSigUpdateResponse struct {
@@ -318,73 +284,49 @@ type MultiSigUpdateResponse struct {
 
 // lastPendingBatchRequest gets the latest batch that has NOT been signed by operatorAddr
 func lastPendingBatchRequest(ctx sdk.Context, operatorAddr string, keeper Keeper) ([]byte, error) {
-	addr, err := sdk.AccAddressFromBech32(operatorAddr)
+	res, err := keeper.LastPendingBatchRequestByAddr(sdk.WrapSDKContext(ctx),
+		&types.QueryLastPendingBatchRequestByAddrRequest{Address: operatorAddr})
 	if err != nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "address invalid")
+		return nil, err
 	}
-
-	var pendingBatchReq *types.OutgoingTxBatch
-	keeper.IterateOutgoingTXBatches(ctx, func(_ []byte, batch *types.InternalOutgoingTxBatch) bool {
-		foundConfirm := keeper.GetBatchConfirm(ctx, batch.BatchNonce, batch.TokenContract, addr) != nil
-		if !foundConfirm {
-			pendingBatchReq = batch.ToExternal()
-			return true
-		}
-		return false
-	})
-	if pendingBatchReq == nil {
+	if res.Batch == nil {
 		return nil, nil
 	}
-	res, err := codec.MarshalJSONIndent(types.ModuleCdc, pendingBatchReq)
-	if err != nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
-	}
-	return res, nil
+	return legacyJSON(res.Batch)
 }
 
 const MaxResults = 100 // todo: impl pagination
 
 // Gets MaxResults batches from store. Does not select by token type or anything
 func lastBatchesRequest(ctx sdk.Context, keeper Keeper) ([]byte, error) {
-	var batches []*types.OutgoingTxBatch
-	keeper.IterateOutgoingTXBatches(ctx, func(_ []byte, batch *types.InternalOutgoingTxBatch) bool {
-		batches = append(batches, batch.ToExternal())
-		return len(batches) == MaxResults
-	})
-	if len(batches) == 0 {
-		return nil, nil
-	}
-	res, err := codec.MarshalJSONIndent(types.ModuleCdc, batches)
+	res, err := keeper.OutgoingTxBatches(sdk.WrapSDKContext(ctx), &types.QueryOutgoingTxBatchesRequest{})
 	if err != nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+		return nil, err
 	}
-	return res, nil
+	if len(res.Batches) == 0 {
+		return nil, nil
+	}
+	return legacyJSON(res.Batches)
 }
 
 func queryBatchFees(ctx sdk.Context, keeper Keeper) ([]byte, error) {
-	val := types.QueryBatchFeeResponse{BatchFees: keeper.GetAllBatchFees(ctx, OutgoingTxBatchSize)}
-	res, err := codec.MarshalJSONIndent(types.ModuleCdc, val)
+	res, err := keeper.BatchFees(sdk.WrapSDKContext(ctx), &types.QueryBatchFeeRequest{})
 	if err != nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+		return nil, err
 	}
-	return res, nil
+	return legacyJSON(*res)
 }
 
 // Gets MaxResults logic calls from store.
 func lastLogicCallRequests(ctx sdk.Context, keeper Keeper) ([]byte, error) {
-	var calls []*types.OutgoingLogicCall
-	keeper.IterateOutgoingLogicCalls(ctx, func(_ []byte, call *types.OutgoingLogicCall) bool {
-		calls = append(calls, call)
-		return len(calls) == MaxResults
-	})
-	if len(calls) == 0 {
-		return nil, nil
-	}
-	res, err := codec.MarshalJSONIndent(types.ModuleCdc, calls)
+	res, err := keeper.OutgoingLogicCalls(sdk.WrapSDKContext(ctx), &types.QueryOutgoingLogicCallsRequest{})
 	if err != nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+		return nil, err
 	}
-	return res, nil
+	if len(res.Calls) == 0 {
+		return nil, nil
+	}
+	return legacyJSON(res.Calls)
 }
 
 // queryBatch gets a batch by tokenContract and nonce
@@ -393,63 +335,51 @@ func queryBatch(ctx sdk.Context, nonce string, tokenContract string, keeper Keep
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, err.Error())
 	}
-	contract, err := types.NewEthAddress(tokenContract)
-	if err != nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, err.Error())
-	}
-	foundBatch := keeper.GetOutgoingTXBatch(ctx, *contract, parsedNonce)
-	if foundBatch == nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Can not find tx batch")
-	}
-	res, err := codec.MarshalJSONIndent(types.ModuleCdc, foundBatch.ToExternal())
+	res, err := keeper.BatchRequestByNonce(sdk.WrapSDKContext(ctx), &types.QueryBatchRequestByNonceRequest{
+		Nonce:           parsedNonce,
+		ContractAddress: tokenContract,
+	})
 	if err != nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, err.Error())
+		return nil, err
 	}
-	return res, nil
+	return legacyJSON(res.Batch)
 }
 
 // lastPendingLogicCallRequest gets the latest call that has NOT been signed by operatorAddr
 func lastPendingLogicCallRequest(ctx sdk.Context, operatorAddr string, keeper Keeper) ([]byte, error) {
-	addr, err := sdk.AccAddressFromBech32(operatorAddr)
+	res, err := keeper.LastPendingLogicCallByAddr(sdk.WrapSDKContext(ctx),
+		&types.QueryLastPendingLogicCallByAddrRequest{Address: operatorAddr})
 	if err != nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "address invalid")
+		return nil, err
 	}
-
-	var pendingLogicCalls *types.OutgoingLogicCall
-	keeper.IterateOutgoingLogicCalls(ctx, func(_ []byte, call *types.OutgoingLogicCall) bool {
-		foundConfirm := keeper.GetLogicCallConfirm(ctx, call.InvalidationId, call.InvalidationNonce, addr) != nil
-		if !foundConfirm {
-			pendingLogicCalls = call
-			return true
-		}
-		return false
-	})
-	if pendingLogicCalls == nil {
+	if res.Call == nil {
 		return nil, nil
 	}
-	res, err := codec.MarshalJSONIndent(types.ModuleCdc, pendingLogicCalls)
-	if err != nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
-	}
-	return res, nil
+	return legacyJSON(res.Call)
 }
 
-// queryLogicCall gets a logic call by nonce and invalidation id
+// queryLogicCall gets a logic call by nonce and hex encoded invalidation id
 func queryLogicCall(ctx sdk.Context, invalidationId string, invalidationNonce string, keeper Keeper) ([]byte, error) {
 	nonce, err := types.UInt64FromString(invalidationNonce)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
-
-	foundCall := keeper.GetOutgoingLogicCall(ctx, []byte(invalidationId), nonce)
-	if foundCall == nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Can not find logic call")
+	invalidationIdBytes, err := hex.DecodeString(invalidationId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
-	res, err := codec.MarshalJSONIndent(types.ModuleCdc, foundCall)
+
+	res, err := keeper.OutgoingLogicCall(sdk.WrapSDKContext(ctx), &types.QueryOutgoingLogicCallRequest{
+		InvalidationId:    invalidationIdBytes,
+		InvalidationNonce: nonce,
+	})
 	if err != nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, err.Error())
+		return nil, err
 	}
-	return res, nil
+	if res.Call == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Can not find logic call")
+	}
+	return legacyJSON(res.Call)
 }
 
 // allLogicCallConfirms returns all the confirm messages for a given nonce
@@ -464,88 +394,47 @@ func queryAllLogicCallConfirms(ctx sdk.Context, invalidationId string, invalidat
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
-	var confirms []*types.MsgConfirmLogicCall
-	keeper.IterateLogicConfirmByInvalidationIDAndNonce(ctx, invalidationIdBytes, nonce, func(_ []byte, c *types.MsgConfirmLogicCall) bool {
-		confirms = append(confirms, c)
-		return false
+	res, err := keeper.LogicConfirms(sdk.WrapSDKContext(ctx), &types.QueryLogicConfirmsRequest{
+		InvalidationId:    invalidationIdBytes,
+		InvalidationNonce: nonce,
 	})
-	if len(confirms) == 0 {
-		return nil, nil
-	}
-	res, err := codec.MarshalJSONIndent(types.ModuleCdc, confirms)
 	if err != nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+		return nil, err
 	}
-
-	return res, nil
+	if len(res.Confirms) == 0 {
+		return nil, nil
+	}
+	return legacyJSON(res.Confirms)
 }
 
 func queryGravityID(ctx sdk.Context, keeper Keeper) ([]byte, error) {
-	gravityID := keeper.GetGravityID(ctx)
-	res, err := codec.MarshalJSONIndent(types.ModuleCdc, gravityID)
+	res, err := keeper.Params(sdk.WrapSDKContext(ctx), &types.QueryParamsRequest{})
 	if err != nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
-	} else {
-		return res, nil
+		return nil, err
 	}
+	return legacyJSON(res.Params.GravityId)
 }
 
 func queryDenomToERC20(ctx sdk.Context, denom string, keeper Keeper) ([]byte, error) {
-	cosmos_originated, erc20, err := keeper.DenomToERC20Lookup(ctx, denom)
-	if err != nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
-	}
-	var response types.QueryDenomToERC20Response
-	response.CosmosOriginated = cosmos_originated
-	response.Erc20 = erc20.GetAddress()
-	bytes, err := codec.MarshalJSONIndent(types.ModuleCdc, response)
+	res, err := keeper.DenomToERC20(sdk.WrapSDKContext(ctx), &types.QueryDenomToERC20Request{Denom: denom})
 	if err != nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
-	} else {
-		return bytes, nil
+		return nil, err
 	}
+	return legacyJSON(*res)
 }
 
 func queryERC20ToDenom(ctx sdk.Context, ERC20 string, keeper Keeper) ([]byte, error) {
-	ethAddr, err := types.NewEthAddress(ERC20)
-	if err != nil {
-		return nil, sdkerrors.Wrapf(err, "invalid ERC20 in query: %s", ERC20)
-	}
-	cosmos_originated, denom := keeper.ERC20ToDenomLookup(ctx, *ethAddr)
-	var response types.QueryERC20ToDenomResponse
-	response.CosmosOriginated = cosmos_originated
-	response.Denom = denom
-	bytes, err := codec.MarshalJSONIndent(types.ModuleCdc, response)
+	res, err := keeper.ERC20ToDenom(sdk.WrapSDKContext(ctx), &types.QueryERC20ToDenomRequest{Erc20: ERC20})
 	if err != nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
-	} else {
-		return bytes, nil
+		return nil, err
 	}
+	return legacyJSON(*res)
 }
 
 func queryPendingSendToEth(ctx sdk.Context, senderAddr string, k Keeper) ([]byte, error) {
-	batches := k.GetOutgoingTxBatches(ctx)
-	unbatched_tx := k.GetUnbatchedTransactions(ctx)
-	res := types.QueryPendingSendToEthResponse{
-		TransfersInBatches: []*types.OutgoingTransferTx{},
-		UnbatchedTransfers: []*types.OutgoingTransferTx{},
-	}
-	for _, batch := range batches {
-		for _, tx := range batch.Transactions {
-			if tx.Sender.String() == senderAddr {
-				res.TransfersInBatches = append(res.TransfersInBatches, tx.ToExternal())
-			}
-		}
-	}
-	for _, tx := range unbatched_tx {
-		if tx.Sender.String() == senderAddr {
-			res.UnbatchedTransfers = append(res.UnbatchedTransfers, tx.ToExternal())
-		}
-	}
-	bytes, err := codec.MarshalJSONIndent(types.ModuleCdc, res)
+	res, err := k.GetPendingSendToEth(sdk.WrapSDKContext(ctx), &types.QueryPendingSendToEth{SenderAddress: senderAddr})
 	if err != nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
-	} else {
-		return bytes, nil
+		return nil, err
 	}
+	return legacyJSON(*res)
 }
@@ -551,6 +551,22 @@ func TestLastPendingBatchRequest(t *testing.T) {
 	}
 }
 
+// without a batch to sign the legacy query returns nothing and the gRPC query a nil batch
+func TestLastPendingBatchRequestNoBatch(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	accAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	got, err := lastPendingBatchRequest(ctx, accAddr.String(), input.GravityKeeper)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	res, err := input.GravityKeeper.LastPendingBatchRequestByAddr(sdk.WrapSDKContext(ctx),
+		&types.QueryLastPendingBatchRequestByAddrRequest{Address: accAddr.String()})
+	require.NoError(t, err)
+	assert.Nil(t, res.Batch)
+}
+
 //nolint: exhaustivestruct
 func createTestBatch(t *testing.T, input TestInput) {
 	var (
@@ -1083,3 +1099,49 @@ func TestQueryPendingSendToEth(t *testing.T) {
 
 	assert.JSONEq(t, string(expectedJSON), string(response), "json is equal")
 }
+
+//nolint: exhaustivestruct
+// A logic call is found by its invalidation id and nonce however many calls are outstanding
+func TestQueryLogicCall(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	token := []*types.ERC20Token{{Contract: "0x7580bfe88dd3d07947908fae12d95872a260f2d8", Amount: sdk.NewInt(5000)}}
+	for i := 0; i <= MaxResults; i++ {
+		// SetOutgoingLogicCall does not store calls, they are written to the store directly
+		call := types.OutgoingLogicCall{
+			Transfers:            token,
+			Fees:                 token,
+			LogicContractAddress: "0x510ab76899430424d209a6c9a5b9951fb8a6f47d",
+			Payload:              []byte("payload"),
+			Timeout:              10000,
+			InvalidationId:       []byte{byte(i)},
+			InvalidationNonce:    1,
+		}
+		k.store(ctx).Set(types.GetOutgoingLogicCallKey(call.InvalidationId, call.InvalidationNonce), k.cdc.MustMarshal(&call))
+	}
+	all, err := k.OutgoingLogicCalls(sdk.WrapSDKContext(ctx), &types.QueryOutgoingLogicCallsRequest{})
+	require.NoError(t, err)
+	require.Len(t, all.Calls, MaxResults)
+
+	res, err := k.OutgoingLogicCall(sdk.WrapSDKContext(ctx), &types.QueryOutgoingLogicCallRequest{
+		InvalidationId:    []byte{byte(MaxResults)},
+		InvalidationNonce: 1,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, res.Call)
+	require.Equal(t, []byte{byte(MaxResults)}, res.Call.InvalidationId)
+
+	res, err = k.OutgoingLogicCall(sdk.WrapSDKContext(ctx), &types.QueryOutgoingLogicCallRequest{
+		InvalidationId:    []byte{byte(MaxResults)},
+		InvalidationNonce: 2,
+	})
+	require.NoError(t, err)
+	require.Nil(t, res.Call)
+
+	bz, err := queryLogicCall(ctx, hex.EncodeToString([]byte{byte(MaxResults)}), "1", k)
+	require.NoError(t, err)
+	require.Contains(t, string(bz), `"invalidation_nonce": "1"`)
+	_, err = queryLogicCall(ctx, hex.EncodeToString([]byte{byte(MaxResults)}), "2", k)
+	require.Error(t, err)
+}
@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+//nolint: exhaustivestruct
+func TestSubmitConfirmations(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	for i := range ValAddrs {
+		k.SetOrchestratorValidator(ctx, ValAddrs[i], AccAddrs[i])
+	}
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	ethAddress, err := types.NewEthAddress(crypto.PubkeyToAddress(privKey.PublicKey).String())
+	require.NoError(t, err)
+	k.SetEthAddressForValidator(ctx, ValAddrs[0], *ethAddress)
+
+	valset := k.SetValsetRequest(ctx)
+	signature, err := types.NewEthereumSignature(valset.GetCheckpoint(k.GetGravityID(ctx)), privKey)
+	require.NoError(t, err)
+	confirm := types.NewMsgValsetConfirm(valset.Nonce, *ethAddress, AccAddrs[0], hex.EncodeToString(signature))
+	unknownValset := types.NewMsgValsetConfirm(valset.Nonce+1, *ethAddress, AccAddrs[0], hex.EncodeToString(signature))
+
+	msg := types.NewMsgSubmitConfirmations(AccAddrs[0], []types.MsgValsetConfirm{*unknownValset, *confirm}, nil, nil)
+	res, err := NewMsgServerImpl(k).SubmitConfirmations(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	require.Len(t, res.ValsetConfirmResults, 2)
+	require.Equal(t, types.ErrInvalid.ABCICode(), res.ValsetConfirmResults[0].Code)
+	require.Equal(t, types.ModuleName, res.ValsetConfirmResults[0].Codespace)
+	require.Zero(t, res.ValsetConfirmResults[1].Code)
+	require.Empty(t, res.BatchConfirmResults)
+	require.NotNil(t, k.GetValsetConfirm(ctx, valset.Nonce, AccAddrs[0]))
+
+	// submitted again the confirm is a duplicate
+	res, err = NewMsgServerImpl(k).SubmitConfirmations(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	require.Equal(t, types.ErrDuplicate.ABCICode(), res.ValsetConfirmResults[1].Code)
+
+	// confirms signed by another orchestrator are rejected as a whole
+	other := types.NewMsgValsetConfirm(valset.Nonce, *ethAddress, AccAddrs[1], hex.EncodeToString(signature))
+	msg = types.NewMsgSubmitConfirmations(AccAddrs[0], []types.MsgValsetConfirm{*confirm, *other}, nil, nil)
+	_, err = NewMsgServerImpl(k).SubmitConfirmations(sdk.WrapSDKContext(ctx), msg)
+	require.Error(t, err)
+}
+
+//nolint: exhaustivestruct
+func TestSubmitClaims(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	for i := range ValAddrs {
+		k.SetOrchestratorValidator(ctx, ValAddrs[i], AccAddrs[i])
+	}
+	deposit := func(nonce uint64) *types.MsgSendToCosmosClaim {
+		return &types.MsgSendToCosmosClaim{
+			EventNonce:     nonce,
+			BlockHeight:    1,
+			TokenContract:  TokenContractAddrs[0],
+			Amount:         sdk.NewInt(100),
+			EthereumSender: EthAddrs[0].String(),
+			CosmosReceiver: AccAddrs[0].String(),
+			Orchestrator:   AccAddrs[0].String(),
+		}
+	}
+	erc20Deployed := &types.MsgERC20DeployedClaim{
+		EventNonce:    2,
+		BlockHeight:   1,
+		CosmosDenom:   "stake",
+		TokenContract: TokenContractAddrs[1],
+		Name:          "Stake",
+		Symbol:        "STK",
+		Orchestrator:  AccAddrs[0].String(),
+	}
+
+	// the third claim skips a nonce and fails, the first two are attested
+	msg, err := types.NewMsgSubmitClaims(AccAddrs[0], []types.EthereumClaim{deposit(1), erc20Deployed, deposit(4)})
+	require.NoError(t, err)
+	res, err := NewMsgServerImpl(k).SubmitClaims(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	require.Len(t, res.Results, 3)
+	require.Zero(t, res.Results[0].Code)
+	require.Zero(t, res.Results[1].Code)
+	require.Equal(t, types.ErrNonContiguousEventNonce.ABCICode(), res.Results[2].Code)
+	require.Equal(t, uint64(2), k.GetLastEventNonceByValidator(ctx, ValAddrs[0]))
+	require.Len(t, k.GetAttestationMapping(ctx), 2)
+
+	// claims must be in event nonce order
+	msg, err = types.NewMsgSubmitClaims(AccAddrs[0], []types.EthereumClaim{deposit(4), deposit(3)})
+	require.NoError(t, err)
+	require.Error(t, msg.ValidateBasic())
+}
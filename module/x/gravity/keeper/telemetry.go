@@ -0,0 +1,194 @@
+package keeper
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+
+	metrics "github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// The names of the metrics the module reports, every name is prefixed with the module name and every
+// metric is labeled with the bridge chain id of the chain it is about
+const (
+	MetricUnbatchedTransfers     = "unbatched_transfers"
+	MetricUnbatchedValue         = "unbatched_value"
+	MetricOutstandingBatches     = "outstanding_batches"
+	MetricOldestBatchAge         = "oldest_batch_age_blocks"
+	MetricPendingValsets         = "pending_valsets"
+	MetricLastObservedEventNonce = "last_observed_event_nonce"
+	MetricLastObservedEthHeight  = "last_observed_ethereum_height"
+	MetricValidatorEventNonceLag = "validator_event_nonce_lag"
+	MetricAttestationLatency     = "attestation_observation_latency_blocks"
+	MetricTimedOutBatches        = "timed_out_batches"
+	MetricDepositsCredited       = "deposits_credited"
+	MetricDepositsCreditedValue  = "deposits_credited_value"
+	MetricWithdrawalsExecuted    = "withdrawals_executed"
+	MetricWithdrawalsValue       = "withdrawals_executed_value"
+
+	MetricLabelBridgeChainID = "bridge_chain_id"
+	MetricLabelTokenContract = "token_contract"
+	MetricLabelValidator     = "validator"
+)
+
+// BridgeHealthMetricsInterval is the number of blocks between two reports of the bridge health gauges, a
+// report scans the pool, the outstanding batches and the valsets with their confirms
+const BridgeHealthMetricsInterval = 10
+
+// gaugeLabels holds, by bridge chain id and label name, the label values a per token or per validator gauge
+// was last set for. It is only kept in memory, it does not need to survive a restart since the metrics do not.
+type gaugeLabels map[string]map[string]bool
+
+// ReportBridgeHealthMetrics sets the gauges describing the state of the chain the keeper is scoped to: the
+// transfers waiting in the pool, the batches and valsets waiting on Ethereum, the last observed event and
+// how far behind each bonded validator is in reporting events. The gauges of a token that left the pool or
+// of a validator that left the bonded set are set back to zero. It is called at the end of every
+// BridgeHealthMetricsInterval blocks.
+func (k Keeper) ReportBridgeHealthMetrics(ctx sdk.Context) {
+	unbatchedCount := make(map[string]float32)
+	unbatchedValue := make(map[string]sdk.Int)
+	k.IterateUnbatchedTransactions(ctx, types.OutgoingTXPoolKey, func(_ []byte, tx *types.InternalOutgoingTransferTx) bool {
+		contract := tx.Erc20Token.Contract.GetAddress()
+		if _, ok := unbatchedValue[contract]; !ok {
+			unbatchedValue[contract] = sdk.ZeroInt()
+		}
+		unbatchedCount[contract]++
+		unbatchedValue[contract] = unbatchedValue[contract].Add(tx.Erc20Token.Amount)
+		return false
+	})
+	tokens := make(map[string]bool, len(unbatchedCount))
+	for contract, count := range unbatchedCount {
+		labels := k.metricLabels(ctx, telemetry.NewLabel(MetricLabelTokenContract, contract))
+		telemetry.SetGaugeWithLabels([]string{types.ModuleName, MetricUnbatchedTransfers}, count, labels)
+		telemetry.SetGaugeWithLabels([]string{types.ModuleName, MetricUnbatchedValue}, metricAmount(unbatchedValue[contract]), labels)
+		tokens[contract] = true
+	}
+	k.resetGoneGauges(ctx, MetricLabelTokenContract, tokens, MetricUnbatchedTransfers, MetricUnbatchedValue)
+
+	batches := k.GetOutgoingTxBatches(ctx)
+	var oldestBatchAge float32
+	for _, batch := range batches {
+		if age := float32(uint64(ctx.BlockHeight()) - batch.Block); age > oldestBatchAge {
+			oldestBatchAge = age
+		}
+	}
+	telemetry.SetGaugeWithLabels([]string{types.ModuleName, MetricOutstandingBatches}, float32(len(batches)), k.metricLabels(ctx))
+	telemetry.SetGaugeWithLabels([]string{types.ModuleName, MetricOldestBatchAge}, oldestBatchAge, k.metricLabels(ctx))
+	telemetry.SetGaugeWithLabels([]string{types.ModuleName, MetricPendingValsets}, float32(k.countPendingValsets(ctx)), k.metricLabels(ctx))
+
+	lastObservedNonce := k.GetLastObservedEventNonce(ctx)
+	telemetry.SetGaugeWithLabels([]string{types.ModuleName, MetricLastObservedEventNonce}, float32(lastObservedNonce), k.metricLabels(ctx))
+	telemetry.SetGaugeWithLabels([]string{types.ModuleName, MetricLastObservedEthHeight},
+		float32(k.GetLastObservedEthereumBlockHeight(ctx).EthereumBlockHeight), k.metricLabels(ctx))
+	validators := make(map[string]bool)
+	for _, validator := range k.StakingKeeper.GetBondedValidatorsByPower(ctx) {
+		var lag float32
+		if nonce := k.GetLastEventNonceByValidator(ctx, validator.GetOperator()); nonce < lastObservedNonce {
+			lag = float32(lastObservedNonce - nonce)
+		}
+		telemetry.SetGaugeWithLabels([]string{types.ModuleName, MetricValidatorEventNonceLag}, lag,
+			k.metricLabels(ctx, telemetry.NewLabel(MetricLabelValidator, validator.GetOperator().String())))
+		validators[validator.GetOperator().String()] = true
+	}
+	k.resetGoneGauges(ctx, MetricLabelValidator, validators, MetricValidatorEventNonceLag)
+}
+
+// resetGoneGauges sets the gauges named names back to zero for the values of label they were set for in the
+// last report but not in this one, and remembers the values of this report
+func (k Keeper) resetGoneGauges(ctx sdk.Context, label string, current map[string]bool, names ...string) {
+	if k.reportedGauges == nil {
+		return
+	}
+	key := strconv.FormatUint(k.GetBridgeChainID(ctx), 10) + "/" + label
+	for value := range k.reportedGauges[key] {
+		if current[value] {
+			continue
+		}
+		labels := k.metricLabels(ctx, telemetry.NewLabel(label, value))
+		for _, name := range names {
+			telemetry.SetGaugeWithLabels([]string{types.ModuleName, name}, 0, labels)
+		}
+	}
+	k.reportedGauges[key] = current
+}
+
+// countPendingValsets returns the number of valsets newer than the last one observed on Ethereum that are not
+// yet signed by members holding the two thirds of the power needed to submit them
+func (k Keeper) countPendingValsets(ctx sdk.Context) (pending int) {
+	var lastObservedNonce uint64
+	if lastObserved := k.GetLastObservedValset(ctx); lastObserved != nil {
+		lastObservedNonce = lastObserved.Nonce
+	}
+	k.IterateValsets(ctx, func(_ []byte, valset *types.Valset) bool {
+		if valset.Nonce <= lastObservedNonce {
+			return false
+		}
+		signed := make(map[string]bool)
+		for _, confirm := range k.GetValsetConfirms(ctx, valset.Nonce) {
+			signed[strings.ToLower(confirm.EthAddress)] = true
+		}
+		var totalPower, signedPower uint64
+		for _, member := range valset.Members {
+			totalPower += member.Power
+			if signed[strings.ToLower(member.EthereumAddress)] {
+				signedPower += member.Power
+			}
+		}
+		if signedPower*3 < totalPower*2 {
+			pending++
+		}
+		return false
+	})
+	return
+}
+
+// recordAttestationObserved samples how many blocks an attestation took to be observed since its first vote
+func (k Keeper) recordAttestationObserved(ctx sdk.Context, att *types.Attestation) {
+	// attestations imported from genesis may have been voted for at a later height of the exported chain
+	if att.Height > uint64(ctx.BlockHeight()) {
+		return
+	}
+	metrics.AddSampleWithLabels([]string{types.ModuleName, MetricAttestationLatency},
+		float32(uint64(ctx.BlockHeight())-att.Height), k.metricLabels(ctx))
+}
+
+// RecordBatchTimedOut counts a batch canceled because it timed out on Ethereum
+func (k Keeper) RecordBatchTimedOut(ctx sdk.Context, batch types.InternalOutgoingTxBatch) {
+	telemetry.IncrCounterWithLabels([]string{types.ModuleName, MetricTimedOutBatches}, 1,
+		k.metricLabels(ctx, telemetry.NewLabel(MetricLabelTokenContract, batch.TokenContract.GetAddress())))
+}
+
+// recordDepositCredited counts a deposit credited to its Cosmos receiver and its amount
+func (k Keeper) recordDepositCredited(ctx sdk.Context, tokenContract types.EthAddress, amount sdk.Int) {
+	labels := k.metricLabels(ctx, telemetry.NewLabel(MetricLabelTokenContract, tokenContract.GetAddress()))
+	telemetry.IncrCounterWithLabels([]string{types.ModuleName, MetricDepositsCredited}, 1, labels)
+	telemetry.IncrCounterWithLabels([]string{types.ModuleName, MetricDepositsCreditedValue}, metricAmount(amount), labels)
+}
+
+// recordBatchExecuted counts the transfers of a batch executed on Ethereum and their amount
+func (k Keeper) recordBatchExecuted(ctx sdk.Context, batch types.InternalOutgoingTxBatch) {
+	value := sdk.ZeroInt()
+	for _, tx := range batch.Transactions {
+		value = value.Add(tx.Erc20Token.Amount)
+	}
+	labels := k.metricLabels(ctx, telemetry.NewLabel(MetricLabelTokenContract, batch.TokenContract.GetAddress()))
+	telemetry.IncrCounterWithLabels([]string{types.ModuleName, MetricWithdrawalsExecuted}, float32(len(batch.Transactions)), labels)
+	telemetry.IncrCounterWithLabels([]string{types.ModuleName, MetricWithdrawalsValue}, metricAmount(value), labels)
+}
+
+// metricLabels returns the labels of a metric about the chain the keeper is scoped to
+func (k Keeper) metricLabels(ctx sdk.Context, labels ...metrics.Label) []metrics.Label {
+	chainID := telemetry.NewLabel(MetricLabelBridgeChainID, strconv.FormatUint(k.GetBridgeChainID(ctx), 10))
+	return append([]metrics.Label{chainID}, labels...)
+}
+
+// metricAmount converts a token amount to the float32 metrics are reported in, amounts beyond its range
+// lose precision
+func metricAmount(amount sdk.Int) float32 {
+	f, _ := new(big.Float).SetInt(amount.BigInt()).Float32()
+	return f
+}
@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	metrics "github.com/armon/go-metrics"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// inmemMetrics sends the metrics reported during the test to an in memory sink
+func inmemMetrics(t *testing.T) *metrics.InmemSink {
+	sink := metrics.NewInmemSink(time.Minute, time.Minute)
+	cfg := metrics.DefaultConfig("")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+	_, err := metrics.NewGlobal(cfg, sink)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, _ = metrics.NewGlobal(cfg, &metrics.BlackholeSink{})
+	})
+	return sink
+}
+
+//nolint: exhaustivestruct
+func TestReportBridgeHealthMetrics(t *testing.T) {
+	sink := inmemMetrics(t)
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	chainLabel := fmt.Sprintf("%s=%d", MetricLabelBridgeChainID, k.GetBridgeChainID(ctx))
+
+	// a deposit is credited and counted
+	claim := types.MsgSendToCosmosClaim{
+		EventNonce:     1,
+		BlockHeight:    1,
+		TokenContract:  TokenContractAddrs[0],
+		Amount:         sdk.NewInt(500),
+		EthereumSender: EthAddrs[0].String(),
+		CosmosReceiver: AccAddrs[0].String(),
+		Orchestrator:   AccAddrs[0].String(),
+	}
+	require.NoError(t, k.AttestationHandler.Handle(ctx, types.Attestation{}, &claim))
+	tokenContract, err := types.NewEthAddress(TokenContractAddrs[0])
+	require.NoError(t, err)
+	tokenLabel := fmt.Sprintf("%s=%s", MetricLabelTokenContract, tokenContract.GetAddress())
+	denom := types.GravityDenom(*tokenContract)
+
+	// two transfers wait in the pool
+	receiver, err := types.NewEthAddress(EthAddrs[1].String())
+	require.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		_, err := k.AddToOutgoingPool(ctx, AccAddrs[0], *receiver, sdk.NewInt64Coin(denom, 100), sdk.NewInt64Coin(denom, 10))
+		require.NoError(t, err)
+	}
+
+	// the first validator is one event behind
+	k.setLastObservedEventNonce(ctx, 2)
+	for i := range ValAddrs {
+		k.setLastEventNonceByValidator(ctx, ValAddrs[i], 2)
+	}
+	k.setLastEventNonceByValidator(ctx, ValAddrs[0], 1)
+
+	// nobody signed the valset request yet
+	for i := range ValAddrs {
+		ethAddr, err := types.NewEthAddress(EthAddrs[i].String())
+		require.NoError(t, err)
+		k.SetEthAddressForValidator(ctx, ValAddrs[i], *ethAddr)
+	}
+	k.SetValsetRequest(ctx)
+
+	k.ReportBridgeHealthMetrics(ctx)
+	data := sink.Data()
+	require.NotEmpty(t, data)
+	gauge := func(name string, labels ...string) float32 {
+		key := types.ModuleName + "." + name + ";" + chainLabel
+		for _, label := range labels {
+			key += ";" + label
+		}
+		value, ok := data[0].Gauges[key]
+		require.True(t, ok, "no gauge %s", key)
+		return value.Value
+	}
+	require.Equal(t, float32(2), gauge(MetricUnbatchedTransfers, tokenLabel))
+	require.Equal(t, float32(200), gauge(MetricUnbatchedValue, tokenLabel))
+	require.Equal(t, float32(0), gauge(MetricOutstandingBatches))
+	require.Equal(t, float32(1), gauge(MetricPendingValsets))
+	require.Equal(t, float32(2), gauge(MetricLastObservedEventNonce))
+	require.Equal(t, float32(1), gauge(MetricValidatorEventNonceLag, MetricLabelValidator+"="+ValAddrs[0].String()))
+	require.Equal(t, float32(0), gauge(MetricValidatorEventNonceLag, MetricLabelValidator+"="+ValAddrs[1].String()))
+
+	credited := data[0].Counters[types.ModuleName+"."+MetricDepositsCreditedValue+";"+chainLabel+";"+tokenLabel]
+	require.Equal(t, float64(500), credited.Sum)
+
+	// once the pool is drained and the validator left the bonded set their gauges go back to zero
+	for _, tx := range k.GetUnbatchedTransactions(ctx) {
+		require.NoError(t, k.RemoveFromOutgoingPoolAndRefund(ctx, tx.Id, AccAddrs[0]))
+	}
+	k.StakingKeeper = NewStakingKeeperMock(ValAddrs[1:]...)
+	k.ReportBridgeHealthMetrics(ctx)
+	data = sink.Data()
+	require.Equal(t, float32(0), gauge(MetricUnbatchedTransfers, tokenLabel))
+	require.Equal(t, float32(0), gauge(MetricUnbatchedValue, tokenLabel))
+	require.Equal(t, float32(0), gauge(MetricValidatorEventNonceLag, MetricLabelValidator+"="+ValAddrs[0].String()))
+}
@@ -21,6 +21,8 @@ import (
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	authzmod "github.com/cosmos/cosmos-sdk/x/authz/module"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -31,6 +33,8 @@ import (
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/evidence"
+	evidencekeeper "github.com/cosmos/cosmos-sdk/x/evidence/keeper"
+	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
@@ -78,6 +82,7 @@ var (
 		upgrade.AppModuleBasic{},
 		evidence.AppModuleBasic{},
 		vesting.AppModuleBasic{},
+		authzmod.AppModuleBasic{},
 	)
 
 	// Ensure that StakingKeeperMock implements required interface
@@ -186,24 +191,39 @@ var (
 
 	// TestingGravityParams is a set of gravity params for testing
 	TestingGravityParams = types.Params{
-		GravityId:                    "testgravityid",
-		MinimumTransferToEth:         sdk.NewInt(5),
-		MinimumFeeTransferToEth:      sdk.NewInt(5),
-		ContractSourceHash:           "62328f7bc12efb28f86111d08c29b39285680a906ea0e524e0209d6f6657b713",
-		BridgeEthereumAddress:        "0x8858eeb3dfffa017d4bce9801d340d36cf895ccf",
-		BridgeChainId:                11,
-		SignedValsetsWindow:          10,
-		SignedBatchesWindow:          10,
-		SignedLogicCallsWindow:       10,
-		TargetBatchTimeout:           60001,
-		AverageBlockTime:             5000,
-		AverageEthereumBlockTime:     15000,
-		SlashFractionValset:          sdk.NewDecWithPrec(1, 2),
-		SlashFractionBatch:           sdk.NewDecWithPrec(1, 2),
-		SlashFractionLogicCall:       sdk.Dec{},
-		UnbondSlashingValsetsWindow:  15,
-		SlashFractionBadEthSignature: sdk.NewDecWithPrec(1, 2),
-		ValsetReward:                 sdk.Coin{Denom: "", Amount: sdk.ZeroInt()},
+		GravityId:                      "testgravityid",
+		MinimumTransferToEth:           sdk.NewInt(5),
+		MinimumFeeTransferToEth:        sdk.NewInt(5),
+		ContractSourceHash:             "62328f7bc12efb28f86111d08c29b39285680a906ea0e524e0209d6f6657b713",
+		BridgeEthereumAddress:          "0x8858eeb3dfffa017d4bce9801d340d36cf895ccf",
+		BridgeChainId:                  11,
+		SignedValsetsWindow:            10,
+		SignedBatchesWindow:            10,
+		SignedLogicCallsWindow:         10,
+		TargetBatchTimeout:             60001,
+		AverageBlockTime:               5000,
+		AverageEthereumBlockTime:       15000,
+		SlashFractionValset:            sdk.NewDecWithPrec(1, 2),
+		SlashFractionBatch:             sdk.NewDecWithPrec(1, 2),
+		SlashFractionLogicCall:         sdk.Dec{},
+		UnbondSlashingValsetsWindow:    15,
+		SlashFractionBadEthSignature:   sdk.NewDecWithPrec(1, 2),
+		ValsetReward:                   sdk.Coin{Denom: "", Amount: sdk.ZeroInt()},
+		SlashFractionConflictingClaim:  sdk.ZeroDec(),
+		SignedClaimsWindow:             10,
+		SlashFractionClaim:             sdk.NewDecWithPrec(1, 2),
+		JailMissedClaims:               true,
+		BadEthSignatureRewardFraction:  sdk.NewDecWithPrec(1, 1),
+		TransferHistoryRetention:       100,
+		DepositReceiptRetention:        100,
+		RefundFailedDeposits:           true,
+		BatchSelectionPolicy:           types.BATCH_SELECTION_POLICY_FEE_PRIORITY,
+		BatchMinimumFees:               sdk.Coins{},
+		BatchGuaranteedInclusionBlocks: 0,
+		EvmChains:                      []types.EvmChain{},
+		ProtocolFeeBasisPoints:         0,
+		ProtocolFeeMinimums:            sdk.Coins{},
+		ProtocolFeeTreasury:            "",
 	}
 )
 
@@ -216,6 +236,8 @@ type TestInput struct {
 	DistKeeper     distrkeeper.Keeper
 	BankKeeper     bankkeeper.BaseKeeper
 	GovKeeper      govkeeper.Keeper
+	EvidenceKeeper evidencekeeper.Keeper
+	AuthzKeeper    authzkeeper.Keeper
 	Context        sdk.Context
 	Marshaler      codec.Codec
 	LegacyAmino    *codec.LegacyAmino
@@ -289,6 +311,8 @@ func CreateTestEnv(t *testing.T) TestInput {
 	tkeyParams := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
 	keyGov := sdk.NewKVStoreKey(govtypes.StoreKey)
 	keySlashing := sdk.NewKVStoreKey(slashingtypes.StoreKey)
+	keyEvidence := sdk.NewKVStoreKey(evidencetypes.StoreKey)
+	keyAuthz := sdk.NewKVStoreKey(authzkeeper.StoreKey)
 
 	// Initialize memory database and mount stores on it
 	db := dbm.NewMemDB()
@@ -302,6 +326,8 @@ func CreateTestEnv(t *testing.T) TestInput {
 	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
 	ms.MountStoreWithDB(keyGov, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keySlashing, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyEvidence, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyAuthz, sdk.StoreTypeIAVL, db)
 	err := ms.LoadLatestVersion()
 	require.Nil(t, err)
 
@@ -445,7 +471,9 @@ func CreateTestEnv(t *testing.T) TestInput {
 		getSubspace(paramsKeeper, slashingtypes.ModuleName).WithKeyTable(slashingtypes.ParamKeyTable()),
 	)
 
-	k := NewKeeper(marshaler, gravityKey, getSubspace(paramsKeeper, types.DefaultParamspace), stakingKeeper, bankKeeper, slashingKeeper)
+	evidenceKeeper := evidencekeeper.NewKeeper(marshaler, keyEvidence, &stakingKeeper, slashingKeeper)
+
+	k := NewKeeper(marshaler, gravityKey, getSubspace(paramsKeeper, types.DefaultParamspace), stakingKeeper, bankKeeper, slashingKeeper, evidenceKeeper, distKeeper)
 
 	stakingKeeper = *stakingKeeper.SetHooks(
 		stakingtypes.NewMultiStakingHooks(
@@ -455,6 +483,12 @@ func CreateTestEnv(t *testing.T) TestInput {
 		),
 	)
 
+	// authz executes the gravity messages granted to a grantee through the msg server
+	msgServiceRouter := baseapp.NewMsgServiceRouter()
+	msgServiceRouter.SetInterfaceRegistry(marshaler.(codec.ProtoCodecMarshaler).InterfaceRegistry())
+	types.RegisterMsgServer(msgServiceRouter, NewMsgServerImpl(k))
+	authzKeeper := authzkeeper.NewKeeper(keyAuthz, marshaler, msgServiceRouter)
+
 	k.SetParams(ctx, TestingGravityParams)
 	params := k.GetParams(ctx)
 
@@ -468,6 +502,8 @@ func CreateTestEnv(t *testing.T) TestInput {
 		SlashingKeeper: slashingKeeper,
 		DistKeeper:     distKeeper,
 		GovKeeper:      govKeeper,
+		EvidenceKeeper: *evidenceKeeper,
+		AuthzKeeper:    authzKeeper,
 		Context:        ctx,
 		Marshaler:      marshaler,
 		LegacyAmino:    cdc,
@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// recordTransferStatus appends a step to the history of a transfer to Ethereum. Transfers that were
+// already in flight when the history was introduced get a history starting at this step. Once a
+// transfer is executed or canceled its history is pruned after TransferHistoryRetention blocks.
+func (k Keeper) recordTransferStatus(ctx sdk.Context, tx types.OutgoingTransferTx, update types.TransferStatusUpdate) {
+	history := k.GetTransferHistory(ctx, tx.Id)
+	if history == nil {
+		history = &types.TransferHistory{Transfer: tx}
+	}
+	update.Height = ctx.BlockHeight()
+	history.Updates = append(history.Updates, update)
+	if update.Status == types.TRANSFER_STATUS_EXECUTED || update.Status == types.TRANSFER_STATUS_CANCELED {
+		history.CompletedHeight = ctx.BlockHeight()
+	}
+	k.SetTransferHistory(ctx, *history)
+}
+
+// recordBatchStatus appends the same step to the history of every transfer in a batch
+func (k Keeper) recordBatchStatus(ctx sdk.Context, batch types.InternalOutgoingTxBatch, update types.TransferStatusUpdate) {
+	update.BatchNonce = batch.BatchNonce
+	for _, tx := range batch.Transactions {
+		k.recordTransferStatus(ctx, *tx.ToExternal(), update)
+	}
+}
+
+// SetTransferHistory stores the history of a transfer along with its sender index and, once the
+// transfer is completed, its pruning index
+func (k Keeper) SetTransferHistory(ctx sdk.Context, history types.TransferHistory) {
+	sender, err := sdk.AccAddressFromBech32(history.Transfer.Sender)
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "invalid sender in transfer history"))
+	}
+	store := k.store(ctx)
+	store.Set(types.GetTransferHistoryKey(history.Transfer.Id), k.cdc.MustMarshal(&history))
+	store.Set(types.GetTransferHistoryBySenderKey(sender, history.Transfer.Id), []byte{})
+	if history.CompletedHeight != 0 {
+		store.Set(types.GetTransferHistoryByCompletedHeightKey(history.CompletedHeight, history.Transfer.Id), []byte{})
+	}
+}
+
+// GetTransferHistory returns the history of a transfer, nil if there is none
+func (k Keeper) GetTransferHistory(ctx sdk.Context, txID uint64) *types.TransferHistory {
+	bz := k.store(ctx).Get(types.GetTransferHistoryKey(txID))
+	if bz == nil {
+		return nil
+	}
+	var history types.TransferHistory
+	k.cdc.MustUnmarshal(bz, &history)
+	return &history
+}
+
+// DeleteTransferHistory removes the history of a transfer and its indexes
+func (k Keeper) DeleteTransferHistory(ctx sdk.Context, history types.TransferHistory) {
+	sender, err := sdk.AccAddressFromBech32(history.Transfer.Sender)
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "invalid sender in transfer history"))
+	}
+	store := k.store(ctx)
+	store.Delete(types.GetTransferHistoryKey(history.Transfer.Id))
+	store.Delete(types.GetTransferHistoryBySenderKey(sender, history.Transfer.Id))
+	if history.CompletedHeight != 0 {
+		store.Delete(types.GetTransferHistoryByCompletedHeightKey(history.CompletedHeight, history.Transfer.Id))
+	}
+}
+
+// IterateTransferHistories iterates through all transfer histories in ASC tx id order
+func (k Keeper) IterateTransferHistories(ctx sdk.Context, cb func(key []byte, history *types.TransferHistory) bool) {
+	prefixStore := prefix.NewStore(k.store(ctx), types.TransferHistoryKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var history types.TransferHistory
+		k.cdc.MustUnmarshal(iter.Value(), &history)
+		// cb returns true to stop early
+		if cb(iter.Key(), &history) {
+			break
+		}
+	}
+}
+
+// GetTransferHistories returns the history of every transfer that has not been pruned
+func (k Keeper) GetTransferHistories(ctx sdk.Context) (out []*types.TransferHistory) {
+	k.IterateTransferHistories(ctx, func(_ []byte, history *types.TransferHistory) bool {
+		out = append(out, history)
+		return false
+	})
+	return
+}
+
+// GetTransferHistoriesBySender returns the history of every transfer of a sender that has not been
+// pruned, in ASC tx id order
+func (k Keeper) GetTransferHistoriesBySender(ctx sdk.Context, sender sdk.AccAddress) (out []*types.TransferHistory) {
+	prefixStore := prefix.NewStore(k.store(ctx), types.GetTransferHistoryBySenderPrefix(sender))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		history := k.GetTransferHistory(ctx, types.UInt64FromBytes(iter.Key()))
+		if history == nil {
+			panic("transfer history sender index points at a missing history")
+		}
+		out = append(out, history)
+	}
+	return
+}
+
+// PruneTransferHistories deletes the history of every transfer that was executed or canceled more than
+// TransferHistoryRetention blocks ago
+func (k Keeper) PruneTransferHistories(ctx sdk.Context) {
+	retention := k.GetParams(ctx).TransferHistoryRetention
+	if uint64(ctx.BlockHeight()) <= retention {
+		return
+	}
+	cutoff := uint64(ctx.BlockHeight()) - retention
+
+	var txIDs []uint64
+	prefixStore := prefix.NewStore(k.store(ctx), types.TransferHistoryByCompletedHeightKey)
+	iter := prefixStore.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		// the key is the completed height followed by the tx id
+		if types.UInt64FromBytes(iter.Key()[:8]) > cutoff {
+			break
+		}
+		txIDs = append(txIDs, types.UInt64FromBytes(iter.Key()[8:]))
+	}
+	iter.Close()
+
+	for _, txID := range txIDs {
+		history := k.GetTransferHistory(ctx, txID)
+		if history == nil {
+			panic("transfer history pruning index points at a missing history")
+		}
+		k.DeleteTransferHistory(ctx, *history)
+	}
+}
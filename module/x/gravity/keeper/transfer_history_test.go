@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// historyStatuses returns the statuses a transfer went through in order
+func historyStatuses(history *types.TransferHistory) []types.TransferStatus {
+	var statuses []types.TransferStatus
+	for _, update := range history.Updates {
+		statuses = append(statuses, update.Status)
+	}
+	return statuses
+}
+
+func TestTransferHistoryLifecycle(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	var (
+		mySender, _            = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver, _          = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr, _ = types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		token, err             = types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr.GetAddress())
+		allVouchers            = sdk.NewCoins(token.GravityCoin())
+	)
+	require.NoError(t, err)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
+
+	var txIDs []uint64
+	for i := 1; i <= 2; i++ {
+		amount, err := types.NewInternalERC20Token(sdk.NewInt(int64(100*i)), myTokenContractAddr.GetAddress())
+		require.NoError(t, err)
+		fee, err := types.NewInternalERC20Token(sdk.NewInt(int64(i)), myTokenContractAddr.GetAddress())
+		require.NoError(t, err)
+		txID, err := k.AddToOutgoingPool(ctx, mySender, *myReceiver, amount.GravityCoin(), fee.GravityCoin())
+		require.NoError(t, err)
+		txIDs = append(txIDs, txID)
+	}
+	history := k.GetTransferHistory(ctx, txIDs[0])
+	require.NotNil(t, history)
+	require.Equal(t, txIDs[0], history.Transfer.Id)
+	require.Equal(t, []types.TransferStatus{types.TRANSFER_STATUS_QUEUED}, historyStatuses(history))
+
+	// the second transfer is canceled by its sender
+	require.NoError(t, k.RemoveFromOutgoingPoolAndRefund(ctx, txIDs[1], mySender))
+	history = k.GetTransferHistory(ctx, txIDs[1])
+	require.Equal(t, []types.TransferStatus{types.TRANSFER_STATUS_QUEUED, types.TRANSFER_STATUS_CANCELED}, historyStatuses(history))
+	require.Equal(t, ctx.BlockHeight(), history.CompletedHeight)
+
+	// the first is batched, the batch times out and the transfer is batched again
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	batch, err := k.BuildOutgoingTXBatch(ctx, *myTokenContractAddr, 10)
+	require.NoError(t, err)
+	k.SetLastObservedEthereumBlockHeight(ctx, batch.BatchTimeout+1)
+	require.NoError(t, k.CancelOutgoingTXBatch(ctx, *myTokenContractAddr, batch.BatchNonce))
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	batch, err = k.BuildOutgoingTXBatch(ctx, *myTokenContractAddr, 10)
+	require.NoError(t, err)
+
+	// and executed
+	k.OutgoingTxBatchExecuted(ctx, *myTokenContractAddr, batch.BatchNonce, 1234)
+	history = k.GetTransferHistory(ctx, txIDs[0])
+	require.Equal(t, []types.TransferStatus{
+		types.TRANSFER_STATUS_QUEUED,
+		types.TRANSFER_STATUS_BATCHED,
+		types.TRANSFER_STATUS_BATCH_TIMED_OUT,
+		types.TRANSFER_STATUS_BATCHED,
+		types.TRANSFER_STATUS_EXECUTED,
+	}, historyStatuses(history))
+	executed := history.Updates[len(history.Updates)-1]
+	require.Equal(t, batch.BatchNonce, executed.BatchNonce)
+	require.Equal(t, uint64(1234), executed.EthereumHeight)
+	require.Equal(t, ctx.BlockHeight(), executed.Height)
+	require.Equal(t, ctx.BlockHeight(), history.CompletedHeight)
+
+	bySender, err := k.TransferHistoryBySender(sdk.WrapSDKContext(ctx), &types.QueryTransferHistoryBySenderRequest{Sender: mySender.String()})
+	require.NoError(t, err)
+	require.Len(t, bySender.Histories, 2)
+	require.Equal(t, txIDs[0], bySender.Histories[0].Transfer.Id)
+	require.Equal(t, txIDs[1], bySender.Histories[1].Transfer.Id)
+
+	// the canceled transfer completed first and is pruned first
+	retention := int64(k.GetParams(ctx).TransferHistoryRetention)
+	canceledAt := k.GetTransferHistory(ctx, txIDs[1]).CompletedHeight
+	k.PruneTransferHistories(ctx.WithBlockHeight(canceledAt + retention - 1))
+	require.NotNil(t, k.GetTransferHistory(ctx, txIDs[1]))
+	k.PruneTransferHistories(ctx.WithBlockHeight(canceledAt + retention))
+	require.Nil(t, k.GetTransferHistory(ctx, txIDs[1]))
+	require.NotNil(t, k.GetTransferHistory(ctx, txIDs[0]))
+	require.Len(t, k.GetTransferHistoriesBySender(ctx, mySender), 1)
+
+	k.PruneTransferHistories(ctx.WithBlockHeight(ctx.BlockHeight() + retention))
+	require.Empty(t, k.GetTransferHistories(ctx))
+	require.Empty(t, k.GetTransferHistoriesBySender(ctx, mySender))
+}
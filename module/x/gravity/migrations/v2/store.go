@@ -0,0 +1,134 @@
+package v2
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// The values the params added in v2 take on chains upgrading from v1. These are pinned here
+// rather than read from DefaultParams so that later changes to the defaults do not change
+// what this migration does.
+var (
+	SlashFractionConflictingClaim  = sdk.ZeroDec()
+	SignedClaimsWindow             = uint64(10000)
+	SlashFractionClaim             = sdk.ZeroDec()
+	JailMissedClaims               = false
+	BadEthSignatureRewardFraction  = sdk.NewDec(1).Quo(sdk.NewDec(10))
+	TransferHistoryRetention       = uint64(120960)
+	DepositReceiptRetention        = uint64(120960)
+	RefundFailedDeposits           = true
+	BatchSelectionPolicy           = types.BATCH_SELECTION_POLICY_FEE_PRIORITY
+	BatchMinimumFees               = sdk.Coins{}
+	BatchGuaranteedInclusionBlocks = uint64(0)
+	EvmChains                      = []types.EvmChain{}
+	ProtocolFeeBasisPoints         = uint64(0)
+	ProtocolFeeMinimums            = sdk.Coins{}
+	ProtocolFeeTreasury            = ""
+)
+
+// MigrateStore performs the in-place store migration from ConsensusVersion 1 to 2:
+// the params added in v2 are set, GetParams panics on any missing param, claim
+// slashing is started at the last observed event nonce and the transfers in flight
+// are recorded as queued at the upgrade height.
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryCodec, paramSpace paramtypes.Subspace) error {
+	migrateParams(ctx, paramSpace)
+	migrateLastSlashedClaimNonce(ctx.KVStore(storeKey))
+	return migrateQueuedTransferHeights(ctx, ctx.KVStore(storeKey), cdc)
+}
+
+// migrateParams sets every param added in v2 that is not in the store yet, params that are
+// already set are left alone
+func migrateParams(ctx sdk.Context, paramSpace paramtypes.Subspace) {
+	newParams := []struct {
+		key   []byte
+		value interface{}
+	}{
+		{types.ParamStoreSlashFractionConflictingClaim, SlashFractionConflictingClaim},
+		{types.ParamsStoreKeySignedClaimsWindow, SignedClaimsWindow},
+		{types.ParamsStoreSlashFractionClaim, SlashFractionClaim},
+		{types.ParamsStoreJailMissedClaims, JailMissedClaims},
+		{types.ParamsStoreBadEthSignatureRewardFraction, BadEthSignatureRewardFraction},
+		{types.ParamsStoreTransferHistoryRetention, TransferHistoryRetention},
+		{types.ParamsStoreDepositReceiptRetention, DepositReceiptRetention},
+		{types.ParamsStoreRefundFailedDeposits, RefundFailedDeposits},
+		{types.ParamsStoreBatchSelectionPolicy, BatchSelectionPolicy},
+		{types.ParamsStoreBatchMinimumFees, BatchMinimumFees},
+		{types.ParamsStoreBatchGuaranteedInclusionBlocks, BatchGuaranteedInclusionBlocks},
+		{types.ParamsStoreEvmChains, EvmChains},
+		{types.ParamsStoreProtocolFeeBasisPoints, ProtocolFeeBasisPoints},
+		{types.ParamsStoreProtocolFeeMinimums, ProtocolFeeMinimums},
+		{types.ParamsStoreProtocolFeeTreasury, ProtocolFeeTreasury},
+	}
+	for _, p := range newParams {
+		if !paramSpace.Has(ctx, p.key) {
+			paramSpace.Set(ctx, p.key, p.value)
+		}
+	}
+}
+
+// migrateLastSlashedClaimNonce starts claim slashing at the last observed event nonce. v1 stores
+// have no LastSlashedClaimNonce, left at zero every static validator would be slashed for every
+// observed attestation still in the store that it did not vote on, long after the fact.
+func migrateLastSlashedClaimNonce(store sdk.KVStore) {
+	if store.Has(types.LastSlashedClaimNonce) {
+		return
+	}
+	lastObserved := store.Get(types.LastObservedEventNonceKey)
+	if len(lastObserved) == 0 {
+		return
+	}
+	store.Set(types.LastSlashedClaimNonce, lastObserved)
+}
+
+// migrateQueuedTransferHeights records the transfers in the pool or in a batch as queued at the upgrade
+// height. v1 stores do not record when a transfer was queued, counted from height zero every transfer
+// would become overdue for guaranteed inclusion at once.
+func migrateQueuedTransferHeights(ctx sdk.Context, store sdk.KVStore, cdc codec.BinaryCodec) error {
+	var transfers []types.OutgoingTransferTx
+	poolIter := sdk.KVStorePrefixIterator(store, types.OutgoingTXPoolKey)
+	defer poolIter.Close()
+	for ; poolIter.Valid(); poolIter.Next() {
+		var tx types.OutgoingTransferTx
+		if err := cdc.Unmarshal(poolIter.Value(), &tx); err != nil {
+			return err
+		}
+		transfers = append(transfers, tx)
+	}
+	batchIter := sdk.KVStorePrefixIterator(store, types.OutgoingTXBatchKey)
+	defer batchIter.Close()
+	for ; batchIter.Valid(); batchIter.Next() {
+		var batch types.OutgoingTxBatch
+		if err := cdc.Unmarshal(batchIter.Value(), &batch); err != nil {
+			return err
+		}
+		for _, tx := range batch.Transactions {
+			transfers = append(transfers, *tx)
+		}
+	}
+
+	for _, tx := range transfers {
+		tokenContract, err := types.NewEthAddress(tx.Erc20Fee.Contract)
+		if err != nil {
+			return err
+		}
+		key := types.GetQueuedTransferHeightKey(*tokenContract, tx.Id)
+		if store.Has(key) {
+			continue
+		}
+		queued := types.QueuedTransferHeight{
+			TxId:          tx.Id,
+			Height:        ctx.BlockHeight(),
+			TokenContract: tokenContract.GetAddress(),
+			Fee:           tx.Erc20Fee.Amount,
+		}
+		bz, err := cdc.Marshal(&queued)
+		if err != nil {
+			return err
+		}
+		store.Set(key, bz)
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	v2 "github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/migrations/v2"
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// newParamsKeys lists the params that do not exist in a v1 store
+var newParamsKeys = [][]byte{
+	types.ParamStoreSlashFractionConflictingClaim,
+	types.ParamsStoreKeySignedClaimsWindow,
+	types.ParamsStoreSlashFractionClaim,
+	types.ParamsStoreJailMissedClaims,
+	types.ParamsStoreBadEthSignatureRewardFraction,
+	types.ParamsStoreTransferHistoryRetention,
+	types.ParamsStoreDepositReceiptRetention,
+	types.ParamsStoreRefundFailedDeposits,
+	types.ParamsStoreBatchSelectionPolicy,
+	types.ParamsStoreBatchMinimumFees,
+	types.ParamsStoreBatchGuaranteedInclusionBlocks,
+	types.ParamsStoreEvmChains,
+	types.ParamsStoreProtocolFeeBasisPoints,
+	types.ParamsStoreProtocolFeeMinimums,
+	types.ParamsStoreProtocolFeeTreasury,
+}
+
+var testCodec = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+// setupV1Store builds a store holding the v1 params, which lack every param in newParamsKeys
+func setupV1Store(t *testing.T) (sdk.Context, sdk.StoreKey, paramtypes.Subspace) {
+	gravityKey := sdk.NewKVStoreKey(types.StoreKey)
+	paramsKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	tParamsKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(gravityKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tParamsKey, sdk.StoreTypeTransient, db)
+	require.NoError(t, ms.LoadLatestVersion())
+	ctx := sdk.NewContext(ms, tmproto.Header{Height: 1234567}, false, log.NewNopLogger())
+
+	paramSpace := paramtypes.NewSubspace(testCodec, codec.NewLegacyAmino(), paramsKey, tParamsKey, types.DefaultParamspace).
+		WithKeyTable(types.ParamKeyTable())
+	paramSpace.SetParamSet(ctx, types.DefaultParams())
+
+	// the params subspace stores its values under the subspace name
+	paramStore := ctx.KVStore(paramsKey)
+	for _, key := range newParamsKeys {
+		paramStore.Delete(append([]byte(types.DefaultParamspace+"/"), key...))
+		require.False(t, paramSpace.Has(ctx, key))
+	}
+	return ctx, gravityKey, paramSpace
+}
+
+func TestMigrateParams(t *testing.T) {
+	ctx, gravityKey, paramSpace := setupV1Store(t)
+	// a v1 param is kept as it is
+	paramSpace.Set(ctx, types.ParamsStoreKeySignedValsetsWindow, uint64(42))
+
+	require.NoError(t, v2.MigrateStore(ctx, gravityKey, testCodec, paramSpace))
+
+	var params types.Params
+	require.NotPanics(t, func() { paramSpace.GetParamSet(ctx, &params) })
+	require.NoError(t, params.ValidateBasic())
+	require.Equal(t, uint64(42), params.SignedValsetsWindow)
+	require.Equal(t, types.DefaultParams().GravityId, params.GravityId)
+	require.Equal(t, v2.SlashFractionConflictingClaim, params.SlashFractionConflictingClaim)
+	require.Equal(t, v2.SignedClaimsWindow, params.SignedClaimsWindow)
+	require.Equal(t, v2.SlashFractionClaim, params.SlashFractionClaim)
+	require.Equal(t, v2.JailMissedClaims, params.JailMissedClaims)
+	require.Equal(t, v2.BadEthSignatureRewardFraction, params.BadEthSignatureRewardFraction)
+	require.Equal(t, v2.TransferHistoryRetention, params.TransferHistoryRetention)
+	require.Equal(t, v2.DepositReceiptRetention, params.DepositReceiptRetention)
+	require.Equal(t, v2.RefundFailedDeposits, params.RefundFailedDeposits)
+	require.Equal(t, v2.BatchSelectionPolicy, params.BatchSelectionPolicy)
+	require.Empty(t, params.BatchMinimumFees)
+	require.Equal(t, v2.BatchGuaranteedInclusionBlocks, params.BatchGuaranteedInclusionBlocks)
+	require.Empty(t, params.EvmChains)
+	require.Equal(t, v2.ProtocolFeeBasisPoints, params.ProtocolFeeBasisPoints)
+	require.Empty(t, params.ProtocolFeeMinimums)
+	require.Equal(t, v2.ProtocolFeeTreasury, params.ProtocolFeeTreasury)
+}
+
+func TestMigrateParamsKeepsExistingValues(t *testing.T) {
+	ctx, gravityKey, paramSpace := setupV1Store(t)
+	paramSpace.Set(ctx, types.ParamsStoreKeySignedClaimsWindow, uint64(7))
+
+	require.NoError(t, v2.MigrateStore(ctx, gravityKey, testCodec, paramSpace))
+
+	var window uint64
+	paramSpace.Get(ctx, types.ParamsStoreKeySignedClaimsWindow, &window)
+	require.Equal(t, uint64(7), window)
+}
+
+func TestMigrateLastSlashedClaimNonce(t *testing.T) {
+	ctx, gravityKey, paramSpace := setupV1Store(t)
+	store := ctx.KVStore(gravityKey)
+	store.Set(types.LastObservedEventNonceKey, types.UInt64Bytes(5))
+
+	require.NoError(t, v2.MigrateStore(ctx, gravityKey, testCodec, paramSpace))
+	require.Equal(t, uint64(5), types.UInt64FromBytes(store.Get(types.LastSlashedClaimNonce)))
+
+	// running the migration again changes nothing
+	store.Set(types.LastObservedEventNonceKey, types.UInt64Bytes(9))
+	require.NoError(t, v2.MigrateStore(ctx, gravityKey, testCodec, paramSpace))
+	require.Equal(t, uint64(5), types.UInt64FromBytes(store.Get(types.LastSlashedClaimNonce)))
+}
+
+func TestMigrateLastSlashedClaimNonceNothingObserved(t *testing.T) {
+	ctx, gravityKey, paramSpace := setupV1Store(t)
+
+	require.NoError(t, v2.MigrateStore(ctx, gravityKey, testCodec, paramSpace))
+	require.False(t, ctx.KVStore(gravityKey).Has(types.LastSlashedClaimNonce))
+}
+
+//nolint: exhaustivestruct
+func TestMigrateQueuedTransferHeights(t *testing.T) {
+	ctx, gravityKey, paramSpace := setupV1Store(t)
+	store := ctx.KVStore(gravityKey)
+	tokenContract, err := types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	require.NoError(t, err)
+	transfer := func(id uint64) *types.OutgoingTransferTx {
+		return &types.OutgoingTransferTx{
+			Id:          id,
+			Sender:      "cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn",
+			DestAddress: "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7",
+			Erc20Token:  &types.ERC20Token{Contract: tokenContract.GetAddress(), Amount: sdk.NewInt(100)},
+			Erc20Fee:    &types.ERC20Token{Contract: tokenContract.GetAddress(), Amount: sdk.NewInt(int64(id))},
+		}
+	}
+	pooled, err := transfer(1).ToInternal()
+	require.NoError(t, err)
+	store.Set(types.GetOutgoingTxPoolKey(*pooled.Erc20Fee, 1), testCodec.MustMarshal(transfer(1)))
+	batch := types.OutgoingTxBatch{BatchNonce: 1, TokenContract: tokenContract.GetAddress(), Transactions: []*types.OutgoingTransferTx{transfer(2)}}
+	store.Set(types.GetOutgoingTxBatchKey(*tokenContract, 1), testCodec.MustMarshal(&batch))
+
+	require.NoError(t, v2.MigrateStore(ctx, gravityKey, testCodec, paramSpace))
+	for _, id := range []uint64{1, 2} {
+		var queued types.QueuedTransferHeight
+		testCodec.MustUnmarshal(store.Get(types.GetQueuedTransferHeightKey(*tokenContract, id)), &queued)
+		require.Equal(t, ctx.BlockHeight(), queued.Height)
+		require.Equal(t, sdk.NewInt(int64(id)), queued.Fee)
+	}
+
+	// running the migration again changes nothing
+	require.NoError(t, v2.MigrateStore(ctx.WithBlockHeight(ctx.BlockHeight()+1), gravityKey, testCodec, paramSpace))
+	var queued types.QueuedTransferHeight
+	testCodec.MustUnmarshal(store.Get(types.GetQueuedTransferHeightKey(*tokenContract, 1)), &queued)
+	require.Equal(t, ctx.BlockHeight(), queued.Height)
+}
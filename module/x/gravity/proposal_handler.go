@@ -0,0 +1,80 @@
+package gravity
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/keeper"
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// NewGravityProposalHandler returns a handler for governance proposals targeting the gravity module
+func NewGravityProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.ClearBridgeHijackProposal:
+			k, err := k.EvmChainKeeper(ctx, c.ChainId)
+			if err != nil {
+				return err
+			}
+			return handleClearBridgeHijackProposal(ctx, k, c)
+
+		case *types.ReleaseFailedDepositProposal:
+			k, err := k.EvmChainKeeper(ctx, c.ChainId)
+			if err != nil {
+				return err
+			}
+			return k.ReleaseFailedDeposit(ctx, c.EventNonce, c.Recipient)
+
+		case *types.UpdateBlocklistProposal:
+			return k.UpdateBlocklist(ctx, c.Blocked, c.Unblocked)
+
+		case *types.ReleaseQuarantinedDepositProposal:
+			k, err := k.EvmChainKeeper(ctx, c.ChainId)
+			if err != nil {
+				return err
+			}
+			return k.ReleaseQuarantinedDeposit(ctx, c.EventNonce, c.Recipient)
+
+		case *types.SetERC20MetadataProposal:
+			k, err := k.EvmChainKeeper(ctx, c.ChainId)
+			if err != nil {
+				return err
+			}
+			tokenContract, err := types.NewEthAddress(c.Erc20)
+			if err != nil {
+				return sdkerrors.Wrap(err, "erc20")
+			}
+			return k.SetERC20Metadata(ctx, *tokenContract, c.Name, c.Symbol, c.Decimals, c.Display, c.Aliases)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized gravity proposal content type: %T", c)
+		}
+	}
+}
+
+// NewParamChangeProposalHandler wraps the handler of param change proposals. The params subspace only validates
+// each changed key on its own, so a proposal fails if the resulting params fail the cross checks of
+// Params.ValidateBasic, or if it removes a chain from the EvmChains param while the chain still holds state or
+// funds. Governance applies proposals in a cache context, so the params set by a failing proposal are discarded.
+func NewParamChangeProposalHandler(k keeper.Keeper, paramsHandler govtypes.Handler) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		previous := k.GetParams(ctx).EvmChains
+		if err := paramsHandler(ctx, content); err != nil {
+			return err
+		}
+		if err := k.GetParams(ctx).ValidateBasic(); err != nil {
+			return err
+		}
+		return k.CheckRemovedEvmChains(ctx, previous)
+	}
+}
+
+func handleClearBridgeHijackProposal(ctx sdk.Context, k keeper.Keeper, p *types.ClearBridgeHijackProposal) error {
+	if !k.IsBridgePaused(ctx) {
+		return sdkerrors.Wrap(types.ErrInvalid, "bridge is not paused")
+	}
+	k.ClearBridgeHijackIncidents(ctx)
+	return nil
+}
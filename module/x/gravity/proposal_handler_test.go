@@ -0,0 +1,44 @@
+package gravity
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/keeper"
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+//nolint: exhaustivestruct
+func TestParamChangeProposalValidatesParams(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+
+	// stands in for the params handler, which only validates each changed key on its own
+	setEvmChains := func(chains []types.EvmChain) govtypes.Handler {
+		return func(ctx sdk.Context, content govtypes.Content) error {
+			params := k.GetParams(ctx)
+			params.EvmChains = chains
+			k.SetParams(ctx, params)
+			return nil
+		}
+	}
+	bridgeChainID := k.GetParams(ctx).BridgeChainId
+	chain := types.EvmChain{
+		ChainId:                  137,
+		BridgeEthereumAddress:    "0x8858eeb3dfffa017d4bce9801d340d36cf895ccf",
+		GravityId:                "polygon-gravity",
+		AverageEthereumBlockTime: 2000,
+	}
+
+	h := NewParamChangeProposalHandler(k, setEvmChains([]types.EvmChain{chain}))
+	require.NoError(t, h(ctx, nil))
+
+	// a chain sharing the id of the default chain passes the per key validation but not the cross checks
+	chain.ChainId = bridgeChainID
+	h = NewParamChangeProposalHandler(k, setEvmChains([]types.EvmChain{chain}))
+	require.ErrorIs(t, h(ctx, nil), types.ErrInvalid)
+}
@@ -73,4 +73,83 @@ const (
 			{ "internalType": "uint256",   "name": "_invalidationNonce",      "type": "uint256"   }
       ]
     }]`
+
+	// GravityContractABIJSON is the part of the Gravity contract ABI relayers call, the functions submitting
+	// valset updates, batches and logic calls along with the signatures of the current valset
+	GravityContractABIJSON = `[{
+		"name": "updateValset",
+		"stateMutability": "nonpayable",
+		"type": "function",
+		"inputs": [
+			{ "internalType": "struct ValsetArgs", "name": "_newValset", "type": "tuple", "components": [
+				{ "internalType": "address[]", "name": "validators",   "type": "address[]" },
+				{ "internalType": "uint256[]", "name": "powers",       "type": "uint256[]" },
+				{ "internalType": "uint256",   "name": "valsetNonce",  "type": "uint256"   },
+				{ "internalType": "uint256",   "name": "rewardAmount", "type": "uint256"   },
+				{ "internalType": "address",   "name": "rewardToken",  "type": "address"   }
+			]},
+			{ "internalType": "struct ValsetArgs", "name": "_currentValset", "type": "tuple", "components": [
+				{ "internalType": "address[]", "name": "validators",   "type": "address[]" },
+				{ "internalType": "uint256[]", "name": "powers",       "type": "uint256[]" },
+				{ "internalType": "uint256",   "name": "valsetNonce",  "type": "uint256"   },
+				{ "internalType": "uint256",   "name": "rewardAmount", "type": "uint256"   },
+				{ "internalType": "address",   "name": "rewardToken",  "type": "address"   }
+			]},
+			{ "internalType": "uint8[]",   "name": "_v", "type": "uint8[]"   },
+			{ "internalType": "bytes32[]", "name": "_r", "type": "bytes32[]" },
+			{ "internalType": "bytes32[]", "name": "_s", "type": "bytes32[]" }
+		],
+		"outputs": []
+	}, {
+		"name": "submitBatch",
+		"stateMutability": "nonpayable",
+		"type": "function",
+		"inputs": [
+			{ "internalType": "struct ValsetArgs", "name": "_currentValset", "type": "tuple", "components": [
+				{ "internalType": "address[]", "name": "validators",   "type": "address[]" },
+				{ "internalType": "uint256[]", "name": "powers",       "type": "uint256[]" },
+				{ "internalType": "uint256",   "name": "valsetNonce",  "type": "uint256"   },
+				{ "internalType": "uint256",   "name": "rewardAmount", "type": "uint256"   },
+				{ "internalType": "address",   "name": "rewardToken",  "type": "address"   }
+			]},
+			{ "internalType": "uint8[]",   "name": "_v",             "type": "uint8[]"   },
+			{ "internalType": "bytes32[]", "name": "_r",             "type": "bytes32[]" },
+			{ "internalType": "bytes32[]", "name": "_s",             "type": "bytes32[]" },
+			{ "internalType": "uint256[]", "name": "_amounts",       "type": "uint256[]" },
+			{ "internalType": "address[]", "name": "_destinations",  "type": "address[]" },
+			{ "internalType": "uint256[]", "name": "_fees",          "type": "uint256[]" },
+			{ "internalType": "uint256",   "name": "_batchNonce",    "type": "uint256"   },
+			{ "internalType": "address",   "name": "_tokenContract", "type": "address"   },
+			{ "internalType": "uint256",   "name": "_batchTimeout",  "type": "uint256"   }
+		],
+		"outputs": []
+	}, {
+		"name": "submitLogicCall",
+		"stateMutability": "nonpayable",
+		"type": "function",
+		"inputs": [
+			{ "internalType": "struct ValsetArgs", "name": "_currentValset", "type": "tuple", "components": [
+				{ "internalType": "address[]", "name": "validators",   "type": "address[]" },
+				{ "internalType": "uint256[]", "name": "powers",       "type": "uint256[]" },
+				{ "internalType": "uint256",   "name": "valsetNonce",  "type": "uint256"   },
+				{ "internalType": "uint256",   "name": "rewardAmount", "type": "uint256"   },
+				{ "internalType": "address",   "name": "rewardToken",  "type": "address"   }
+			]},
+			{ "internalType": "uint8[]",   "name": "_v", "type": "uint8[]"   },
+			{ "internalType": "bytes32[]", "name": "_r", "type": "bytes32[]" },
+			{ "internalType": "bytes32[]", "name": "_s", "type": "bytes32[]" },
+			{ "internalType": "struct LogicCallArgs", "name": "_args", "type": "tuple", "components": [
+				{ "internalType": "uint256[]", "name": "transferAmounts",        "type": "uint256[]" },
+				{ "internalType": "address[]", "name": "transferTokenContracts", "type": "address[]" },
+				{ "internalType": "uint256[]", "name": "feeAmounts",             "type": "uint256[]" },
+				{ "internalType": "address[]", "name": "feeTokenContracts",      "type": "address[]" },
+				{ "internalType": "address",   "name": "logicContractAddress",   "type": "address"   },
+				{ "internalType": "bytes",     "name": "payload",                "type": "bytes"     },
+				{ "internalType": "uint256",   "name": "timeOut",                "type": "uint256"   },
+				{ "internalType": "bytes32",   "name": "invalidationId",         "type": "bytes32"   },
+				{ "internalType": "uint256",   "name": "invalidationNonce",      "type": "uint256"   }
+			]}
+		],
+		"outputs": []
+	}]`
 )
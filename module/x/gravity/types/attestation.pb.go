@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -77,11 +78,20 @@ func (ClaimType) EnumDescriptor() ([]byte, []int) {
 // the key in which the attestation is stored is keyed on the exact details of the claim
 // but there is no reason to store those exact details becuause the next message sender
 // will kindly provide you with them.
+// VOTE_POWERS:
+// The power of each voter at the time its vote was cast, index aligned with votes.
+// Voters outside the static validator set count as zero
+// TOTAL_POWER:
+// The total power of the bonded static validator set when the first vote was cast,
+// the threshold is computed against this value so that power changes between the
+// votes and the tally can not flip the outcome
 type Attestation struct {
-	Observed bool       `protobuf:"varint,1,opt,name=observed,proto3" json:"observed,omitempty"`
-	Votes    []string   `protobuf:"bytes,2,rep,name=votes,proto3" json:"votes,omitempty"`
-	Height   uint64     `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
-	Claim    *types.Any `protobuf:"bytes,4,opt,name=claim,proto3" json:"claim,omitempty"`
+	Observed   bool       `protobuf:"varint,1,opt,name=observed,proto3" json:"observed,omitempty"`
+	Votes      []string   `protobuf:"bytes,2,rep,name=votes,proto3" json:"votes,omitempty"`
+	Height     uint64     `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Claim      *types.Any `protobuf:"bytes,4,opt,name=claim,proto3" json:"claim,omitempty"`
+	VotePowers []uint64   `protobuf:"varint,5,rep,packed,name=vote_powers,json=votePowers,proto3" json:"vote_powers,omitempty"`
+	TotalPower uint64     `protobuf:"varint,6,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
 }
 
 func (m *Attestation) Reset()         { *m = Attestation{} }
@@ -145,6 +155,20 @@ func (m *Attestation) GetClaim() *types.Any {
 	return nil
 }
 
+func (m *Attestation) GetVotePowers() []uint64 {
+	if m != nil {
+		return m.VotePowers
+	}
+	return nil
+}
+
+func (m *Attestation) GetTotalPower() uint64 {
+	if m != nil {
+		return m.TotalPower
+	}
+	return 0
+}
+
 // ERC20Token unique identifier for an Ethereum ERC20 token.
 // CONTRACT:
 // The contract address on ETH of the token, this could be a Cosmos
@@ -195,46 +219,306 @@ func (m *ERC20Token) GetContract() string {
 	return ""
 }
 
+// ConflictingClaim records a validator that voted for a different claim than the one that was
+// observed at the same event nonce, which points at a compromised or faulty Ethereum node
+// VALIDATOR:
+// The operator address of the validator that voted for the conflicting claim
+// CLAIM_HASH:
+// The hash of the claim the validator voted for
+// OBSERVED_CLAIM_HASH:
+// The hash of the claim that was observed at this event nonce
+// HEIGHT:
+// The Cosmos block height the conflict was detected at
+type ConflictingClaim struct {
+	EventNonce        uint64 `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	Validator         string `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	ClaimHash         []byte `protobuf:"bytes,3,opt,name=claim_hash,json=claimHash,proto3" json:"claim_hash,omitempty"`
+	ObservedClaimHash []byte `protobuf:"bytes,4,opt,name=observed_claim_hash,json=observedClaimHash,proto3" json:"observed_claim_hash,omitempty"`
+	Height            uint64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ConflictingClaim) Reset()         { *m = ConflictingClaim{} }
+func (m *ConflictingClaim) String() string { return proto.CompactTextString(m) }
+func (*ConflictingClaim) ProtoMessage()    {}
+func (*ConflictingClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3205613bbab7525, []int{2}
+}
+func (m *ConflictingClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConflictingClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConflictingClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConflictingClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConflictingClaim.Merge(m, src)
+}
+func (m *ConflictingClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConflictingClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConflictingClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConflictingClaim proto.InternalMessageInfo
+
+func (m *ConflictingClaim) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
+	}
+	return 0
+}
+
+func (m *ConflictingClaim) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *ConflictingClaim) GetClaimHash() []byte {
+	if m != nil {
+		return m.ClaimHash
+	}
+	return nil
+}
+
+func (m *ConflictingClaim) GetObservedClaimHash() []byte {
+	if m != nil {
+		return m.ObservedClaimHash
+	}
+	return nil
+}
+
+func (m *ConflictingClaim) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// DepositReceipt records the outcome of an observed deposit from Ethereum, the
+// record is pruned deposit_receipt_retention blocks after the deposit was observed
+// ETHEREUM_HEIGHT:
+// The Ethereum block height the deposit was made at
+// AMOUNT:
+// The amount in the Cosmos denom the token contract maps to
+// ERROR:
+// Why the deposit could not be credited to the receiver, empty when it was
+// HEIGHT:
+// The Cosmos block height the deposit was observed at
+// REFUND_TX_ID:
+// The id of the transfer to Ethereum that refunds a failed deposit to its sender,
+// zero while the deposit is held in escrow or when it was released on Cosmos
+// RELEASED_TO:
+// The account governance released a failed deposit to
+// QUARANTINED:
+// Whether the deposit was quarantined rather than credited because its sender
+// or receiver is blocked
+type DepositReceipt struct {
+	EventNonce     uint64      `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	EthereumHeight uint64      `protobuf:"varint,2,opt,name=ethereum_height,json=ethereumHeight,proto3" json:"ethereum_height,omitempty"`
+	TokenContract  string      `protobuf:"bytes,3,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Amount         types1.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+	EthereumSender string      `protobuf:"bytes,5,opt,name=ethereum_sender,json=ethereumSender,proto3" json:"ethereum_sender,omitempty"`
+	CosmosReceiver string      `protobuf:"bytes,6,opt,name=cosmos_receiver,json=cosmosReceiver,proto3" json:"cosmos_receiver,omitempty"`
+	Success        bool        `protobuf:"varint,7,opt,name=success,proto3" json:"success,omitempty"`
+	Error          string      `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	Height         int64       `protobuf:"varint,9,opt,name=height,proto3" json:"height,omitempty"`
+	RefundTxId     uint64      `protobuf:"varint,10,opt,name=refund_tx_id,json=refundTxId,proto3" json:"refund_tx_id,omitempty"`
+	ReleasedTo     string      `protobuf:"bytes,11,opt,name=released_to,json=releasedTo,proto3" json:"released_to,omitempty"`
+	Quarantined    bool        `protobuf:"varint,12,opt,name=quarantined,proto3" json:"quarantined,omitempty"`
+}
+
+func (m *DepositReceipt) Reset()         { *m = DepositReceipt{} }
+func (m *DepositReceipt) String() string { return proto.CompactTextString(m) }
+func (*DepositReceipt) ProtoMessage()    {}
+func (*DepositReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3205613bbab7525, []int{3}
+}
+func (m *DepositReceipt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositReceipt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DepositReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositReceipt.Merge(m, src)
+}
+func (m *DepositReceipt) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositReceipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositReceipt proto.InternalMessageInfo
+
+func (m *DepositReceipt) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
+	}
+	return 0
+}
+
+func (m *DepositReceipt) GetEthereumHeight() uint64 {
+	if m != nil {
+		return m.EthereumHeight
+	}
+	return 0
+}
+
+func (m *DepositReceipt) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *DepositReceipt) GetAmount() types1.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types1.Coin{}
+}
+
+func (m *DepositReceipt) GetEthereumSender() string {
+	if m != nil {
+		return m.EthereumSender
+	}
+	return ""
+}
+
+func (m *DepositReceipt) GetCosmosReceiver() string {
+	if m != nil {
+		return m.CosmosReceiver
+	}
+	return ""
+}
+
+func (m *DepositReceipt) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *DepositReceipt) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *DepositReceipt) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *DepositReceipt) GetRefundTxId() uint64 {
+	if m != nil {
+		return m.RefundTxId
+	}
+	return 0
+}
+
+func (m *DepositReceipt) GetReleasedTo() string {
+	if m != nil {
+		return m.ReleasedTo
+	}
+	return ""
+}
+
+func (m *DepositReceipt) GetQuarantined() bool {
+	if m != nil {
+		return m.Quarantined
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterEnum("gravity.v1.ClaimType", ClaimType_name, ClaimType_value)
 	proto.RegisterType((*Attestation)(nil), "gravity.v1.Attestation")
 	proto.RegisterType((*ERC20Token)(nil), "gravity.v1.ERC20Token")
+	proto.RegisterType((*ConflictingClaim)(nil), "gravity.v1.ConflictingClaim")
+	proto.RegisterType((*DepositReceipt)(nil), "gravity.v1.DepositReceipt")
 }
 
 func init() { proto.RegisterFile("gravity/v1/attestation.proto", fileDescriptor_e3205613bbab7525) }
 
 var fileDescriptor_e3205613bbab7525 = []byte{
-	// 476 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0x4f, 0x6f, 0x9b, 0x30,
-	0x18, 0xc6, 0x71, 0xfe, 0xa9, 0x71, 0x2f, 0x91, 0x15, 0x55, 0x69, 0xd4, 0xd1, 0x28, 0x87, 0x29,
-	0xaa, 0x14, 0xbc, 0x76, 0x9f, 0x80, 0x80, 0xbb, 0x46, 0xa2, 0x25, 0x02, 0x32, 0xad, 0xd3, 0x24,
-	0x04, 0xc4, 0x23, 0xa8, 0x01, 0x47, 0xe0, 0xa0, 0x71, 0xde, 0x65, 0xc7, 0x7d, 0x87, 0x7d, 0x99,
-	0x1e, 0x73, 0x9c, 0x76, 0xa8, 0xa6, 0xe4, 0x8b, 0x4c, 0x01, 0x92, 0x45, 0x3d, 0xc1, 0xe3, 0xdf,
-	0xeb, 0xd7, 0x8f, 0x1f, 0xbf, 0xf0, 0xc2, 0x8f, 0x9d, 0x34, 0xe0, 0x19, 0x4e, 0xaf, 0xb1, 0xc3,
-	0x39, 0x4d, 0xb8, 0xc3, 0x03, 0x16, 0x49, 0xcb, 0x98, 0x71, 0x86, 0x60, 0x49, 0xa5, 0xf4, 0xba,
-	0xdb, 0xf6, 0x99, 0xcf, 0xf2, 0x65, 0xbc, 0xfb, 0x2b, 0x2a, 0xba, 0xe7, 0x3e, 0x63, 0xfe, 0x82,
-	0xe2, 0x5c, 0xb9, 0xab, 0xaf, 0xd8, 0x89, 0xb2, 0x02, 0xf5, 0xbf, 0x03, 0x78, 0x2a, 0xff, 0x6f,
-	0x89, 0xba, 0xf0, 0x84, 0xb9, 0x09, 0x8d, 0x53, 0x3a, 0xeb, 0x80, 0x1e, 0x18, 0x9c, 0x18, 0x07,
-	0x8d, 0xda, 0xb0, 0x9e, 0x32, 0x4e, 0x93, 0x4e, 0xa5, 0x57, 0x1d, 0x34, 0x8d, 0x42, 0xa0, 0x33,
-	0xd8, 0x98, 0xd3, 0xc0, 0x9f, 0xf3, 0x4e, 0xb5, 0x07, 0x06, 0x35, 0xa3, 0x54, 0xe8, 0x0a, 0xd6,
-	0xbd, 0x85, 0x13, 0x84, 0x9d, 0x5a, 0x0f, 0x0c, 0x4e, 0x6f, 0xda, 0x52, 0x61, 0x42, 0xda, 0x9b,
-	0x90, 0xe4, 0x28, 0x33, 0x8a, 0x92, 0xfe, 0x12, 0x42, 0x62, 0x28, 0x37, 0xef, 0x2c, 0xf6, 0x44,
-	0x73, 0x0f, 0x1e, 0x8b, 0x78, 0xec, 0x78, 0x3c, 0xf7, 0xd0, 0x34, 0x0e, 0x1a, 0xdd, 0xc2, 0x86,
-	0x13, 0xb2, 0x55, 0xc4, 0x3b, 0x95, 0x1d, 0x19, 0x49, 0xcf, 0x2f, 0x97, 0xc2, 0x9f, 0x97, 0xcb,
-	0xb7, 0x7e, 0xc0, 0xe7, 0x2b, 0x57, 0xf2, 0x58, 0x88, 0x3d, 0x96, 0x84, 0x2c, 0x29, 0x3f, 0xc3,
-	0x64, 0xf6, 0x84, 0x79, 0xb6, 0xa4, 0x89, 0x34, 0x8e, 0xb8, 0x51, 0xee, 0xbe, 0x5a, 0x03, 0xd8,
-	0x54, 0x76, 0x67, 0x5b, 0xd9, 0x92, 0xa2, 0x2e, 0x3c, 0x53, 0x34, 0x79, 0x7c, 0x6f, 0x5b, 0x8f,
-	0x13, 0x62, 0x4f, 0x1f, 0xcc, 0x09, 0x51, 0xc6, 0xb7, 0x63, 0xa2, 0xb6, 0x04, 0xf4, 0x06, 0x9e,
-	0x1f, 0x31, 0x93, 0x3c, 0xa8, 0xb6, 0xa5, 0xdb, 0x8a, 0x6e, 0xde, 0xeb, 0x66, 0x0b, 0xa0, 0x1e,
-	0xbc, 0x38, 0xc2, 0x23, 0xd9, 0x52, 0xee, 0x0e, 0x45, 0xc4, 0xba, 0x6b, 0x55, 0x5e, 0x35, 0xc8,
-	0xef, 0x69, 0xab, 0x64, 0xa2, 0xe9, 0x8f, 0x44, 0x6d, 0x55, 0x51, 0x1f, 0x8a, 0x47, 0x58, 0xd3,
-	0x3f, 0x8c, 0x15, 0x5b, 0x91, 0x35, 0xcd, 0x26, 0x9f, 0x88, 0x32, 0xb5, 0x88, 0xda, 0xaa, 0xbd,
-	0x6a, 0xf1, 0x51, 0xd6, 0x4c, 0x62, 0xd9, 0xd3, 0x89, 0x2a, 0xef, 0x70, 0xbd, 0x5b, 0xfb, 0xf1,
-	0x4b, 0x14, 0x46, 0x5f, 0x9e, 0x37, 0x22, 0x58, 0x6f, 0x44, 0xf0, 0x77, 0x23, 0x82, 0x9f, 0x5b,
-	0x51, 0x58, 0x6f, 0x45, 0xe1, 0xf7, 0x56, 0x14, 0x3e, 0x8f, 0x8e, 0xc2, 0x71, 0x16, 0x7c, 0x4e,
-	0x9d, 0x61, 0x44, 0xf9, 0x3e, 0xa0, 0x72, 0x7c, 0x86, 0x6e, 0x1c, 0xcc, 0x7c, 0x8a, 0x43, 0x36,
-	0x5b, 0x2d, 0x28, 0xfe, 0x86, 0xf7, 0x43, 0x97, 0x87, 0xe7, 0x36, 0xf2, 0x77, 0x7b, 0xff, 0x2f,
-	0x00, 0x00, 0xff, 0xff, 0x0f, 0x0b, 0x25, 0x26, 0x8c, 0x02, 0x00, 0x00,
+	// 818 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0xd1, 0x6e, 0xe3, 0x44,
+	0x14, 0x8d, 0x9b, 0xa4, 0xdb, 0x4c, 0x4a, 0x09, 0xa6, 0x5a, 0xb9, 0x51, 0xd7, 0xb5, 0x22, 0x01,
+	0xd1, 0x4a, 0xb5, 0xe9, 0xf2, 0xc0, 0x73, 0xe2, 0x78, 0x69, 0xa4, 0x6c, 0x13, 0x39, 0x2e, 0x62,
+	0x11, 0xd2, 0x68, 0x62, 0xdf, 0x3a, 0xd6, 0x26, 0x33, 0xc1, 0x33, 0x31, 0xcd, 0x1f, 0xf0, 0xc8,
+	0x3f, 0xf0, 0x13, 0xf0, 0x01, 0x48, 0xfb, 0xd8, 0x47, 0xc4, 0xc3, 0x0a, 0xb5, 0x3f, 0x82, 0x3c,
+	0x63, 0xa7, 0xa1, 0x2f, 0xfb, 0x64, 0xdf, 0x73, 0x8f, 0xef, 0xdc, 0x7b, 0xce, 0x1d, 0xa3, 0xd3,
+	0x38, 0x25, 0x59, 0x22, 0x36, 0x4e, 0x76, 0xe1, 0x10, 0x21, 0x80, 0x0b, 0x22, 0x12, 0x46, 0xed,
+	0x55, 0xca, 0x04, 0xd3, 0x51, 0x91, 0xb5, 0xb3, 0x8b, 0xb6, 0x19, 0x32, 0xbe, 0x64, 0xdc, 0x99,
+	0x11, 0x0e, 0x4e, 0x76, 0x31, 0x03, 0x41, 0x2e, 0x9c, 0x90, 0x25, 0x05, 0xb7, 0x7d, 0x1c, 0xb3,
+	0x98, 0xc9, 0x57, 0x27, 0x7f, 0x2b, 0xd0, 0x93, 0x98, 0xb1, 0x78, 0x01, 0x8e, 0x8c, 0x66, 0xeb,
+	0x1b, 0x87, 0xd0, 0x8d, 0x4a, 0x75, 0xfe, 0xd2, 0x50, 0xb3, 0xf7, 0x78, 0xa4, 0xde, 0x46, 0x07,
+	0x6c, 0xc6, 0x21, 0xcd, 0x20, 0x32, 0x34, 0x4b, 0xeb, 0x1e, 0xf8, 0xdb, 0x58, 0x3f, 0x46, 0xf5,
+	0x8c, 0x09, 0xe0, 0xc6, 0x9e, 0x55, 0xed, 0x36, 0x7c, 0x15, 0xe8, 0xcf, 0xd1, 0xfe, 0x1c, 0x92,
+	0x78, 0x2e, 0x8c, 0xaa, 0xa5, 0x75, 0x6b, 0x7e, 0x11, 0xe9, 0x2f, 0x51, 0x3d, 0x5c, 0x90, 0x64,
+	0x69, 0xd4, 0x2c, 0xad, 0xdb, 0x7c, 0x75, 0x6c, 0xab, 0x26, 0xec, 0xb2, 0x09, 0xbb, 0x47, 0x37,
+	0xbe, 0xa2, 0xe8, 0x67, 0xa8, 0x99, 0x17, 0xc3, 0x2b, 0xf6, 0x0b, 0xa4, 0xdc, 0xa8, 0x5b, 0xd5,
+	0x6e, 0xcd, 0x47, 0x39, 0x34, 0x91, 0x48, 0x4e, 0x10, 0x4c, 0x90, 0x85, 0x62, 0x18, 0xfb, 0xf2,
+	0x24, 0x24, 0x21, 0xc9, 0xe8, 0xac, 0x10, 0xf2, 0x7c, 0xf7, 0xd5, 0xd7, 0x01, 0x7b, 0x07, 0x72,
+	0x8a, 0x90, 0x51, 0x91, 0x92, 0x50, 0xc8, 0x29, 0x1a, 0xfe, 0x36, 0xd6, 0x5f, 0xa3, 0x7d, 0xb2,
+	0x64, 0x6b, 0x2a, 0x8c, 0xbd, 0x3c, 0xd3, 0xb7, 0xdf, 0x7f, 0x38, 0xab, 0xfc, 0xf3, 0xe1, 0xec,
+	0xcb, 0x38, 0x11, 0xf3, 0xf5, 0xcc, 0x0e, 0xd9, 0xd2, 0x29, 0x54, 0x56, 0x8f, 0x73, 0x1e, 0xbd,
+	0x73, 0xc4, 0x66, 0x05, 0xdc, 0x1e, 0x52, 0xe1, 0x17, 0x5f, 0x77, 0xfe, 0xd0, 0x50, 0xcb, 0x65,
+	0xf4, 0x66, 0x91, 0x84, 0x22, 0xa1, 0xb1, 0x5b, 0x0e, 0x02, 0x19, 0x50, 0x81, 0x29, 0xa3, 0x21,
+	0xc8, 0xb3, 0x6b, 0x3e, 0x92, 0xd0, 0x55, 0x8e, 0xe8, 0xa7, 0xa8, 0x91, 0x91, 0x45, 0x12, 0x11,
+	0xc1, 0x52, 0xd5, 0x80, 0xff, 0x08, 0xe8, 0x2f, 0x10, 0x92, 0x82, 0xe0, 0x39, 0xe1, 0x73, 0xa9,
+	0xe7, 0xa1, 0xdf, 0x90, 0xc8, 0x25, 0xe1, 0x73, 0xdd, 0x46, 0x9f, 0x97, 0x66, 0xe0, 0x1d, 0x5e,
+	0x4d, 0xf2, 0x3e, 0x2b, 0x53, 0xee, 0x96, 0xff, 0x68, 0x4d, 0x7d, 0xd7, 0x9a, 0xce, 0x9f, 0x55,
+	0x74, 0x34, 0x80, 0x15, 0xe3, 0x89, 0xf0, 0x21, 0x84, 0x64, 0x25, 0x3e, 0xde, 0xf8, 0x57, 0xe8,
+	0x53, 0x10, 0x73, 0x48, 0x61, 0xbd, 0xc4, 0x45, 0xd1, 0x3d, 0x49, 0x3a, 0x2a, 0xe1, 0x4b, 0xe5,
+	0xfb, 0x17, 0xe8, 0x48, 0xe4, 0x26, 0xe0, 0xad, 0x03, 0x55, 0x39, 0xe6, 0x27, 0x12, 0x75, 0x4b,
+	0x1b, 0xbe, 0xdd, 0xda, 0xa0, 0xf6, 0xe3, 0xc4, 0x56, 0x6a, 0xdb, 0xf9, 0x6a, 0xdb, 0xc5, 0x6a,
+	0xdb, 0x2e, 0x4b, 0x68, 0xbf, 0x96, 0x3b, 0x54, 0xea, 0xfe, 0xbf, 0x46, 0x38, 0xd0, 0x08, 0x52,
+	0x39, 0x5d, 0xe3, 0xb1, 0x91, 0xa9, 0x44, 0x73, 0xa2, 0x2a, 0x89, 0xd3, 0x7c, 0xc8, 0xac, 0xd8,
+	0x9b, 0x86, 0x7f, 0xa4, 0x60, 0xbf, 0x40, 0x75, 0x03, 0x3d, 0xe3, 0xeb, 0x30, 0x04, 0xce, 0x8d,
+	0x67, 0x72, 0xe5, 0xcb, 0x30, 0xdf, 0x78, 0x48, 0x53, 0x96, 0x1a, 0x07, 0xf2, 0x43, 0x15, 0xec,
+	0xc8, 0xda, 0xb0, 0xb4, 0x6e, 0x75, 0xbb, 0xf1, 0x16, 0x3a, 0x4c, 0xe1, 0x66, 0x4d, 0x23, 0x2c,
+	0x6e, 0x71, 0x12, 0x19, 0x48, 0x89, 0xa8, 0xb0, 0xe0, 0x76, 0x18, 0xe5, 0x2a, 0xa7, 0xb0, 0x00,
+	0xc2, 0x21, 0xc2, 0x82, 0x19, 0x4d, 0x59, 0x15, 0x95, 0x50, 0xc0, 0x74, 0x0b, 0x35, 0x7f, 0x5e,
+	0x93, 0x94, 0x50, 0x91, 0x50, 0x88, 0x8c, 0x43, 0xd9, 0xce, 0x2e, 0xf4, 0xf2, 0x4e, 0x43, 0x0d,
+	0xe9, 0x70, 0xb0, 0x59, 0x81, 0xde, 0x46, 0xcf, 0xdd, 0x51, 0x6f, 0xf8, 0x06, 0x07, 0x6f, 0x27,
+	0x1e, 0xbe, 0xbe, 0x9a, 0x4e, 0x3c, 0x77, 0xf8, 0x7a, 0xe8, 0x0d, 0x5a, 0x15, 0xfd, 0x05, 0x3a,
+	0xd9, 0xc9, 0x4d, 0xbd, 0xab, 0x01, 0x0e, 0xc6, 0xd8, 0x1d, 0x4f, 0xdf, 0x8c, 0xa7, 0x2d, 0x4d,
+	0xb7, 0xd0, 0xe9, 0x4e, 0xba, 0xdf, 0x0b, 0xdc, 0xcb, 0x2d, 0xc9, 0x0b, 0x2e, 0x5b, 0x7b, 0x4f,
+	0x0a, 0xc8, 0xeb, 0x85, 0x07, 0xde, 0x64, 0x34, 0x7e, 0xeb, 0x0d, 0x5a, 0x55, 0xbd, 0x83, 0xcc,
+	0x9d, 0xf4, 0x68, 0xfc, 0xdd, 0xd0, 0xc5, 0x6e, 0x6f, 0x34, 0xc2, 0xde, 0x0f, 0x9e, 0x7b, 0x1d,
+	0x78, 0x83, 0x56, 0xed, 0x49, 0x89, 0xef, 0x7b, 0xa3, 0xa9, 0x17, 0xe0, 0xeb, 0xc9, 0xa0, 0x97,
+	0xa7, 0xeb, 0xed, 0xda, 0xaf, 0xbf, 0x9b, 0x95, 0xfe, 0x4f, 0xef, 0xef, 0x4d, 0xed, 0xee, 0xde,
+	0xd4, 0xfe, 0xbd, 0x37, 0xb5, 0xdf, 0x1e, 0xcc, 0xca, 0xdd, 0x83, 0x59, 0xf9, 0xfb, 0xc1, 0xac,
+	0xfc, 0xd8, 0xdf, 0xb9, 0x93, 0x64, 0x21, 0xe6, 0x40, 0xce, 0x29, 0x88, 0xf2, 0x5e, 0x16, 0xff,
+	0xc5, 0xf3, 0x59, 0x9a, 0x44, 0x31, 0x38, 0x4b, 0x16, 0xad, 0x17, 0xe0, 0xdc, 0x3a, 0x05, 0xae,
+	0xee, 0xec, 0x6c, 0x5f, 0xfe, 0x70, 0xbe, 0xf9, 0x6f, 0x00, 0xfe, 0x69, 0x67, 0xdf, 0x65, 0x05,
+	0x00, 0x00,
 }
 
 func (m *Attestation) Marshal() (dAtA []byte, err error) {
@@ -257,6 +541,29 @@ func (m *Attestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TotalPower != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.TotalPower))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.VotePowers) > 0 {
+		dAtA2 := make([]byte, len(m.VotePowers)*10)
+		var j1 int
+		for _, num := range m.VotePowers {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintAttestation(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Claim != nil {
 		{
 			size, err := m.Claim.MarshalToSizedBuffer(dAtA[:i])
@@ -336,6 +643,168 @@ func (m *ERC20Token) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ConflictingClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConflictingClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConflictingClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ObservedClaimHash) > 0 {
+		i -= len(m.ObservedClaimHash)
+		copy(dAtA[i:], m.ObservedClaimHash)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.ObservedClaimHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ClaimHash) > 0 {
+		i -= len(m.ClaimHash)
+		copy(dAtA[i:], m.ClaimHash)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.ClaimHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepositReceipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositReceipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Quarantined {
+		i--
+		if m.Quarantined {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.ReleasedTo) > 0 {
+		i -= len(m.ReleasedTo)
+		copy(dAtA[i:], m.ReleasedTo)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.ReleasedTo)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.RefundTxId != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.RefundTxId))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Height != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.CosmosReceiver) > 0 {
+		i -= len(m.CosmosReceiver)
+		copy(dAtA[i:], m.CosmosReceiver)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.CosmosReceiver)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.EthereumSender) > 0 {
+		i -= len(m.EthereumSender)
+		copy(dAtA[i:], m.EthereumSender)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.EthereumSender)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAttestation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EthereumHeight != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.EthereumHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAttestation(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAttestation(v)
 	base := offset
@@ -369,6 +838,16 @@ func (m *Attestation) Size() (n int) {
 		l = m.Claim.Size()
 		n += 1 + l + sovAttestation(uint64(l))
 	}
+	if len(m.VotePowers) > 0 {
+		l = 0
+		for _, e := range m.VotePowers {
+			l += sovAttestation(uint64(e))
+		}
+		n += 1 + sovAttestation(uint64(l)) + l
+	}
+	if m.TotalPower != 0 {
+		n += 1 + sovAttestation(uint64(m.TotalPower))
+	}
 	return n
 }
 
@@ -387,18 +866,94 @@ func (m *ERC20Token) Size() (n int) {
 	return n
 }
 
-func sovAttestation(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozAttestation(x uint64) (n int) {
-	return sovAttestation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *Attestation) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
+func (m *ConflictingClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		n += 1 + sovAttestation(uint64(m.EventNonce))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.ClaimHash)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.ObservedClaimHash)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovAttestation(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *DepositReceipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		n += 1 + sovAttestation(uint64(m.EventNonce))
+	}
+	if m.EthereumHeight != 0 {
+		n += 1 + sovAttestation(uint64(m.EthereumHeight))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovAttestation(uint64(l))
+	l = len(m.EthereumSender)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.CosmosReceiver)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovAttestation(uint64(m.Height))
+	}
+	if m.RefundTxId != 0 {
+		n += 1 + sovAttestation(uint64(m.RefundTxId))
+	}
+	l = len(m.ReleasedTo)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	if m.Quarantined {
+		n += 2
+	}
+	return n
+}
+
+func sovAttestation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAttestation(x uint64) (n int) {
+	return sovAttestation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Attestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowAttestation
@@ -529,6 +1084,101 @@ func (m *Attestation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAttestation
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.VotePowers = append(m.VotePowers, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAttestation
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAttestation
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAttestation
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.VotePowers) == 0 {
+					m.VotePowers = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAttestation
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.VotePowers = append(m.VotePowers, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePowers", wireType)
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			m.TotalPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAttestation(dAtA[iNdEx:])
@@ -672,6 +1322,559 @@ func (m *ERC20Token) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ConflictingClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttestation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConflictingClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConflictingClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			}
+			m.EventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimHash = append(m.ClaimHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ClaimHash == nil {
+				m.ClaimHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedClaimHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObservedClaimHash = append(m.ObservedClaimHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ObservedClaimHash == nil {
+				m.ObservedClaimHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttestation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepositReceipt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttestation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositReceipt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			}
+			m.EventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeight", wireType)
+			}
+			m.EthereumHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosReceiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmosReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundTxId", wireType)
+			}
+			m.RefundTxId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RefundTxId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleasedTo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReleasedTo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quarantined", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Quarantined = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttestation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipAttestation(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
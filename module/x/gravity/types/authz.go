@@ -0,0 +1,50 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// OrchestratorMsgTypeURLs returns the confirms and claims an orchestrator submits while running the bridge. A
+// registered orchestrator can grant them to a hot key, which submits them wrapped in an authz MsgExec, and keep
+// its own key cold.
+func OrchestratorMsgTypeURLs() []string {
+	return []string{
+		sdk.MsgTypeURL(&MsgValsetConfirm{}),
+		sdk.MsgTypeURL(&MsgConfirmBatch{}),
+		sdk.MsgTypeURL(&MsgConfirmLogicCall{}),
+		sdk.MsgTypeURL(&MsgSendToCosmosClaim{}),
+		sdk.MsgTypeURL(&MsgBatchSendToEthClaim{}),
+		sdk.MsgTypeURL(&MsgERC20DeployedClaim{}),
+		sdk.MsgTypeURL(&MsgLogicCallExecutedClaim{}),
+		sdk.MsgTypeURL(&MsgValsetUpdatedClaim{}),
+		sdk.MsgTypeURL(&MsgEthereumHeightClaim{}),
+		sdk.MsgTypeURL(&MsgSubmitConfirmations{}),
+		sdk.MsgTypeURL(&MsgSubmitClaims{}),
+	}
+}
+
+// BridgeMsgTypeURLs returns the messages moving the coins of an account to Ethereum. Users of custodial wallets
+// grant them to the wallet so it can bridge on their behalf.
+func BridgeMsgTypeURLs() []string {
+	return []string{
+		sdk.MsgTypeURL(&MsgSendToEth{}),
+		sdk.MsgTypeURL(&MsgCancelSendToEth{}),
+	}
+}
+
+// NewGenericGrants returns the MsgGrants giving grantee a generic authorization for each of the message types
+// until expiration, see OrchestratorMsgTypeURLs and BridgeMsgTypeURLs for the recommended sets
+func NewGenericGrants(granter sdk.AccAddress, grantee sdk.AccAddress, msgTypeURLs []string, expiration time.Time) ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(msgTypeURLs))
+	for i, msgTypeURL := range msgTypeURLs {
+		msg, err := authz.NewMsgGrant(granter, grantee, authz.NewGenericAuthorization(msgTypeURL), expiration)
+		if err != nil {
+			return nil, err
+		}
+		msgs[i] = msg
+	}
+	return msgs, nil
+}
@@ -13,7 +13,18 @@ import (
 )
 
 func (o OutgoingTransferTx) ToInternal() (*InternalOutgoingTransferTx, error) {
-	return NewInternalOutgoingTransferTx(o.Id, o.Sender, o.DestAddress, *o.Erc20Token, *o.Erc20Fee)
+	tx, err := NewInternalOutgoingTransferTx(o.Id, o.Sender, o.DestAddress, *o.Erc20Token, *o.Erc20Fee)
+	if err != nil {
+		return nil, err
+	}
+	if o.ProtocolFee != nil {
+		if err := o.ProtocolFee.Validate(); err != nil {
+			return nil, sdkerrors.Wrap(err, "invalid ProtocolFee")
+		}
+		protocolFee := *o.ProtocolFee
+		tx.ProtocolFee = &protocolFee
+	}
+	return tx, nil
 }
 
 // InternalOutgoingTransferTx is an internal duplicate of OutgoingTransferTx with validation
@@ -23,6 +34,8 @@ type InternalOutgoingTransferTx struct {
 	DestAddress *EthAddress
 	Erc20Token  *InternalERC20Token
 	Erc20Fee    *InternalERC20Token
+	// ProtocolFee is the protocol fee held with the transfer, nil when it paid none
+	ProtocolFee *sdk.Coin
 }
 
 func NewInternalOutgoingTransferTx(
@@ -55,6 +68,7 @@ func NewInternalOutgoingTransferTx(
 		DestAddress: dest,
 		Erc20Token:  token,
 		Erc20Fee:    fee,
+		ProtocolFee: nil,
 	}, nil
 }
 
@@ -65,6 +79,7 @@ func (i InternalOutgoingTransferTx) ToExternal() *OutgoingTransferTx {
 		DestAddress: i.DestAddress.GetAddress(),
 		Erc20Token:  i.Erc20Token.ToExternal(),
 		Erc20Fee:    i.Erc20Fee.ToExternal(),
+		ProtocolFee: i.ProtocolFee,
 	}
 }
 
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -99,13 +100,15 @@ func (m *OutgoingTxBatch) GetBlock() uint64 {
 	return 0
 }
 
-// OutgoingTransferTx represents an individual send from gravity to ETH
+// OutgoingTransferTx represents an individual send from gravity to ETH,
+// protocol_fee is the protocol fee held with the transfer, if any
 type OutgoingTransferTx struct {
 	Id          uint64      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Sender      string      `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
 	DestAddress string      `protobuf:"bytes,3,opt,name=dest_address,json=destAddress,proto3" json:"dest_address,omitempty"`
 	Erc20Token  *ERC20Token `protobuf:"bytes,4,opt,name=erc20_token,json=erc20Token,proto3" json:"erc20_token,omitempty"`
 	Erc20Fee    *ERC20Token `protobuf:"bytes,5,opt,name=erc20_fee,json=erc20Fee,proto3" json:"erc20_fee,omitempty"`
+	ProtocolFee *types.Coin `protobuf:"bytes,6,opt,name=protocol_fee,json=protocolFee,proto3" json:"protocol_fee,omitempty"`
 }
 
 func (m *OutgoingTransferTx) Reset()         { *m = OutgoingTransferTx{} }
@@ -176,6 +179,13 @@ func (m *OutgoingTransferTx) GetErc20Fee() *ERC20Token {
 	return nil
 }
 
+func (m *OutgoingTransferTx) GetProtocolFee() *types.Coin {
+	if m != nil {
+		return m.ProtocolFee
+	}
+	return nil
+}
+
 // OutgoingLogicCall represents an individual logic call from gravity to ETH
 type OutgoingLogicCall struct {
 	Transfers            []*ERC20Token `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
@@ -286,40 +296,43 @@ func init() {
 func init() { proto.RegisterFile("gravity/v1/batch.proto", fileDescriptor_4453b445b0660cab) }
 
 var fileDescriptor_4453b445b0660cab = []byte{
-	// 522 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x51, 0x6b, 0x1a, 0x41,
-	0x10, 0xf6, 0x8c, 0x31, 0x71, 0x34, 0x86, 0x2c, 0x41, 0x8e, 0x52, 0xae, 0xd6, 0x52, 0x2a, 0x05,
-	0xbd, 0xc4, 0x04, 0xfa, 0x5c, 0xa5, 0x85, 0x42, 0x69, 0xe1, 0xf0, 0xa9, 0x14, 0x64, 0xbd, 0x1d,
-	0xcf, 0x25, 0xe7, 0xad, 0xdc, 0xae, 0xa2, 0xff, 0xa2, 0x3f, 0xab, 0x2f, 0x81, 0x3c, 0xe6, 0xb1,
-	0xe8, 0x1f, 0x29, 0x37, 0x77, 0x67, 0x4c, 0x4b, 0xf2, 0x76, 0xf3, 0xcd, 0x37, 0x33, 0xdf, 0xcc,
-	0x7e, 0x07, 0x8d, 0x20, 0xe6, 0x4b, 0x69, 0xd6, 0xee, 0xf2, 0xd2, 0x1d, 0x73, 0xe3, 0x4f, 0xbb,
-	0xf3, 0x58, 0x19, 0xc5, 0x20, 0xc3, 0xbb, 0xcb, 0xcb, 0x17, 0x2f, 0xf7, 0x38, 0xdc, 0x18, 0xd4,
-	0x86, 0x1b, 0xa9, 0xa2, 0x94, 0xd9, 0xba, 0xb7, 0xe0, 0xf4, 0xfb, 0xc2, 0x04, 0x4a, 0x46, 0xc1,
-	0x70, 0xd5, 0x4f, 0x7a, 0xb0, 0x57, 0x50, 0xa5, 0x66, 0xa3, 0x48, 0x45, 0x3e, 0xda, 0x56, 0xd3,
-	0x6a, 0x97, 0x3c, 0x20, 0xe8, 0x5b, 0x82, 0xb0, 0x37, 0x70, 0x92, 0x12, 0x8c, 0x9c, 0xa1, 0x5a,
-	0x18, 0xbb, 0x48, 0x94, 0x1a, 0x81, 0xc3, 0x14, 0x63, 0x7d, 0xa8, 0x99, 0x98, 0x47, 0x9a, 0xfb,
-	0xc9, 0x38, 0x6d, 0x1f, 0x34, 0x0f, 0xda, 0xd5, 0x9e, 0xd3, 0x7d, 0x90, 0xd6, 0xdd, 0x0d, 0x4e,
-	0x78, 0x13, 0x8c, 0x87, 0x2b, 0xef, 0x51, 0x0d, 0x7b, 0x0b, 0x75, 0xa3, 0x6e, 0x30, 0x1a, 0xf9,
-	0x2a, 0x32, 0x31, 0xf7, 0x8d, 0x5d, 0x6a, 0x5a, 0xed, 0x8a, 0x77, 0x42, 0xe8, 0x20, 0x03, 0xd9,
-	0x39, 0x1c, 0x8e, 0x43, 0xe5, 0xdf, 0xd8, 0x87, 0xa4, 0x23, 0x0d, 0x5a, 0xb7, 0x16, 0xb0, 0xff,
-	0x27, 0xb0, 0x3a, 0x14, 0xa5, 0xc8, 0x96, 0x2a, 0x4a, 0xc1, 0x1a, 0x50, 0xd6, 0x18, 0x09, 0x8c,
-	0x69, 0x8b, 0x8a, 0x97, 0x45, 0xec, 0x35, 0xd4, 0x04, 0x6a, 0x33, 0xe2, 0x42, 0xc4, 0xa8, 0x13,
-	0xfd, 0x49, 0xb6, 0x9a, 0x60, 0x1f, 0x53, 0x88, 0x7d, 0x80, 0x2a, 0xc6, 0x7e, 0xef, 0x62, 0x44,
-	0x72, 0x48, 0x5b, 0xb5, 0xd7, 0xd8, 0xdf, 0xf0, 0x93, 0x37, 0xe8, 0x5d, 0x0c, 0x93, 0xac, 0x07,
-	0x44, 0xa5, 0x6f, 0x76, 0x05, 0x95, 0xb4, 0x70, 0x82, 0x48, 0xa2, 0x9f, 0x2e, 0x3b, 0x26, 0xe2,
-	0x67, 0xc4, 0xd6, 0x6d, 0x11, 0xce, 0xf2, 0x7d, 0xbe, 0xaa, 0x40, 0xfa, 0x03, 0x1e, 0x86, 0xec,
-	0x1a, 0x2a, 0x26, 0x5b, 0x4e, 0xdb, 0x16, 0xdd, 0xf8, 0xa9, 0x56, 0x0f, 0x44, 0xf6, 0x1e, 0x4a,
-	0x13, 0x44, 0x6d, 0x17, 0x9f, 0x2d, 0x20, 0x0e, 0xbb, 0x86, 0x46, 0x98, 0x8c, 0xdb, 0x3d, 0xc2,
-	0x3f, 0x27, 0x39, 0xa7, 0x6c, 0xfe, 0x18, 0xf9, 0x6d, 0x6c, 0x38, 0x9a, 0xf3, 0x75, 0xa8, 0xb8,
-	0xa0, 0xbb, 0xd4, 0xbc, 0x3c, 0x4c, 0x32, 0xb9, 0x6f, 0xd2, 0xf7, 0xca, 0x43, 0xf6, 0x0e, 0x4e,
-	0x65, 0xb4, 0xe4, 0xa1, 0x14, 0x64, 0xd1, 0x91, 0x14, 0x76, 0x99, 0x6a, 0xeb, 0xfb, 0xf0, 0x17,
-	0xc1, 0x3a, 0xc0, 0x1e, 0x11, 0x53, 0xa3, 0x1e, 0x51, 0xb7, 0xb3, 0xfd, 0x4c, 0xea, 0xd7, 0x9d,
-	0x3f, 0x8e, 0xf7, 0xfc, 0xd1, 0xff, 0xf9, 0x7b, 0xe3, 0x58, 0x77, 0x1b, 0xc7, 0xfa, 0xb3, 0x71,
-	0xac, 0x5f, 0x5b, 0xa7, 0x70, 0xb7, 0x75, 0x0a, 0xf7, 0x5b, 0xa7, 0xf0, 0xa3, 0x1f, 0x48, 0x33,
-	0x5d, 0x8c, 0xbb, 0xbe, 0x9a, 0xb9, 0x3c, 0x34, 0x53, 0xe4, 0x9d, 0x08, 0x8d, 0xeb, 0x2b, 0x3d,
-	0x53, 0xba, 0x93, 0xdd, 0xaa, 0x33, 0x8e, 0xa5, 0x08, 0xd0, 0x9d, 0x29, 0xb1, 0x08, 0xd1, 0x5d,
-	0xb9, 0xf9, 0x7f, 0x66, 0xd6, 0x73, 0xd4, 0xe3, 0x32, 0xfd, 0x5f, 0x57, 0x7f, 0x03, 0x00, 0x00,
-	0xff, 0xff, 0xb6, 0x22, 0x58, 0x81, 0xa3, 0x03, 0x00, 0x00,
+	// 569 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x4d, 0x6b, 0xdb, 0x40,
+	0x10, 0x8d, 0x94, 0x4f, 0x8f, 0x94, 0x84, 0x2c, 0xc1, 0xa8, 0xa1, 0xa8, 0x6e, 0x4a, 0x69, 0x28,
+	0x58, 0x8a, 0x9d, 0x40, 0x2f, 0xbd, 0xd4, 0xa6, 0x81, 0x42, 0x69, 0x41, 0xf8, 0x54, 0x0a, 0x66,
+	0xb5, 0x3b, 0x51, 0x96, 0xc8, 0xda, 0xa0, 0x5d, 0x1b, 0xfb, 0x37, 0xf4, 0xd2, 0x9f, 0xd5, 0x4b,
+	0x21, 0xc7, 0x1c, 0x8b, 0xfd, 0x47, 0x8a, 0x56, 0x92, 0xe3, 0xb4, 0xe0, 0x9b, 0xe7, 0xcd, 0x1b,
+	0xcf, 0xdb, 0xf7, 0x46, 0xd0, 0x4c, 0x72, 0x3a, 0x11, 0x7a, 0x16, 0x4e, 0x3a, 0x61, 0x4c, 0x35,
+	0xbb, 0x09, 0xee, 0x72, 0xa9, 0x25, 0x81, 0x0a, 0x0f, 0x26, 0x9d, 0x93, 0xe7, 0x2b, 0x1c, 0xaa,
+	0x35, 0x2a, 0x4d, 0xb5, 0x90, 0x59, 0xc9, 0x3c, 0xf1, 0x99, 0x54, 0x23, 0xa9, 0xc2, 0x98, 0x2a,
+	0x0c, 0x27, 0x9d, 0x18, 0x35, 0xed, 0x84, 0x4c, 0x8a, 0xaa, 0x7f, 0xfa, 0x60, 0xc1, 0xe1, 0xd7,
+	0xb1, 0x4e, 0xa4, 0xc8, 0x92, 0xc1, 0xb4, 0x57, 0xec, 0x20, 0x2f, 0xc0, 0x31, 0xcb, 0x86, 0x99,
+	0xcc, 0x18, 0x7a, 0x56, 0xcb, 0x3a, 0xdb, 0x8a, 0xc0, 0x40, 0x5f, 0x0a, 0x84, 0xbc, 0x82, 0xfd,
+	0x92, 0xa0, 0xc5, 0x08, 0xe5, 0x58, 0x7b, 0xb6, 0xa1, 0xb8, 0x06, 0x1c, 0x94, 0x18, 0xe9, 0x81,
+	0xab, 0x73, 0x9a, 0x29, 0xca, 0x0a, 0x39, 0xca, 0xdb, 0x6c, 0x6d, 0x9e, 0x39, 0x5d, 0x3f, 0x78,
+	0x94, 0x1e, 0x2c, 0x17, 0x17, 0xbc, 0x6b, 0xcc, 0x07, 0xd3, 0xe8, 0xc9, 0x0c, 0x79, 0x0d, 0x07,
+	0x5a, 0xde, 0x62, 0x36, 0x64, 0x32, 0xd3, 0x39, 0x65, 0xda, 0xdb, 0x6a, 0x59, 0x67, 0x8d, 0x68,
+	0xdf, 0xa0, 0xfd, 0x0a, 0x24, 0xc7, 0xb0, 0x1d, 0xa7, 0x92, 0xdd, 0x7a, 0xdb, 0x46, 0x47, 0x59,
+	0x9c, 0xfe, 0xb0, 0x81, 0xfc, 0xbf, 0x81, 0x1c, 0x80, 0x2d, 0x78, 0xf5, 0x28, 0x5b, 0x70, 0xd2,
+	0x84, 0x1d, 0x85, 0x19, 0xc7, 0xdc, 0xbc, 0xa2, 0x11, 0x55, 0x15, 0x79, 0x09, 0x2e, 0x47, 0xa5,
+	0x87, 0x94, 0xf3, 0x1c, 0x55, 0xa1, 0xbf, 0xe8, 0x3a, 0x05, 0xf6, 0xa1, 0x84, 0xc8, 0x3b, 0x70,
+	0x30, 0x67, 0xdd, 0xf3, 0xa1, 0x91, 0x63, 0xb4, 0x39, 0xdd, 0xe6, 0xea, 0x0b, 0x3f, 0x46, 0xfd,
+	0xee, 0xf9, 0xa0, 0xe8, 0x46, 0x60, 0xa8, 0xe6, 0x37, 0xb9, 0x80, 0x46, 0x39, 0x78, 0x8d, 0xe8,
+	0x6d, 0xaf, 0x1d, 0xdb, 0x33, 0xc4, 0x2b, 0x44, 0xf2, 0x1e, 0x5c, 0x93, 0x19, 0x93, 0xa9, 0x99,
+	0xdb, 0x31, 0x73, 0xcf, 0x82, 0x32, 0xe1, 0xa0, 0x48, 0x38, 0xa8, 0x12, 0x0e, 0xfa, 0x52, 0x64,
+	0x91, 0x53, 0xd3, 0xaf, 0x10, 0x4f, 0x7f, 0xdb, 0x70, 0x54, 0xbb, 0xf1, 0x59, 0x26, 0x82, 0xf5,
+	0x69, 0x9a, 0x92, 0x4b, 0x68, 0xe8, 0xca, 0x1a, 0xe5, 0x59, 0xad, 0xcd, 0x35, 0x42, 0x1e, 0x89,
+	0xe4, 0x2d, 0x6c, 0x5d, 0x23, 0x2a, 0xcf, 0x5e, 0x3b, 0x60, 0x38, 0xe4, 0x12, 0x9a, 0x69, 0xb1,
+	0x6e, 0x19, 0xe1, 0x3f, 0x86, 0x1e, 0x9b, 0x6e, 0x1d, 0x65, 0xed, 0xac, 0x07, 0xbb, 0x77, 0x74,
+	0x96, 0x4a, 0xca, 0x8d, 0xab, 0x6e, 0x54, 0x97, 0x45, 0xa7, 0xbe, 0xba, 0x32, 0xed, 0xba, 0x24,
+	0x6f, 0xe0, 0x50, 0x64, 0x13, 0x9a, 0x0a, 0x6e, 0x3e, 0x80, 0xa1, 0xe0, 0xc6, 0x22, 0x37, 0x3a,
+	0x58, 0x85, 0x3f, 0x71, 0xd2, 0x06, 0xf2, 0x84, 0x58, 0x9e, 0xf9, 0xae, 0xf9, 0xb7, 0xa3, 0xd5,
+	0x4e, 0x79, 0xed, 0xcb, 0xeb, 0xda, 0x5b, 0xb9, 0xae, 0xde, 0xf7, 0x5f, 0x73, 0xdf, 0xba, 0x9f,
+	0xfb, 0xd6, 0x9f, 0xb9, 0x6f, 0xfd, 0x5c, 0xf8, 0x1b, 0xf7, 0x0b, 0x7f, 0xe3, 0x61, 0xe1, 0x6f,
+	0x7c, 0xeb, 0x25, 0x42, 0xdf, 0x8c, 0xe3, 0x80, 0xc9, 0x51, 0x48, 0x53, 0x7d, 0x83, 0xb4, 0x9d,
+	0xa1, 0x0e, 0xcb, 0x98, 0xda, 0x95, 0x57, 0xed, 0x38, 0x17, 0x3c, 0xc1, 0x70, 0x24, 0xf9, 0x38,
+	0xc5, 0x70, 0x1a, 0xd6, 0x5f, 0xb1, 0x9e, 0xdd, 0xa1, 0x8a, 0x77, 0x4c, 0x74, 0x17, 0x7f, 0x07,
+	0x00, 0x94, 0xd7, 0xf5, 0xbd, 0x01, 0x04, 0x00, 0x00,
 }
 
 func (m *OutgoingTxBatch) Marshal() (dAtA []byte, err error) {
@@ -401,6 +414,18 @@ func (m *OutgoingTransferTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ProtocolFee != nil {
+		{
+			size, err := m.ProtocolFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBatch(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.Erc20Fee != nil {
 		{
 			size, err := m.Erc20Fee.MarshalToSizedBuffer(dAtA[:i])
@@ -598,6 +623,10 @@ func (m *OutgoingTransferTx) Size() (n int) {
 		l = m.Erc20Fee.Size()
 		n += 1 + l + sovBatch(uint64(l))
 	}
+	if m.ProtocolFee != nil {
+		l = m.ProtocolFee.Size()
+		n += 1 + l + sovBatch(uint64(l))
+	}
 	return n
 }
 
@@ -1009,6 +1038,42 @@ func (m *OutgoingTransferTx) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProtocolFee == nil {
+				m.ProtocolFee = &types.Coin{}
+			}
+			if err := m.ProtocolFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBatch(dAtA[iNdEx:])
@@ -5,6 +5,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	evidenceexported "github.com/cosmos/cosmos-sdk/x/evidence/exported"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // ModuleCdc is the codec for the module
@@ -32,6 +34,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgValsetUpdatedClaim{},
 		&MsgCancelSendToEth{},
 		&MsgSubmitBadSignatureEvidence{},
+		&MsgEthereumHeightClaim{},
+		&MsgSubmitConfirmations{},
+		&MsgSubmitClaims{},
 	)
 
 	registry.RegisterInterface(
@@ -46,6 +51,11 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 
 	registry.RegisterInterface("gravity.v1beta1.EthereumSigned", (*EthereumSigned)(nil), &Valset{}, &OutgoingTxBatch{}, &OutgoingLogicCall{})
 
+	registry.RegisterImplementations((*govtypes.Content)(nil), &ClearBridgeHijackProposal{}, &ReleaseFailedDepositProposal{},
+		&UpdateBlocklistProposal{}, &ReleaseQuarantinedDepositProposal{}, &SetERC20MetadataProposal{})
+
+	registry.RegisterImplementations((*evidenceexported.Evidence)(nil), &BadSignatureEvidence{})
+
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
@@ -73,4 +83,7 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&IDSet{}, "gravity/IDSet", nil)
 	cdc.RegisterConcrete(&Attestation{}, "gravity/Attestation", nil)
 	cdc.RegisterConcrete(&MsgSubmitBadSignatureEvidence{}, "gravity/MsgSubmitBadSignatureEvidence", nil)
+	cdc.RegisterConcrete(&MsgEthereumHeightClaim{}, "gravity/MsgEthereumHeightClaim", nil)
+	cdc.RegisterConcrete(&MsgSubmitConfirmations{}, "gravity/MsgSubmitConfirmations", nil)
+	cdc.RegisterConcrete(&MsgSubmitClaims{}, "gravity/MsgSubmitClaims", nil)
 }
@@ -17,4 +17,7 @@ var (
 	ErrResetDelegateKeys       = sdkerrors.Register(ModuleName, 10, "can not set orchestrator addresses more than once")
 	ErrMismatched              = sdkerrors.Register(ModuleName, 11, "mismatched")
 	NotStaticVal               = sdkerrors.Register(ModuleName, 12, "this validator is not allowed to have an orchestrator")
+	ErrBridgePaused            = sdkerrors.Register(ModuleName, 13, "bridge is paused due to a validator set hijack")
+	ErrBlocked                 = sdkerrors.Register(ModuleName, 14, "address is blocked")
+	ErrDoubleSign              = sdkerrors.Register(ModuleName, 15, "refused to sign two different objects with the same nonce")
 )
@@ -23,6 +23,10 @@ const (
 	// GravityDenomLen is the length of the denoms generated by the gravity module
 	GravityDenomLen = len(GravityDenomPrefix) + len(GravityDenomSeparator) + ETHContractAddressLen
 
+	// EvmChainGravityDenomSeparator separates the chain id from the token contract in the denoms of tokens
+	// from the chains in Params.EvmChains, i.e. gravity137/0xc783df8a850f42e7F7e57013759C285caa701eB6
+	EvmChainGravityDenomSeparator = "/"
+
 	// ZeroAddress is an EthAddress containing the zero ethereum address
 	ZeroAddressString = "0x0000000000000000000000000000000000000000"
 )
@@ -158,6 +162,12 @@ func GravityDenom(tokenContract EthAddress) string {
 	return fmt.Sprintf("%s%s%s", GravityDenomPrefix, GravityDenomSeparator, tokenContract.GetAddress())
 }
 
+// EvmChainGravityDenom converts an EthAddress on one of the chains in Params.EvmChains to a gravity cosmos
+// denom, tokens of different chains never share a denom even when their contracts share an address
+func EvmChainGravityDenom(chainID uint64, tokenContract EthAddress) string {
+	return fmt.Sprintf("%s%d%s%s", GravityDenomPrefix, chainID, EvmChainGravityDenomSeparator, tokenContract.GetAddress())
+}
+
 // ValidateBasic permforms stateless validation
 func (e *ERC20Token) ValidateBasic() error {
 	if err := ValidateEthAddress(e.Contract); err != nil {
@@ -194,3 +204,17 @@ func GravityDenomToERC20(denom string) (*EthAddress, error) {
 		return ethAddr, nil
 	}
 }
+
+// EvmChainGravityDenomToERC20 converts a gravity cosmos denom of one of the chains in Params.EvmChains to
+// an EthAddress
+func EvmChainGravityDenomToERC20(chainID uint64, denom string) (*EthAddress, error) {
+	fullPrefix := fmt.Sprintf("%s%d%s", GravityDenomPrefix, chainID, EvmChainGravityDenomSeparator)
+	if !strings.HasPrefix(denom, fullPrefix) {
+		return nil, fmt.Errorf("denom prefix(%s) not equal to expected(%s)", denom, fullPrefix)
+	}
+	ethAddr, err := NewEthAddress(strings.TrimPrefix(denom, fullPrefix))
+	if err != nil {
+		return nil, fmt.Errorf("error(%s) validating ethereum contract address", err)
+	}
+	return ethAddr, nil
+}
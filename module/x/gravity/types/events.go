@@ -9,6 +9,10 @@ const (
 	EventTypeBridgeWithdrawalReceived  = "withdrawal_received"
 	EventTypeBridgeDepositReceived     = "deposit_received"
 	EventTypeBridgeWithdrawCanceled    = "withdraw_canceled"
+	EventTypeBridgeHijackDetected      = "bridge_hijack_detected"
+	EventTypeBridgeHijackCleared       = "bridge_hijack_cleared"
+	EventTypeConflictingClaim          = "conflicting_claim"
+	EventTypeBadSignatureEvidence      = "bad_signature_evidence"
 
 	AttributeKeyAttestationID          = "attestation_id"
 	AttributeKeyBatchConfirmKey        = "batch_confirm_key"
@@ -27,4 +31,14 @@ const (
 	AttributeKeyInvalidationNonce      = "logic_call_invalidation_nonce"
 	AttributeKeyBadEthSignature        = "bad_eth_signature"
 	AttributeKeyBadEthSignatureSubject = "bad_eth_signature_subject"
+	AttributeKeySeverity               = "severity"
+	AttributeKeyExpectedMembers        = "expected_members"
+	AttributeKeyObservedMembers        = "observed_members"
+	AttributeKeyValidator              = "validator"
+	AttributeKeyClaimHash              = "claim_hash"
+	AttributeKeyObservedClaimHash      = "observed_claim_hash"
+	AttributeKeySubmitter              = "submitter"
+	AttributeKeyReward                 = "reward"
+
+	SeverityCritical = "critical"
 )
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	evidenceexported "github.com/cosmos/cosmos-sdk/x/evidence/exported"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+)
+
+const (
+	// EvidenceTypeBadEthSignature defines the evidence type of a BadSignatureEvidence
+	EvidenceTypeBadEthSignature = "bad_eth_signature"
+)
+
+var _ evidenceexported.Evidence = &BadSignatureEvidence{}
+
+// Route returns the evidence route, the gravity module handles the evidence itself
+func (e *BadSignatureEvidence) Route() string { return RouterKey }
+
+// Type returns the evidence type
+func (e *BadSignatureEvidence) Type() string { return EvidenceTypeBadEthSignature }
+
+// Hash uniquely identifies the evidence by the checkpoint and the signature over it
+func (e *BadSignatureEvidence) Hash() tmbytes.HexBytes {
+	return tmhash.Sum(append(append([]byte{}, e.Checkpoint...), []byte(e.Signature)...))
+}
+
+// ValidateBasic performs stateless checks
+func (e *BadSignatureEvidence) ValidateBasic() error {
+	if len(e.Checkpoint) == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "empty checkpoint")
+	}
+	if len(e.Signature) == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "empty signature")
+	}
+	if _, err := sdk.ValAddressFromBech32(e.Validator); err != nil {
+		return sdkerrors.Wrap(err, "validator")
+	}
+	if err := ValidateEthAddress(e.EthereumAddress); err != nil {
+		return sdkerrors.Wrap(err, "ethereum address")
+	}
+	if _, err := sdk.AccAddressFromBech32(e.Submitter); err != nil {
+		return sdkerrors.Wrap(err, "submitter")
+	}
+	if e.Height <= 0 {
+		return sdkerrors.Wrap(ErrInvalid, fmt.Sprintf("invalid height %d", e.Height))
+	}
+	if !e.Reward.IsValid() {
+		return sdkerrors.Wrap(ErrInvalid, fmt.Sprintf("invalid reward %s", e.Reward))
+	}
+	return nil
+}
@@ -72,6 +72,59 @@ var (
 	// to a relayer when they relay a valset
 	ParamStoreValsetRewardAmount = []byte("ValsetReward")
 
+	// ParamStoreSlashFractionConflictingClaim stores the amount by which a validator voting for a claim that
+	// conflicts with the observed one will be slashed, zero disables slashing
+	ParamStoreSlashFractionConflictingClaim = []byte("SlashFractionConflictingClaim")
+
+	// ParamsStoreKeySignedClaimsWindow stores the number of blocks after an attestation is created
+	// that validators have to submit a claim for it
+	ParamsStoreKeySignedClaimsWindow = []byte("SignedClaimsWindow")
+
+	// ParamsStoreSlashFractionClaim stores the slash fraction for not submitting a claim
+	ParamsStoreSlashFractionClaim = []byte("SlashFractionClaim")
+
+	// ParamsStoreJailMissedClaims stores whether validators slashed for not submitting a claim are jailed
+	ParamsStoreJailMissedClaims = []byte("JailMissedClaims")
+
+	// ParamsStoreBadEthSignatureRewardFraction stores the fraction of a bad signature slash paid to the
+	// evidence submitter
+	ParamsStoreBadEthSignatureRewardFraction = []byte("BadEthSignatureRewardFraction")
+
+	// ParamsStoreTransferHistoryRetention stores the number of blocks the history of a transfer to Ethereum
+	// is kept after it was executed or canceled
+	ParamsStoreTransferHistoryRetention = []byte("TransferHistoryRetention")
+
+	// ParamsStoreDepositReceiptRetention stores the number of blocks the receipt of a deposit from Ethereum
+	// is kept after it was observed
+	ParamsStoreDepositReceiptRetention = []byte("DepositReceiptRetention")
+
+	// ParamsStoreRefundFailedDeposits stores whether deposits that can not be credited are refunded to their
+	// Ethereum sender right away rather than held in escrow
+	ParamsStoreRefundFailedDeposits = []byte("RefundFailedDeposits")
+
+	// ParamsStoreBatchSelectionPolicy stores how the transfers of a new batch are chosen from the pool
+	ParamsStoreBatchSelectionPolicy = []byte("BatchSelectionPolicy")
+
+	// ParamsStoreBatchMinimumFees stores the fee a transfer must pay to be batched under the FIFO policy,
+	// per denom
+	ParamsStoreBatchMinimumFees = []byte("BatchMinimumFees")
+
+	// ParamsStoreBatchGuaranteedInclusionBlocks stores the number of blocks after which a waiting transfer
+	// goes into the next batch of its token regardless of the selection policy
+	ParamsStoreBatchGuaranteedInclusionBlocks = []byte("BatchGuaranteedInclusionBlocks")
+
+	// ParamsStoreEvmChains stores the EVM chains bridged besides the default one
+	ParamsStoreEvmChains = []byte("EvmChains")
+
+	// ParamsStoreProtocolFeeBasisPoints stores the protocol fee of a transfer to Ethereum in basis points of its amount
+	ParamsStoreProtocolFeeBasisPoints = []byte("ProtocolFeeBasisPoints")
+
+	// ParamsStoreProtocolFeeMinimums stores the smallest protocol fee a transfer to Ethereum pays, per denom
+	ParamsStoreProtocolFeeMinimums = []byte("ProtocolFeeMinimums")
+
+	// ParamsStoreProtocolFeeTreasury stores the account protocol fees are paid to, the community pool when empty
+	ParamsStoreProtocolFeeTreasury = []byte("ProtocolFeeTreasury")
+
 	// Ensure that params implements the proper interface
 	_ paramtypes.ParamSet = &Params{
 		GravityId:                    "",
@@ -95,6 +148,21 @@ var (
 			Denom:  "",
 			Amount: sdk.Int{},
 		},
+		SlashFractionConflictingClaim:  sdk.Dec{},
+		SignedClaimsWindow:             0,
+		SlashFractionClaim:             sdk.Dec{},
+		JailMissedClaims:               false,
+		BadEthSignatureRewardFraction:  sdk.Dec{},
+		TransferHistoryRetention:       0,
+		DepositReceiptRetention:        0,
+		RefundFailedDeposits:           false,
+		BatchSelectionPolicy:           BATCH_SELECTION_POLICY_FEE_PRIORITY,
+		BatchMinimumFees:               nil,
+		BatchGuaranteedInclusionBlocks: 0,
+		EvmChains:                      nil,
+		ProtocolFeeBasisPoints:         0,
+		ProtocolFeeMinimums:            nil,
+		ProtocolFeeTreasury:            "",
 	}
 )
 
@@ -104,6 +172,41 @@ func (s GenesisState) ValidateBasic() error {
 	if err := s.Params.ValidateBasic(); err != nil {
 		return sdkerrors.Wrap(err, "params")
 	}
+	registered := make(map[uint64]bool, len(s.Params.EvmChains))
+	for _, chain := range s.Params.EvmChains {
+		registered[chain.ChainId] = true
+	}
+	for _, chainState := range s.EvmChainStates {
+		if !registered[chainState.ChainId] {
+			return sdkerrors.Wrapf(ErrInvalid, "state of unknown evm chain %d", chainState.ChainId)
+		}
+		if chainState.State == nil {
+			return sdkerrors.Wrapf(ErrEmpty, "state of evm chain %d", chainState.ChainId)
+		}
+		if err := chainState.State.CosmosOriginatedLocked.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "cosmos originated coins locked for evm chain %d", chainState.ChainId)
+		}
+		// every state is written under its chain, a second one would overwrite the first
+		delete(registered, chainState.ChainId)
+	}
+	// the default chain unlocks what the module holds beyond the coins locked for the other chains
+	if len(s.CosmosOriginatedLocked) > 0 {
+		return sdkerrors.Wrap(ErrInvalid, "cosmos originated coins locked for the default chain")
+	}
+	for _, address := range s.Blocklist {
+		if _, err := NormalizeBlocklistAddress(address); err != nil {
+			return sdkerrors.Wrap(err, "blocklist")
+		}
+	}
+	// the token contract of a queued transfer height is part of its key and the fee locates the transfer in the pool
+	for _, queued := range s.QueuedTransferHeights {
+		if err := ValidateEthAddress(queued.TokenContract); err != nil {
+			return sdkerrors.Wrapf(err, "token contract of queued transfer %d", queued.TxId)
+		}
+		if queued.Fee.IsNil() || queued.Fee.IsNegative() {
+			return sdkerrors.Wrapf(ErrInvalid, "fee of queued transfer %d", queued.TxId)
+		}
+	}
 	return nil
 }
 
@@ -129,24 +232,39 @@ func DefaultGenesisState() *GenesisState {
 // DefaultParams returns a copy of the default params
 func DefaultParams() *Params {
 	return &Params{
-		GravityId:                    "defaultgravityid",
-		MinimumTransferToEth:         sdk.NewInt(5),
-		MinimumFeeTransferToEth:      sdk.NewInt(1),
-		ContractSourceHash:           "",
-		BridgeEthereumAddress:        ZeroAddressString,
-		BridgeChainId:                0,
-		SignedValsetsWindow:          10000,
-		SignedBatchesWindow:          10000,
-		SignedLogicCallsWindow:       10000,
-		TargetBatchTimeout:           43200000,
-		AverageBlockTime:             5000,
-		AverageEthereumBlockTime:     15000,
-		SlashFractionValset:          sdk.NewDec(1).Quo(sdk.NewDec(1000)),
-		SlashFractionBatch:           sdk.NewDec(1).Quo(sdk.NewDec(1000)),
-		SlashFractionLogicCall:       sdk.NewDec(1).Quo(sdk.NewDec(1000)),
-		UnbondSlashingValsetsWindow:  10000,
-		SlashFractionBadEthSignature: sdk.NewDec(1).Quo(sdk.NewDec(1000)),
-		ValsetReward:                 sdk.Coin{Denom: "", Amount: sdk.ZeroInt()},
+		GravityId:                      "defaultgravityid",
+		MinimumTransferToEth:           sdk.NewInt(5),
+		MinimumFeeTransferToEth:        sdk.NewInt(1),
+		ContractSourceHash:             "",
+		BridgeEthereumAddress:          ZeroAddressString,
+		BridgeChainId:                  0,
+		SignedValsetsWindow:            10000,
+		SignedBatchesWindow:            10000,
+		SignedLogicCallsWindow:         10000,
+		TargetBatchTimeout:             43200000,
+		AverageBlockTime:               5000,
+		AverageEthereumBlockTime:       15000,
+		SlashFractionValset:            sdk.NewDec(1).Quo(sdk.NewDec(1000)),
+		SlashFractionBatch:             sdk.NewDec(1).Quo(sdk.NewDec(1000)),
+		SlashFractionLogicCall:         sdk.NewDec(1).Quo(sdk.NewDec(1000)),
+		UnbondSlashingValsetsWindow:    10000,
+		SlashFractionBadEthSignature:   sdk.NewDec(1).Quo(sdk.NewDec(1000)),
+		ValsetReward:                   sdk.Coin{Denom: "", Amount: sdk.ZeroInt()},
+		SlashFractionConflictingClaim:  sdk.ZeroDec(),
+		SignedClaimsWindow:             10000,
+		SlashFractionClaim:             sdk.ZeroDec(),
+		JailMissedClaims:               false,
+		BadEthSignatureRewardFraction:  sdk.NewDec(1).Quo(sdk.NewDec(10)),
+		TransferHistoryRetention:       120960,
+		DepositReceiptRetention:        120960,
+		RefundFailedDeposits:           true,
+		BatchSelectionPolicy:           BATCH_SELECTION_POLICY_FEE_PRIORITY,
+		BatchMinimumFees:               sdk.Coins{},
+		BatchGuaranteedInclusionBlocks: 0,
+		EvmChains:                      []EvmChain{},
+		ProtocolFeeBasisPoints:         0,
+		ProtocolFeeMinimums:            sdk.Coins{},
+		ProtocolFeeTreasury:            "",
 	}
 }
 
@@ -206,6 +324,60 @@ func (p Params) ValidateBasic() error {
 	if err := validateValsetRewardAmount(p.ValsetReward); err != nil {
 		return sdkerrors.Wrap(err, "ValsetReward amount")
 	}
+	if err := validateSlashFractionConflictingClaim(p.SlashFractionConflictingClaim); err != nil {
+		return sdkerrors.Wrap(err, "slash fraction conflicting claim")
+	}
+	if err := validateSignedClaimsWindow(p.SignedClaimsWindow); err != nil {
+		return sdkerrors.Wrap(err, "signed blocks window claims")
+	}
+	if err := validateSlashFractionClaim(p.SlashFractionClaim); err != nil {
+		return sdkerrors.Wrap(err, "slash fraction claim")
+	}
+	if err := validateJailMissedClaims(p.JailMissedClaims); err != nil {
+		return sdkerrors.Wrap(err, "jail missed claims")
+	}
+	if err := validateBadEthSignatureRewardFraction(p.BadEthSignatureRewardFraction); err != nil {
+		return sdkerrors.Wrap(err, "bad eth signature reward fraction")
+	}
+	if err := validateTransferHistoryRetention(p.TransferHistoryRetention); err != nil {
+		return sdkerrors.Wrap(err, "transfer history retention")
+	}
+	if err := validateDepositReceiptRetention(p.DepositReceiptRetention); err != nil {
+		return sdkerrors.Wrap(err, "deposit receipt retention")
+	}
+	if err := validateRefundFailedDeposits(p.RefundFailedDeposits); err != nil {
+		return sdkerrors.Wrap(err, "refund failed deposits")
+	}
+	if err := validateBatchSelectionPolicy(p.BatchSelectionPolicy); err != nil {
+		return sdkerrors.Wrap(err, "batch selection policy")
+	}
+	if err := validateBatchMinimumFees(p.BatchMinimumFees); err != nil {
+		return sdkerrors.Wrap(err, "batch minimum fee")
+	}
+	if err := validateBatchGuaranteedInclusionBlocks(p.BatchGuaranteedInclusionBlocks); err != nil {
+		return sdkerrors.Wrap(err, "batch guaranteed inclusion blocks")
+	}
+	if err := validateEvmChains(p.EvmChains); err != nil {
+		return sdkerrors.Wrap(err, "evm chains")
+	}
+	// the default chain is looked up first, a chain sharing its id could never be reached
+	for _, chain := range p.EvmChains {
+		if chain.ChainId == p.BridgeChainId {
+			return sdkerrors.Wrapf(ErrInvalid, "evm chain %d is the default chain", chain.ChainId)
+		}
+		if chain.GravityId == p.GravityId {
+			return sdkerrors.Wrapf(ErrInvalid, "evm chain %d shares the gravity id of the default chain", chain.ChainId)
+		}
+	}
+	if err := validateProtocolFeeBasisPoints(p.ProtocolFeeBasisPoints); err != nil {
+		return sdkerrors.Wrap(err, "protocol fee basis points")
+	}
+	if err := validateProtocolFeeMinimums(p.ProtocolFeeMinimums); err != nil {
+		return sdkerrors.Wrap(err, "protocol fee minimums")
+	}
+	if err := validateProtocolFeeTreasury(p.ProtocolFeeTreasury); err != nil {
+		return sdkerrors.Wrap(err, "protocol fee treasury")
+	}
 
 	return nil
 }
@@ -234,6 +406,21 @@ func ParamKeyTable() paramtypes.KeyTable {
 			Denom:  "",
 			Amount: sdk.Int{},
 		},
+		SlashFractionConflictingClaim:  sdk.Dec{},
+		SignedClaimsWindow:             0,
+		SlashFractionClaim:             sdk.Dec{},
+		JailMissedClaims:               false,
+		BadEthSignatureRewardFraction:  sdk.Dec{},
+		TransferHistoryRetention:       0,
+		DepositReceiptRetention:        0,
+		RefundFailedDeposits:           false,
+		BatchSelectionPolicy:           BATCH_SELECTION_POLICY_FEE_PRIORITY,
+		BatchMinimumFees:               nil,
+		BatchGuaranteedInclusionBlocks: 0,
+		EvmChains:                      nil,
+		ProtocolFeeBasisPoints:         0,
+		ProtocolFeeMinimums:            nil,
+		ProtocolFeeTreasury:            "",
 	})
 }
 
@@ -258,6 +445,21 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreUnbondSlashingValsetsWindow, &p.UnbondSlashingValsetsWindow, validateUnbondSlashingValsetsWindow),
 		paramtypes.NewParamSetPair(ParamStoreSlashFractionBadEthSignature, &p.SlashFractionBadEthSignature, validateSlashFractionBadEthSignature),
 		paramtypes.NewParamSetPair(ParamStoreValsetRewardAmount, &p.ValsetReward, validateValsetRewardAmount),
+		paramtypes.NewParamSetPair(ParamStoreSlashFractionConflictingClaim, &p.SlashFractionConflictingClaim, validateSlashFractionConflictingClaim),
+		paramtypes.NewParamSetPair(ParamsStoreKeySignedClaimsWindow, &p.SignedClaimsWindow, validateSignedClaimsWindow),
+		paramtypes.NewParamSetPair(ParamsStoreSlashFractionClaim, &p.SlashFractionClaim, validateSlashFractionClaim),
+		paramtypes.NewParamSetPair(ParamsStoreJailMissedClaims, &p.JailMissedClaims, validateJailMissedClaims),
+		paramtypes.NewParamSetPair(ParamsStoreBadEthSignatureRewardFraction, &p.BadEthSignatureRewardFraction, validateBadEthSignatureRewardFraction),
+		paramtypes.NewParamSetPair(ParamsStoreTransferHistoryRetention, &p.TransferHistoryRetention, validateTransferHistoryRetention),
+		paramtypes.NewParamSetPair(ParamsStoreDepositReceiptRetention, &p.DepositReceiptRetention, validateDepositReceiptRetention),
+		paramtypes.NewParamSetPair(ParamsStoreRefundFailedDeposits, &p.RefundFailedDeposits, validateRefundFailedDeposits),
+		paramtypes.NewParamSetPair(ParamsStoreBatchSelectionPolicy, &p.BatchSelectionPolicy, validateBatchSelectionPolicy),
+		paramtypes.NewParamSetPair(ParamsStoreBatchMinimumFees, &p.BatchMinimumFees, validateBatchMinimumFees),
+		paramtypes.NewParamSetPair(ParamsStoreBatchGuaranteedInclusionBlocks, &p.BatchGuaranteedInclusionBlocks, validateBatchGuaranteedInclusionBlocks),
+		paramtypes.NewParamSetPair(ParamsStoreEvmChains, &p.EvmChains, validateEvmChains),
+		paramtypes.NewParamSetPair(ParamsStoreProtocolFeeBasisPoints, &p.ProtocolFeeBasisPoints, validateProtocolFeeBasisPoints),
+		paramtypes.NewParamSetPair(ParamsStoreProtocolFeeMinimums, &p.ProtocolFeeMinimums, validateProtocolFeeMinimums),
+		paramtypes.NewParamSetPair(ParamsStoreProtocolFeeTreasury, &p.ProtocolFeeTreasury, validateProtocolFeeTreasury),
 	}
 }
 
@@ -437,6 +639,175 @@ func validateSlashFractionBadEthSignature(i interface{}) error {
 	return nil
 }
 
+func validateSlashFractionConflictingClaim(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("slash fraction conflicting claim must be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
+func validateSignedClaimsWindow(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateSlashFractionClaim(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("slash fraction claim must be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
+func validateJailMissedClaims(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateBadEthSignatureRewardFraction(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("bad eth signature reward fraction must be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
+func validateTransferHistoryRetention(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateDepositReceiptRetention(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateRefundFailedDeposits(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateBatchSelectionPolicy(i interface{}) error {
+	v, ok := i.(BatchSelectionPolicy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if _, found := BatchSelectionPolicy_name[int32(v)]; !found {
+		return fmt.Errorf("unknown batch selection policy: %d", v)
+	}
+	return nil
+}
+
+func validateBatchMinimumFees(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return v.Validate()
+}
+
+func validateBatchGuaranteedInclusionBlocks(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+// ValidateBasic checks that an entry of Params.EvmChains describes a usable chain
+func (c EvmChain) ValidateBasic() error {
+	if c.ChainId == 0 {
+		return fmt.Errorf("evm chain id must not be zero, zero refers to the default chain")
+	}
+	if c.GravityId == "" {
+		return fmt.Errorf("evm chain %d: gravity id must not be empty", c.ChainId)
+	}
+	if err := validateGravityID(c.GravityId); err != nil {
+		return fmt.Errorf("evm chain %d: gravity id: %s", c.ChainId, err)
+	}
+	// the bridge contract is the zero address until it is deployed
+	if err := ValidateEthAddress(c.BridgeEthereumAddress); err != nil {
+		return fmt.Errorf("evm chain %d: bridge contract address: %s", c.ChainId, err)
+	}
+	if err := validateAverageEthereumBlockTime(c.AverageEthereumBlockTime); err != nil {
+		return fmt.Errorf("evm chain %d: %s", c.ChainId, err)
+	}
+	return nil
+}
+
+func validateEvmChains(i interface{}) error {
+	v, ok := i.([]EvmChain)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	chainIDs := make(map[uint64]bool, len(v))
+	gravityIDs := make(map[string]bool, len(v))
+	for _, chain := range v {
+		if err := chain.ValidateBasic(); err != nil {
+			return err
+		}
+		// a gravity id salts the signatures of a bridge, two chains sharing one could replay each other's
+		if chainIDs[chain.ChainId] || gravityIDs[chain.GravityId] {
+			return fmt.Errorf("duplicate evm chain %d", chain.ChainId)
+		}
+		chainIDs[chain.ChainId] = true
+		gravityIDs[chain.GravityId] = true
+	}
+	return nil
+}
+
+func validateProtocolFeeBasisPoints(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v > 10000 {
+		return fmt.Errorf("protocol fee must not be more than 10000 basis points: %d", v)
+	}
+	return nil
+}
+
+func validateProtocolFeeMinimums(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return v.Validate()
+}
+
+func validateProtocolFeeTreasury(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == "" {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(v); err != nil {
+		return fmt.Errorf("invalid protocol fee treasury %s: %s", v, err)
+	}
+	return nil
+}
+
 func validateValsetRewardAmount(i interface{}) error {
 	if _, ok := i.(sdk.Coin); !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
@@ -27,7 +27,68 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // The slashing fractions for the various gravity related slashing conditions. The first three
 // refer to not submitting a particular message, the third for submitting a different claim
-// for the same Ethereum event
+// for the same Ethereum event. A zero slash_fraction_conflicting_claim only records conflicting
+// claims without slashing
+//
+// jail_missed_claims
+//
+// Whether validators slashed by slash_fraction_claim for not submitting a claim for an observed
+// event are also jailed
+//
+// bad_eth_signature_reward_fraction
+//
+// The fraction of the tokens slashed by slash_fraction_bad_eth_signature that is paid to the
+// account submitting the evidence. Zero disables the reward
+//
+// transfer_history_retention
+//
+// The number of blocks the history of a transfer to Ethereum is kept after the transfer
+// was executed or canceled
+//
+// deposit_receipt_retention
+//
+// The number of blocks the receipt of a deposit from Ethereum is kept after the deposit
+// was observed
+//
+// refund_failed_deposits
+//
+// Whether a deposit from Ethereum that can not be credited to its receiver is refunded to
+// its Ethereum sender right away, otherwise it is held in escrow until governance releases it
+//
+// batch_selection_policy
+//
+// # How the transfers of the next batch of a token are chosen from the pool, see BatchSelectionPolicy
+//
+// batch_minimum_fees
+//
+// The fee a transfer must pay to be batched under BATCH_SELECTION_POLICY_FIFO_MIN_FEE, per denom of
+// the token, in its smallest unit. Tokens without an entry have no minimum
+//
+// batch_guaranteed_inclusion_blocks
+//
+// The number of blocks after which a waiting transfer goes into the next batch of its token ahead of
+// every other transfer regardless of the selection policy, oldest first. Zero disables the guarantee
+//
+// evm_chains
+//
+// The EVM chains bridged besides the one described by bridge_ethereum_address, bridge_chain_id and
+// gravity_id. Each chain keeps its own valsets, batches, logic calls, attestations, event nonces,
+// ERC20 mappings and delegate Ethereum addresses. Chains can be added but a chain's gravity_id and
+// bridge contract must not change once its contract is deployed. Validators are only slashed for the
+// claims they miss or the conflicting claims they vote for on a chain with claim_slashing set, so that
+// orchestrators can start watching a new chain before they are punished for it
+//
+// protocol_fee_basis_points
+// protocol_fee_minimums
+//
+// The protocol fee a transfer to Ethereum pays on top of its bridge fee, in basis points of the
+// amount sent and at least the minimum listed for the denom of the amount, if any. The fee is held
+// with the transfer, refunded when the transfer is canceled and paid out once it is executed
+//
+// protocol_fee_treasury
+//
+// The account protocol fees are paid to, such as the account of a treasury module. The community
+// pool is paid when it is empty
 //
 // unbond_slashing_valsets_window
 //
@@ -46,24 +107,39 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // will be vulnerable to highjacking. For these paramaters the zero values are special and indicate
 // not to attempt any reward. This is the default for bootstrapping.
 type Params struct {
-	GravityId                    string                                 `protobuf:"bytes,1,opt,name=gravity_id,json=gravityId,proto3" json:"gravity_id,omitempty"`
-	MinimumTransferToEth         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=minimum_transfer_to_eth,json=minimumTransferToEth,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"minimum_transfer_to_eth"`
-	MinimumFeeTransferToEth      github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=minimum_fee_transfer_to_eth,json=minimumFeeTransferToEth,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"minimum_fee_transfer_to_eth"`
-	ContractSourceHash           string                                 `protobuf:"bytes,4,opt,name=contract_source_hash,json=contractSourceHash,proto3" json:"contract_source_hash,omitempty"`
-	BridgeEthereumAddress        string                                 `protobuf:"bytes,5,opt,name=bridge_ethereum_address,json=bridgeEthereumAddress,proto3" json:"bridge_ethereum_address,omitempty"`
-	BridgeChainId                uint64                                 `protobuf:"varint,6,opt,name=bridge_chain_id,json=bridgeChainId,proto3" json:"bridge_chain_id,omitempty"`
-	SignedValsetsWindow          uint64                                 `protobuf:"varint,7,opt,name=signed_valsets_window,json=signedValsetsWindow,proto3" json:"signed_valsets_window,omitempty"`
-	SignedBatchesWindow          uint64                                 `protobuf:"varint,8,opt,name=signed_batches_window,json=signedBatchesWindow,proto3" json:"signed_batches_window,omitempty"`
-	SignedLogicCallsWindow       uint64                                 `protobuf:"varint,9,opt,name=signed_logic_calls_window,json=signedLogicCallsWindow,proto3" json:"signed_logic_calls_window,omitempty"`
-	TargetBatchTimeout           uint64                                 `protobuf:"varint,10,opt,name=target_batch_timeout,json=targetBatchTimeout,proto3" json:"target_batch_timeout,omitempty"`
-	AverageBlockTime             uint64                                 `protobuf:"varint,11,opt,name=average_block_time,json=averageBlockTime,proto3" json:"average_block_time,omitempty"`
-	AverageEthereumBlockTime     uint64                                 `protobuf:"varint,12,opt,name=average_ethereum_block_time,json=averageEthereumBlockTime,proto3" json:"average_ethereum_block_time,omitempty"`
-	SlashFractionValset          github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=slash_fraction_valset,json=slashFractionValset,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_valset"`
-	SlashFractionBatch           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=slash_fraction_batch,json=slashFractionBatch,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_batch"`
-	SlashFractionLogicCall       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=slash_fraction_logic_call,json=slashFractionLogicCall,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_logic_call"`
-	UnbondSlashingValsetsWindow  uint64                                 `protobuf:"varint,16,opt,name=unbond_slashing_valsets_window,json=unbondSlashingValsetsWindow,proto3" json:"unbond_slashing_valsets_window,omitempty"`
-	SlashFractionBadEthSignature github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,17,opt,name=slash_fraction_bad_eth_signature,json=slashFractionBadEthSignature,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_bad_eth_signature"`
-	ValsetReward                 types.Coin                             `protobuf:"bytes,18,opt,name=valset_reward,json=valsetReward,proto3" json:"valset_reward"`
+	GravityId                      string                                   `protobuf:"bytes,1,opt,name=gravity_id,json=gravityId,proto3" json:"gravity_id,omitempty"`
+	MinimumTransferToEth           github_com_cosmos_cosmos_sdk_types.Int   `protobuf:"bytes,2,opt,name=minimum_transfer_to_eth,json=minimumTransferToEth,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"minimum_transfer_to_eth"`
+	MinimumFeeTransferToEth        github_com_cosmos_cosmos_sdk_types.Int   `protobuf:"bytes,3,opt,name=minimum_fee_transfer_to_eth,json=minimumFeeTransferToEth,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"minimum_fee_transfer_to_eth"`
+	ContractSourceHash             string                                   `protobuf:"bytes,4,opt,name=contract_source_hash,json=contractSourceHash,proto3" json:"contract_source_hash,omitempty"`
+	BridgeEthereumAddress          string                                   `protobuf:"bytes,5,opt,name=bridge_ethereum_address,json=bridgeEthereumAddress,proto3" json:"bridge_ethereum_address,omitempty"`
+	BridgeChainId                  uint64                                   `protobuf:"varint,6,opt,name=bridge_chain_id,json=bridgeChainId,proto3" json:"bridge_chain_id,omitempty"`
+	SignedValsetsWindow            uint64                                   `protobuf:"varint,7,opt,name=signed_valsets_window,json=signedValsetsWindow,proto3" json:"signed_valsets_window,omitempty"`
+	SignedBatchesWindow            uint64                                   `protobuf:"varint,8,opt,name=signed_batches_window,json=signedBatchesWindow,proto3" json:"signed_batches_window,omitempty"`
+	SignedLogicCallsWindow         uint64                                   `protobuf:"varint,9,opt,name=signed_logic_calls_window,json=signedLogicCallsWindow,proto3" json:"signed_logic_calls_window,omitempty"`
+	TargetBatchTimeout             uint64                                   `protobuf:"varint,10,opt,name=target_batch_timeout,json=targetBatchTimeout,proto3" json:"target_batch_timeout,omitempty"`
+	AverageBlockTime               uint64                                   `protobuf:"varint,11,opt,name=average_block_time,json=averageBlockTime,proto3" json:"average_block_time,omitempty"`
+	AverageEthereumBlockTime       uint64                                   `protobuf:"varint,12,opt,name=average_ethereum_block_time,json=averageEthereumBlockTime,proto3" json:"average_ethereum_block_time,omitempty"`
+	SlashFractionValset            github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,13,opt,name=slash_fraction_valset,json=slashFractionValset,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_valset"`
+	SlashFractionBatch             github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,14,opt,name=slash_fraction_batch,json=slashFractionBatch,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_batch"`
+	SlashFractionLogicCall         github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,15,opt,name=slash_fraction_logic_call,json=slashFractionLogicCall,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_logic_call"`
+	UnbondSlashingValsetsWindow    uint64                                   `protobuf:"varint,16,opt,name=unbond_slashing_valsets_window,json=unbondSlashingValsetsWindow,proto3" json:"unbond_slashing_valsets_window,omitempty"`
+	SlashFractionBadEthSignature   github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,17,opt,name=slash_fraction_bad_eth_signature,json=slashFractionBadEthSignature,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_bad_eth_signature"`
+	ValsetReward                   types.Coin                               `protobuf:"bytes,18,opt,name=valset_reward,json=valsetReward,proto3" json:"valset_reward"`
+	SlashFractionConflictingClaim  github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,19,opt,name=slash_fraction_conflicting_claim,json=slashFractionConflictingClaim,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_conflicting_claim"`
+	SignedClaimsWindow             uint64                                   `protobuf:"varint,20,opt,name=signed_claims_window,json=signedClaimsWindow,proto3" json:"signed_claims_window,omitempty"`
+	SlashFractionClaim             github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,21,opt,name=slash_fraction_claim,json=slashFractionClaim,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_claim"`
+	JailMissedClaims               bool                                     `protobuf:"varint,22,opt,name=jail_missed_claims,json=jailMissedClaims,proto3" json:"jail_missed_claims,omitempty"`
+	BadEthSignatureRewardFraction  github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,23,opt,name=bad_eth_signature_reward_fraction,json=badEthSignatureRewardFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bad_eth_signature_reward_fraction"`
+	TransferHistoryRetention       uint64                                   `protobuf:"varint,24,opt,name=transfer_history_retention,json=transferHistoryRetention,proto3" json:"transfer_history_retention,omitempty"`
+	DepositReceiptRetention        uint64                                   `protobuf:"varint,25,opt,name=deposit_receipt_retention,json=depositReceiptRetention,proto3" json:"deposit_receipt_retention,omitempty"`
+	RefundFailedDeposits           bool                                     `protobuf:"varint,26,opt,name=refund_failed_deposits,json=refundFailedDeposits,proto3" json:"refund_failed_deposits,omitempty"`
+	BatchSelectionPolicy           BatchSelectionPolicy                     `protobuf:"varint,27,opt,name=batch_selection_policy,json=batchSelectionPolicy,proto3,enum=gravity.v1.BatchSelectionPolicy" json:"batch_selection_policy,omitempty"`
+	BatchMinimumFees               github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,28,rep,name=batch_minimum_fees,json=batchMinimumFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"batch_minimum_fees"`
+	BatchGuaranteedInclusionBlocks uint64                                   `protobuf:"varint,29,opt,name=batch_guaranteed_inclusion_blocks,json=batchGuaranteedInclusionBlocks,proto3" json:"batch_guaranteed_inclusion_blocks,omitempty"`
+	EvmChains                      []EvmChain                               `protobuf:"bytes,30,rep,name=evm_chains,json=evmChains,proto3" json:"evm_chains"`
+	ProtocolFeeBasisPoints         uint64                                   `protobuf:"varint,31,opt,name=protocol_fee_basis_points,json=protocolFeeBasisPoints,proto3" json:"protocol_fee_basis_points,omitempty"`
+	ProtocolFeeMinimums            github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,32,rep,name=protocol_fee_minimums,json=protocolFeeMinimums,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"protocol_fee_minimums"`
+	ProtocolFeeTreasury            string                                   `protobuf:"bytes,33,opt,name=protocol_fee_treasury,json=protocolFeeTreasury,proto3" json:"protocol_fee_treasury,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -183,35 +259,215 @@ func (m *Params) GetValsetReward() types.Coin {
 	return types.Coin{}
 }
 
+func (m *Params) GetSignedClaimsWindow() uint64 {
+	if m != nil {
+		return m.SignedClaimsWindow
+	}
+	return 0
+}
+
+func (m *Params) GetJailMissedClaims() bool {
+	if m != nil {
+		return m.JailMissedClaims
+	}
+	return false
+}
+
+func (m *Params) GetTransferHistoryRetention() uint64 {
+	if m != nil {
+		return m.TransferHistoryRetention
+	}
+	return 0
+}
+
+func (m *Params) GetDepositReceiptRetention() uint64 {
+	if m != nil {
+		return m.DepositReceiptRetention
+	}
+	return 0
+}
+
+func (m *Params) GetRefundFailedDeposits() bool {
+	if m != nil {
+		return m.RefundFailedDeposits
+	}
+	return false
+}
+
+func (m *Params) GetBatchSelectionPolicy() BatchSelectionPolicy {
+	if m != nil {
+		return m.BatchSelectionPolicy
+	}
+	return BATCH_SELECTION_POLICY_FEE_PRIORITY
+}
+
+func (m *Params) GetBatchMinimumFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BatchMinimumFees
+	}
+	return nil
+}
+
+func (m *Params) GetBatchGuaranteedInclusionBlocks() uint64 {
+	if m != nil {
+		return m.BatchGuaranteedInclusionBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetEvmChains() []EvmChain {
+	if m != nil {
+		return m.EvmChains
+	}
+	return nil
+}
+
+func (m *Params) GetProtocolFeeBasisPoints() uint64 {
+	if m != nil {
+		return m.ProtocolFeeBasisPoints
+	}
+	return 0
+}
+
+func (m *Params) GetProtocolFeeMinimums() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ProtocolFeeMinimums
+	}
+	return nil
+}
+
+func (m *Params) GetProtocolFeeTreasury() string {
+	if m != nil {
+		return m.ProtocolFeeTreasury
+	}
+	return ""
+}
+
+// EvmChain describes an EVM chain bridged besides the default one, the chain
+// is referred to by its chain_id in messages and queries
+type EvmChain struct {
+	ChainId                  uint64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	BridgeEthereumAddress    string `protobuf:"bytes,2,opt,name=bridge_ethereum_address,json=bridgeEthereumAddress,proto3" json:"bridge_ethereum_address,omitempty"`
+	GravityId                string `protobuf:"bytes,3,opt,name=gravity_id,json=gravityId,proto3" json:"gravity_id,omitempty"`
+	AverageEthereumBlockTime uint64 `protobuf:"varint,4,opt,name=average_ethereum_block_time,json=averageEthereumBlockTime,proto3" json:"average_ethereum_block_time,omitempty"`
+	ClaimSlashing            bool   `protobuf:"varint,5,opt,name=claim_slashing,json=claimSlashing,proto3" json:"claim_slashing,omitempty"`
+}
+
+func (m *EvmChain) Reset()         { *m = EvmChain{} }
+func (m *EvmChain) String() string { return proto.CompactTextString(m) }
+func (*EvmChain) ProtoMessage()    {}
+func (*EvmChain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{1}
+}
+func (m *EvmChain) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EvmChain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvmChain.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EvmChain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmChain.Merge(m, src)
+}
+func (m *EvmChain) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvmChain) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmChain.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmChain proto.InternalMessageInfo
+
+func (m *EvmChain) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *EvmChain) GetBridgeEthereumAddress() string {
+	if m != nil {
+		return m.BridgeEthereumAddress
+	}
+	return ""
+}
+
+func (m *EvmChain) GetGravityId() string {
+	if m != nil {
+		return m.GravityId
+	}
+	return ""
+}
+
+func (m *EvmChain) GetAverageEthereumBlockTime() uint64 {
+	if m != nil {
+		return m.AverageEthereumBlockTime
+	}
+	return 0
+}
+
+func (m *EvmChain) GetClaimSlashing() bool {
+	if m != nil {
+		return m.ClaimSlashing
+	}
+	return false
+}
+
 // GenesisState struct
 type GenesisState struct {
-	Params                    *Params                      `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	LastObservedNonce         uint64                       `protobuf:"varint,2,opt,name=last_observed_nonce,json=lastObservedNonce,proto3" json:"last_observed_nonce,omitempty"`
-	Valsets                   []*Valset                    `protobuf:"bytes,3,rep,name=valsets,proto3" json:"valsets,omitempty"`
-	ValsetConfirms            []*MsgValsetConfirm          `protobuf:"bytes,4,rep,name=valset_confirms,json=valsetConfirms,proto3" json:"valset_confirms,omitempty"`
-	Batches                   []*OutgoingTxBatch           `protobuf:"bytes,5,rep,name=batches,proto3" json:"batches,omitempty"`
-	BatchConfirms             []MsgConfirmBatch            `protobuf:"bytes,6,rep,name=batch_confirms,json=batchConfirms,proto3" json:"batch_confirms"`
-	LogicCalls                []*OutgoingLogicCall         `protobuf:"bytes,7,rep,name=logic_calls,json=logicCalls,proto3" json:"logic_calls,omitempty"`
-	LogicCallConfirms         []MsgConfirmLogicCall        `protobuf:"bytes,8,rep,name=logic_call_confirms,json=logicCallConfirms,proto3" json:"logic_call_confirms"`
-	Attestations              []Attestation                `protobuf:"bytes,9,rep,name=attestations,proto3" json:"attestations"`
-	DelegateKeys              []*MsgSetOrchestratorAddress `protobuf:"bytes,10,rep,name=delegate_keys,json=delegateKeys,proto3" json:"delegate_keys,omitempty"`
-	Erc20ToDenoms             []*ERC20ToDenom              `protobuf:"bytes,11,rep,name=erc20_to_denoms,json=erc20ToDenoms,proto3" json:"erc20_to_denoms,omitempty"`
-	UnbatchedTransfers        []*OutgoingTransferTx        `protobuf:"bytes,12,rep,name=unbatched_transfers,json=unbatchedTransfers,proto3" json:"unbatched_transfers,omitempty"`
-	LastTxPoolId              uint64                       `protobuf:"varint,13,opt,name=last_tx_pool_id,json=lastTxPoolId,proto3" json:"last_tx_pool_id,omitempty"`
-	LastOutgoingBatchId       uint64                       `protobuf:"varint,14,opt,name=last_outgoing_batch_id,json=lastOutgoingBatchId,proto3" json:"last_outgoing_batch_id,omitempty"`
-	LastSlashedLogicCallBlock uint64                       `protobuf:"varint,15,opt,name=last_slashed_logic_call_block,json=lastSlashedLogicCallBlock,proto3" json:"last_slashed_logic_call_block,omitempty"`
-	LastSlashedBatchedBlock   uint64                       `protobuf:"varint,16,opt,name=last_slashed_batched_block,json=lastSlashedBatchedBlock,proto3" json:"last_slashed_batched_block,omitempty"`
-	LastSlashedValsetNonce    uint64                       `protobuf:"varint,17,opt,name=last_slashed_valset_nonce,json=lastSlashedValsetNonce,proto3" json:"last_slashed_valset_nonce,omitempty"`
-	LastUnBondingBlockHeight  uint64                       `protobuf:"varint,18,opt,name=last_un_bonding_block_height,json=lastUnBondingBlockHeight,proto3" json:"last_un_bonding_block_height,omitempty"`
-	LastLatestValsetNonce     uint64                       `protobuf:"varint,19,opt,name=last_latest_valset_nonce,json=lastLatestValsetNonce,proto3" json:"last_latest_valset_nonce,omitempty"`
-	StaticValCosmosAddrs      []string                     `protobuf:"bytes,20,rep,name=static_val_cosmos_addrs,json=staticValCosmosAddrs,proto3" json:"static_val_cosmos_addrs,omitempty"`
+	Params                          *Params                                  `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	LastObservedNonce               uint64                                   `protobuf:"varint,2,opt,name=last_observed_nonce,json=lastObservedNonce,proto3" json:"last_observed_nonce,omitempty"`
+	Valsets                         []*Valset                                `protobuf:"bytes,3,rep,name=valsets,proto3" json:"valsets,omitempty"`
+	ValsetConfirms                  []*MsgValsetConfirm                      `protobuf:"bytes,4,rep,name=valset_confirms,json=valsetConfirms,proto3" json:"valset_confirms,omitempty"`
+	Batches                         []*OutgoingTxBatch                       `protobuf:"bytes,5,rep,name=batches,proto3" json:"batches,omitempty"`
+	BatchConfirms                   []MsgConfirmBatch                        `protobuf:"bytes,6,rep,name=batch_confirms,json=batchConfirms,proto3" json:"batch_confirms"`
+	LogicCalls                      []*OutgoingLogicCall                     `protobuf:"bytes,7,rep,name=logic_calls,json=logicCalls,proto3" json:"logic_calls,omitempty"`
+	LogicCallConfirms               []MsgConfirmLogicCall                    `protobuf:"bytes,8,rep,name=logic_call_confirms,json=logicCallConfirms,proto3" json:"logic_call_confirms"`
+	Attestations                    []Attestation                            `protobuf:"bytes,9,rep,name=attestations,proto3" json:"attestations"`
+	DelegateKeys                    []*MsgSetOrchestratorAddress             `protobuf:"bytes,10,rep,name=delegate_keys,json=delegateKeys,proto3" json:"delegate_keys,omitempty"`
+	Erc20ToDenoms                   []*ERC20ToDenom                          `protobuf:"bytes,11,rep,name=erc20_to_denoms,json=erc20ToDenoms,proto3" json:"erc20_to_denoms,omitempty"`
+	UnbatchedTransfers              []*OutgoingTransferTx                    `protobuf:"bytes,12,rep,name=unbatched_transfers,json=unbatchedTransfers,proto3" json:"unbatched_transfers,omitempty"`
+	LastTxPoolId                    uint64                                   `protobuf:"varint,13,opt,name=last_tx_pool_id,json=lastTxPoolId,proto3" json:"last_tx_pool_id,omitempty"`
+	LastOutgoingBatchId             uint64                                   `protobuf:"varint,14,opt,name=last_outgoing_batch_id,json=lastOutgoingBatchId,proto3" json:"last_outgoing_batch_id,omitempty"`
+	LastSlashedLogicCallBlock       uint64                                   `protobuf:"varint,15,opt,name=last_slashed_logic_call_block,json=lastSlashedLogicCallBlock,proto3" json:"last_slashed_logic_call_block,omitempty"`
+	LastSlashedBatchedBlock         uint64                                   `protobuf:"varint,16,opt,name=last_slashed_batched_block,json=lastSlashedBatchedBlock,proto3" json:"last_slashed_batched_block,omitempty"`
+	LastSlashedValsetNonce          uint64                                   `protobuf:"varint,17,opt,name=last_slashed_valset_nonce,json=lastSlashedValsetNonce,proto3" json:"last_slashed_valset_nonce,omitempty"`
+	LastUnBondingBlockHeight        uint64                                   `protobuf:"varint,18,opt,name=last_un_bonding_block_height,json=lastUnBondingBlockHeight,proto3" json:"last_un_bonding_block_height,omitempty"`
+	LastLatestValsetNonce           uint64                                   `protobuf:"varint,19,opt,name=last_latest_valset_nonce,json=lastLatestValsetNonce,proto3" json:"last_latest_valset_nonce,omitempty"`
+	StaticValCosmosAddrs            []string                                 `protobuf:"bytes,20,rep,name=static_val_cosmos_addrs,json=staticValCosmosAddrs,proto3" json:"static_val_cosmos_addrs,omitempty"`
+	BridgeHijackIncidents           []*BridgeHijackIncident                  `protobuf:"bytes,21,rep,name=bridge_hijack_incidents,json=bridgeHijackIncidents,proto3" json:"bridge_hijack_incidents,omitempty"`
+	ConflictingClaims               []*ConflictingClaim                      `protobuf:"bytes,22,rep,name=conflicting_claims,json=conflictingClaims,proto3" json:"conflicting_claims,omitempty"`
+	LastSlashedClaimNonce           uint64                                   `protobuf:"varint,23,opt,name=last_slashed_claim_nonce,json=lastSlashedClaimNonce,proto3" json:"last_slashed_claim_nonce,omitempty"`
+	BadSignatureEvidence            []*BadSignatureEvidence                  `protobuf:"bytes,24,rep,name=bad_signature_evidence,json=badSignatureEvidence,proto3" json:"bad_signature_evidence,omitempty"`
+	PastEthSignatureCheckpoints     [][]byte                                 `protobuf:"bytes,25,rep,name=past_eth_signature_checkpoints,json=pastEthSignatureCheckpoints,proto3" json:"past_eth_signature_checkpoints,omitempty"`
+	LastObservedEthereumBlockHeight LastObservedEthereumBlockHeight          `protobuf:"bytes,26,opt,name=last_observed_ethereum_block_height,json=lastObservedEthereumBlockHeight,proto3" json:"last_observed_ethereum_block_height"`
+	LastObservedValset              *Valset                                  `protobuf:"bytes,27,opt,name=last_observed_valset,json=lastObservedValset,proto3" json:"last_observed_valset,omitempty"`
+	LastEventNoncesByValidator      []*ValidatorEventNonce                   `protobuf:"bytes,28,rep,name=last_event_nonces_by_validator,json=lastEventNoncesByValidator,proto3" json:"last_event_nonces_by_validator,omitempty"`
+	TransferHistory                 []*TransferHistory                       `protobuf:"bytes,29,rep,name=transfer_history,json=transferHistory,proto3" json:"transfer_history,omitempty"`
+	DepositReceipts                 []*DepositReceipt                        `protobuf:"bytes,30,rep,name=deposit_receipts,json=depositReceipts,proto3" json:"deposit_receipts,omitempty"`
+	FailedDeposits                  []*DepositReceipt                        `protobuf:"bytes,31,rep,name=failed_deposits,json=failedDeposits,proto3" json:"failed_deposits,omitempty"`
+	EthereumHeightVotes             []*EthereumHeightVote                    `protobuf:"bytes,32,rep,name=ethereum_height_votes,json=ethereumHeightVotes,proto3" json:"ethereum_height_votes,omitempty"`
+	QueuedTransferHeights           []*QueuedTransferHeight                  `protobuf:"bytes,33,rep,name=queued_transfer_heights,json=queuedTransferHeights,proto3" json:"queued_transfer_heights,omitempty"`
+	EvmChainStates                  []*EvmChainGenesisState                  `protobuf:"bytes,34,rep,name=evm_chain_states,json=evmChainStates,proto3" json:"evm_chain_states,omitempty"`
+	Blocklist                       []string                                 `protobuf:"bytes,35,rep,name=blocklist,proto3" json:"blocklist,omitempty"`
+	QuarantinedDeposits             []*DepositReceipt                        `protobuf:"bytes,36,rep,name=quarantined_deposits,json=quarantinedDeposits,proto3" json:"quarantined_deposits,omitempty"`
+	LastConflictCheckedNonce        uint64                                   `protobuf:"varint,37,opt,name=last_conflict_checked_nonce,json=lastConflictCheckedNonce,proto3" json:"last_conflict_checked_nonce,omitempty"`
+	CosmosOriginatedLocked          github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,38,rep,name=cosmos_originated_locked,json=cosmosOriginatedLocked,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"cosmos_originated_locked"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_387b0aba880adb60, []int{1}
+	return fileDescriptor_387b0aba880adb60, []int{2}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -380,256 +636,390 @@ func (m *GenesisState) GetStaticValCosmosAddrs() []string {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "gravity.v1.Params")
-	proto.RegisterType((*GenesisState)(nil), "gravity.v1.GenesisState")
+func (m *GenesisState) GetBridgeHijackIncidents() []*BridgeHijackIncident {
+	if m != nil {
+		return m.BridgeHijackIncidents
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("gravity/v1/genesis.proto", fileDescriptor_387b0aba880adb60) }
+func (m *GenesisState) GetConflictingClaims() []*ConflictingClaim {
+	if m != nil {
+		return m.ConflictingClaims
+	}
+	return nil
+}
 
-var fileDescriptor_387b0aba880adb60 = []byte{
-	// 1236 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x5f, 0x4f, 0x1b, 0xc7,
-	0x17, 0xc5, 0x3f, 0x1c, 0x88, 0x07, 0x3b, 0x84, 0xb1, 0x81, 0xe1, 0x4f, 0x1c, 0x2b, 0x52, 0x22,
-	0xf4, 0x53, 0xb0, 0x81, 0x28, 0xad, 0xd2, 0xaa, 0x51, 0xb0, 0x21, 0x85, 0x36, 0x29, 0xd1, 0xda,
-	0x49, 0xa5, 0xaa, 0xd2, 0x76, 0xbc, 0x3b, 0xec, 0xae, 0xd8, 0xdd, 0x41, 0x3b, 0x63, 0x03, 0x6f,
-	0x7d, 0xee, 0x53, 0x3f, 0x56, 0x1e, 0xf3, 0x58, 0x55, 0x55, 0x5a, 0xc1, 0x17, 0xa9, 0xe6, 0xce,
-	0xec, 0x7a, 0x6d, 0x78, 0x29, 0x4f, 0xe0, 0x39, 0xe7, 0xdc, 0x7b, 0xe7, 0xce, 0x9d, 0x33, 0x8b,
-	0x88, 0x97, 0xd0, 0x61, 0x20, 0x2f, 0x5a, 0xc3, 0xed, 0x96, 0xc7, 0x62, 0x26, 0x02, 0xd1, 0x3c,
-	0x4d, 0xb8, 0xe4, 0x18, 0x19, 0xa4, 0x39, 0xdc, 0x5e, 0xad, 0x79, 0xdc, 0xe3, 0xb0, 0xdc, 0x52,
-	0xff, 0x69, 0xc6, 0xea, 0x52, 0x4e, 0x2b, 0x2f, 0x4e, 0x99, 0x51, 0xae, 0x2e, 0xe6, 0xd6, 0x23,
-	0xe1, 0x89, 0x1b, 0xe8, 0x7d, 0x2a, 0x1d, 0xdf, 0xac, 0xaf, 0xe7, 0xd6, 0xa9, 0x94, 0x4c, 0x48,
-	0x2a, 0x03, 0x1e, 0x1b, 0xb4, 0xee, 0x70, 0x11, 0x71, 0xd1, 0xea, 0x53, 0xc1, 0x5a, 0xc3, 0xed,
-	0x3e, 0x93, 0x74, 0xbb, 0xe5, 0xf0, 0xc0, 0xe0, 0x8f, 0x7e, 0x43, 0x68, 0xe6, 0x1d, 0x4d, 0x68,
-	0x24, 0xf0, 0x03, 0x94, 0xd6, 0x6c, 0x07, 0x2e, 0x29, 0x34, 0x0a, 0x1b, 0x25, 0xab, 0x64, 0x56,
-	0x0e, 0x5d, 0xcc, 0xd0, 0x72, 0x14, 0xc4, 0x41, 0x34, 0x88, 0x6c, 0x99, 0xd0, 0x58, 0x1c, 0xb3,
-	0xc4, 0x96, 0xdc, 0x66, 0xd2, 0x27, 0xff, 0x53, 0xdc, 0x76, 0xf3, 0xe3, 0xe7, 0x87, 0x53, 0x7f,
-	0x7e, 0x7e, 0xf8, 0xc4, 0x0b, 0xa4, 0x3f, 0xe8, 0x37, 0x1d, 0x1e, 0xb5, 0x4c, 0x76, 0xfd, 0x67,
-	0x53, 0xb8, 0x27, 0x66, 0xa7, 0x87, 0xb1, 0xb4, 0x6a, 0x26, 0x5c, 0xcf, 0x44, 0xeb, 0xf1, 0x7d,
-	0xe9, 0xe3, 0x10, 0xad, 0xa5, 0x69, 0x8e, 0x19, 0xbb, 0x96, 0x6a, 0xfa, 0x56, 0xa9, 0xd2, 0xca,
-	0x5f, 0x33, 0x36, 0x9e, 0x6d, 0x0b, 0xd5, 0x1c, 0x1e, 0xcb, 0x84, 0x3a, 0xd2, 0x16, 0x7c, 0x90,
-	0x38, 0xcc, 0xf6, 0xa9, 0xf0, 0x49, 0x11, 0x76, 0x8f, 0x53, 0xac, 0x0b, 0xd0, 0x01, 0x15, 0x3e,
-	0xfe, 0x02, 0x2d, 0xf7, 0x93, 0xc0, 0xf5, 0x98, 0x2a, 0x87, 0x25, 0x6c, 0x10, 0xd9, 0xd4, 0x75,
-	0x13, 0x26, 0x04, 0xb9, 0x03, 0xa2, 0x45, 0x0d, 0xef, 0x1b, 0x74, 0x57, 0x83, 0xf8, 0x09, 0x9a,
-	0x37, 0x3a, 0xc7, 0xa7, 0x41, 0xac, 0x5a, 0x3c, 0xd3, 0x28, 0x6c, 0x14, 0xad, 0x8a, 0x5e, 0xee,
-	0xa8, 0xd5, 0x43, 0x17, 0xef, 0xa0, 0x45, 0x11, 0x78, 0x31, 0x73, 0xed, 0x21, 0x0d, 0x05, 0x93,
-	0xc2, 0x3e, 0x0b, 0x62, 0x97, 0x9f, 0x91, 0x59, 0x60, 0x57, 0x35, 0xf8, 0x41, 0x63, 0x3f, 0x02,
-	0x94, 0xd3, 0xc0, 0x60, 0xb0, 0x4c, 0x73, 0x37, 0xaf, 0x69, 0x6b, 0xcc, 0x68, 0x5e, 0xa0, 0x15,
-	0xa3, 0x09, 0xb9, 0x17, 0x38, 0xb6, 0x43, 0xc3, 0x30, 0xd3, 0x95, 0x40, 0xb7, 0xa4, 0x09, 0x6f,
-	0x14, 0xde, 0x51, 0xb0, 0x91, 0x6e, 0xa1, 0x9a, 0xa4, 0x89, 0xc7, 0xa4, 0x4e, 0x67, 0xcb, 0x20,
-	0x62, 0x7c, 0x20, 0x09, 0x02, 0x15, 0xd6, 0x18, 0x64, 0xeb, 0x69, 0x04, 0x3f, 0x45, 0x98, 0x0e,
-	0x59, 0x42, 0x3d, 0x66, 0xf7, 0x43, 0xee, 0x9c, 0x80, 0x84, 0xcc, 0x01, 0xff, 0xbe, 0x41, 0xda,
-	0x0a, 0x50, 0x02, 0xfc, 0x0d, 0x5a, 0x4b, 0xd9, 0x59, 0x8f, 0x73, 0xb2, 0x32, 0xc8, 0x88, 0xa1,
-	0xa4, 0x7d, 0x1e, 0xc9, 0xfb, 0x68, 0x51, 0x84, 0x54, 0xf8, 0xf6, 0xb1, 0x3a, 0xba, 0x80, 0xc7,
-	0xa6, 0x93, 0xa4, 0xd2, 0x28, 0x6c, 0x94, 0xff, 0xd3, 0xec, 0xec, 0x31, 0xc7, 0xaa, 0x42, 0xb0,
-	0xd7, 0x26, 0x96, 0x6e, 0x3c, 0xfe, 0x05, 0xd5, 0x26, 0x72, 0x40, 0x2b, 0xc8, 0xbd, 0x5b, 0xa5,
-	0xc0, 0x63, 0x29, 0xa0, 0x73, 0x38, 0x40, 0x2b, 0x13, 0x19, 0x46, 0xe7, 0x44, 0xe6, 0x6f, 0x95,
-	0x66, 0x69, 0x2c, 0x4d, 0x76, 0xac, 0xb8, 0x83, 0xea, 0x83, 0xb8, 0xcf, 0x63, 0xd7, 0x06, 0x42,
-	0x10, 0x7b, 0x93, 0xb3, 0x77, 0x1f, 0x5a, 0xbe, 0xa6, 0x59, 0x5d, 0x43, 0x1a, 0x9f, 0xc1, 0x21,
-	0x6a, 0x5c, 0xeb, 0x88, 0xab, 0xce, 0xcf, 0x56, 0x53, 0x44, 0xe5, 0x20, 0x61, 0x64, 0xe1, 0x56,
-	0x65, 0xaf, 0x4f, 0x74, 0xc7, 0xdd, 0x97, 0x7e, 0x37, 0x8d, 0x89, 0xf7, 0x50, 0x45, 0x17, 0x6b,
-	0x27, 0xec, 0x8c, 0x26, 0x2e, 0xc1, 0x8d, 0xc2, 0xc6, 0xdc, 0xce, 0x4a, 0x53, 0xc7, 0x6a, 0x2a,
-	0xe3, 0x6b, 0x1a, 0xe3, 0x6b, 0x76, 0x78, 0x10, 0xb7, 0x8b, 0x2a, 0xbf, 0x55, 0xd6, 0x2a, 0x0b,
-	0x44, 0x5f, 0x15, 0x7f, 0xfd, 0xab, 0x31, 0xf5, 0xe8, 0xef, 0x12, 0x2a, 0x7f, 0xab, 0x5d, 0xbc,
-	0x2b, 0xa9, 0x64, 0xf8, 0xff, 0x68, 0xe6, 0x14, 0xcc, 0x11, 0xec, 0x70, 0x6e, 0x07, 0x37, 0x47,
-	0xae, 0xde, 0xd4, 0xb6, 0x69, 0x19, 0x06, 0x6e, 0xa2, 0x6a, 0x48, 0x85, 0xb4, 0x79, 0x5f, 0xb0,
-	0x64, 0xc8, 0x5c, 0x3b, 0xe6, 0xb1, 0xc3, 0xc0, 0x1b, 0x8b, 0xd6, 0x82, 0x82, 0x8e, 0x0c, 0xf2,
-	0x83, 0x02, 0xf0, 0x53, 0x34, 0x6b, 0xba, 0x4c, 0xa6, 0x1b, 0xd3, 0x93, 0xc1, 0x75, 0x73, 0xad,
-	0x94, 0x82, 0xf7, 0xd1, 0xbc, 0xd9, 0xa6, 0xc3, 0xe3, 0xe3, 0x20, 0x89, 0x04, 0x29, 0x82, 0x6a,
-	0x3d, 0xaf, 0x7a, 0x2b, 0xcc, 0xa9, 0x74, 0x34, 0xc9, 0xba, 0x37, 0xcc, 0xff, 0x14, 0xf8, 0x39,
-	0x9a, 0x35, 0x16, 0x41, 0xee, 0x80, 0x7c, 0x2d, 0x2f, 0x3f, 0x1a, 0x48, 0x8f, 0x07, 0xb1, 0xd7,
-	0x3b, 0x87, 0x19, 0xb4, 0x52, 0x2e, 0x3e, 0x40, 0xf7, 0xf4, 0x55, 0xcf, 0x92, 0xcf, 0x5c, 0x57,
-	0xbf, 0x15, 0x9e, 0xc9, 0x03, 0x6a, 0xd3, 0xe7, 0x0a, 0x08, 0xb3, 0x02, 0x5e, 0xa2, 0xb9, 0x9c,
-	0xdf, 0x90, 0x59, 0x08, 0xf3, 0xe0, 0xa6, 0x22, 0xb2, 0xf9, 0xb4, 0x50, 0x98, 0x39, 0x10, 0x7e,
-	0x8f, 0xaa, 0x23, 0xfd, 0xa8, 0x9c, 0xbb, 0x10, 0xe7, 0xe1, 0xcd, 0xe5, 0x64, 0x91, 0x4c, 0x49,
-	0x0b, 0x59, 0xbc, 0xac, 0xac, 0x5d, 0x54, 0xce, 0xbd, 0x9d, 0x82, 0x94, 0x20, 0xde, 0x72, 0x3e,
-	0xde, 0xee, 0x08, 0x4f, 0x47, 0x28, 0x2f, 0xc1, 0xdf, 0xa1, 0x8a, 0xcb, 0x42, 0xe6, 0x51, 0xc9,
-	0xec, 0x13, 0x76, 0x21, 0x08, 0x82, 0x18, 0x8f, 0x27, 0x6a, 0xea, 0x32, 0x79, 0x94, 0xa8, 0xa6,
-	0xca, 0x84, 0x4a, 0x9e, 0x98, 0xe7, 0xc1, 0x2a, 0xa7, 0xda, 0xef, 0xd9, 0x85, 0xc0, 0xaf, 0xd0,
-	0x3c, 0x4b, 0x9c, 0x9d, 0x2d, 0xf5, 0xea, 0xb9, 0x2c, 0xe6, 0x91, 0x20, 0x73, 0x10, 0x8d, 0xe4,
-	0xa3, 0xed, 0x5b, 0x9d, 0x9d, 0xad, 0x1e, 0xdf, 0x53, 0x04, 0xab, 0x02, 0x02, 0xf3, 0x4b, 0xe0,
-	0x23, 0x54, 0x1d, 0xc4, 0xfa, 0xf8, 0xdc, 0xec, 0x11, 0x15, 0xa4, 0x0c, 0x51, 0xea, 0x37, 0x1e,
-	0x7a, 0xfa, 0x30, 0x9e, 0x5b, 0x38, 0x93, 0xa6, 0x8b, 0x02, 0x3f, 0x46, 0xf3, 0x30, 0xde, 0xf2,
-	0xdc, 0x3e, 0xe5, 0x3c, 0x54, 0xef, 0x57, 0x05, 0x46, 0xbb, 0xac, 0x96, 0x7b, 0xe7, 0xef, 0x38,
-	0x0f, 0x0f, 0x5d, 0xfc, 0x0c, 0x2d, 0xe9, 0x5b, 0x60, 0xa2, 0x9a, 0x27, 0x22, 0x70, 0xc1, 0x1a,
-	0x8b, 0x16, 0xdc, 0x91, 0x34, 0x25, 0xcc, 0xc9, 0xa1, 0x8b, 0x5f, 0xa1, 0x07, 0x20, 0x82, 0x8b,
-	0x3e, 0xf6, 0x22, 0x69, 0xdf, 0x07, 0xbf, 0x2b, 0x5a, 0x2b, 0x8a, 0xd4, 0xd5, 0x9c, 0xd1, 0x99,
-	0x2a, 0x02, 0xfe, 0x1a, 0xad, 0x8e, 0x45, 0x48, 0x77, 0xae, 0xe5, 0xda, 0xbe, 0x96, 0x73, 0x72,
-	0xfd, 0x16, 0xba, 0x5a, 0xfc, 0x02, 0xad, 0x8c, 0x89, 0xcd, 0x45, 0xd3, 0xf7, 0x77, 0x41, 0x3f,
-	0x85, 0x39, 0xad, 0xbe, 0x61, 0xfa, 0x12, 0xbf, 0x44, 0xeb, 0x20, 0x1d, 0xc4, 0xb6, 0xb2, 0x46,
-	0xd8, 0x30, 0xbc, 0x54, 0x3e, 0x0b, 0x3c, 0x5f, 0x82, 0x19, 0x15, 0x2d, 0xa2, 0x38, 0xef, 0xe3,
-	0xb6, 0x66, 0x40, 0xd2, 0x03, 0xc0, 0xf1, 0x97, 0x08, 0x30, 0x3b, 0xa4, 0x6a, 0x92, 0xc6, 0x33,
-	0x57, 0x41, 0xbb, 0xa8, 0xf0, 0x37, 0x00, 0xe7, 0x13, 0x3f, 0x47, 0xcb, 0x30, 0x79, 0x8e, 0xd2,
-	0xd8, 0xda, 0xeb, 0xe0, 0x43, 0x44, 0x90, 0x5a, 0x63, 0x7a, 0xa3, 0x64, 0xd5, 0x34, 0xfc, 0x81,
-	0x86, 0x1d, 0x00, 0xd5, 0xa0, 0x89, 0xf6, 0xcf, 0x1f, 0x2f, 0xeb, 0x85, 0x4f, 0x97, 0xf5, 0xc2,
-	0x3f, 0x97, 0xf5, 0xc2, 0xef, 0x57, 0xf5, 0xa9, 0x4f, 0x57, 0xf5, 0xa9, 0x3f, 0xae, 0xea, 0x53,
-	0x3f, 0xb5, 0x73, 0x6e, 0x4c, 0x43, 0xe9, 0x33, 0xba, 0x19, 0x33, 0x99, 0x3a, 0xb2, 0x99, 0x97,
-	0x4d, 0xfd, 0xad, 0xd2, 0x8a, 0xb8, 0x3b, 0x08, 0x59, 0xeb, 0xbc, 0x95, 0x7e, 0x7b, 0x82, 0x5b,
-	0xf7, 0x67, 0xe0, 0x9b, 0xf2, 0xd9, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x08, 0x45, 0x09, 0x2e,
-	0x16, 0x0b, 0x00, 0x00,
+func (m *GenesisState) GetLastSlashedClaimNonce() uint64 {
+	if m != nil {
+		return m.LastSlashedClaimNonce
+	}
+	return 0
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GenesisState) GetBadSignatureEvidence() []*BadSignatureEvidence {
+	if m != nil {
+		return m.BadSignatureEvidence
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GenesisState) GetPastEthSignatureCheckpoints() [][]byte {
+	if m != nil {
+		return m.PastEthSignatureCheckpoints
+	}
+	return nil
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.ValsetReward.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+func (m *GenesisState) GetLastObservedEthereumBlockHeight() LastObservedEthereumBlockHeight {
+	if m != nil {
+		return m.LastObservedEthereumBlockHeight
 	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x92
-	{
-		size := m.SlashFractionBadEthSignature.Size()
-		i -= size
-		if _, err := m.SlashFractionBadEthSignature.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	return LastObservedEthereumBlockHeight{}
+}
+
+func (m *GenesisState) GetLastObservedValset() *Valset {
+	if m != nil {
+		return m.LastObservedValset
 	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x8a
-	if m.UnbondSlashingValsetsWindow != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.UnbondSlashingValsetsWindow))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x80
+	return nil
+}
+
+func (m *GenesisState) GetLastEventNoncesByValidator() []*ValidatorEventNonce {
+	if m != nil {
+		return m.LastEventNoncesByValidator
 	}
-	{
-		size := m.SlashFractionLogicCall.Size()
-		i -= size
-		if _, err := m.SlashFractionLogicCall.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	return nil
+}
+
+func (m *GenesisState) GetTransferHistory() []*TransferHistory {
+	if m != nil {
+		return m.TransferHistory
 	}
-	i--
-	dAtA[i] = 0x7a
-	{
-		size := m.SlashFractionBatch.Size()
-		i -= size
-		if _, err := m.SlashFractionBatch.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	return nil
+}
+
+func (m *GenesisState) GetDepositReceipts() []*DepositReceipt {
+	if m != nil {
+		return m.DepositReceipts
 	}
-	i--
-	dAtA[i] = 0x72
-	{
-		size := m.SlashFractionValset.Size()
-		i -= size
-		if _, err := m.SlashFractionValset.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	return nil
+}
+
+func (m *GenesisState) GetFailedDeposits() []*DepositReceipt {
+	if m != nil {
+		return m.FailedDeposits
 	}
-	i--
-	dAtA[i] = 0x6a
-	if m.AverageEthereumBlockTime != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.AverageEthereumBlockTime))
-		i--
-		dAtA[i] = 0x60
+	return nil
+}
+
+func (m *GenesisState) GetEthereumHeightVotes() []*EthereumHeightVote {
+	if m != nil {
+		return m.EthereumHeightVotes
 	}
-	if m.AverageBlockTime != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.AverageBlockTime))
-		i--
-		dAtA[i] = 0x58
+	return nil
+}
+
+func (m *GenesisState) GetQueuedTransferHeights() []*QueuedTransferHeight {
+	if m != nil {
+		return m.QueuedTransferHeights
 	}
-	if m.TargetBatchTimeout != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TargetBatchTimeout))
-		i--
-		dAtA[i] = 0x50
+	return nil
+}
+
+func (m *GenesisState) GetEvmChainStates() []*EvmChainGenesisState {
+	if m != nil {
+		return m.EvmChainStates
 	}
-	if m.SignedLogicCallsWindow != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedLogicCallsWindow))
-		i--
-		dAtA[i] = 0x48
+	return nil
+}
+
+func (m *GenesisState) GetBlocklist() []string {
+	if m != nil {
+		return m.Blocklist
 	}
-	if m.SignedBatchesWindow != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedBatchesWindow))
-		i--
-		dAtA[i] = 0x40
+	return nil
+}
+
+func (m *GenesisState) GetQuarantinedDeposits() []*DepositReceipt {
+	if m != nil {
+		return m.QuarantinedDeposits
 	}
-	if m.SignedValsetsWindow != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedValsetsWindow))
-		i--
-		dAtA[i] = 0x38
+	return nil
+}
+
+func (m *GenesisState) GetLastConflictCheckedNonce() uint64 {
+	if m != nil {
+		return m.LastConflictCheckedNonce
 	}
-	if m.BridgeChainId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BridgeChainId))
-		i--
-		dAtA[i] = 0x30
+	return 0
+}
+
+func (m *GenesisState) GetCosmosOriginatedLocked() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CosmosOriginatedLocked
 	}
-	if len(m.BridgeEthereumAddress) > 0 {
-		i -= len(m.BridgeEthereumAddress)
-		copy(dAtA[i:], m.BridgeEthereumAddress)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BridgeEthereumAddress)))
-		i--
-		dAtA[i] = 0x2a
+	return nil
+}
+
+// EvmChainGenesisState holds the state of one of the chains in Params.evm_chains,
+// only the fields of state that are kept per chain are used
+type EvmChainGenesisState struct {
+	ChainId uint64        `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	State   *GenesisState `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+}
+
+func (m *EvmChainGenesisState) Reset()         { *m = EvmChainGenesisState{} }
+func (m *EvmChainGenesisState) String() string { return proto.CompactTextString(m) }
+func (*EvmChainGenesisState) ProtoMessage()    {}
+func (*EvmChainGenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{3}
+}
+func (m *EvmChainGenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EvmChainGenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvmChainGenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.ContractSourceHash) > 0 {
-		i -= len(m.ContractSourceHash)
-		copy(dAtA[i:], m.ContractSourceHash)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractSourceHash)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *EvmChainGenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmChainGenesisState.Merge(m, src)
+}
+func (m *EvmChainGenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvmChainGenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmChainGenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmChainGenesisState proto.InternalMessageInfo
+
+func (m *EvmChainGenesisState) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
 	}
-	{
-		size := m.MinimumFeeTransferToEth.Size()
-		i -= size
-		if _, err := m.MinimumFeeTransferToEth.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *EvmChainGenesisState) GetState() *GenesisState {
+	if m != nil {
+		return m.State
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.MinimumTransferToEth.Size()
-		i -= size
-		if _, err := m.MinimumTransferToEth.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return nil
+}
+
+// ValidatorEventNonce records the last event nonce a validator submitted a claim for,
+// it is kept in genesis since the attestations it was derived from may have been pruned
+type ValidatorEventNonce struct {
+	Validator  string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	EventNonce uint64 `protobuf:"varint,2,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+}
+
+func (m *ValidatorEventNonce) Reset()         { *m = ValidatorEventNonce{} }
+func (m *ValidatorEventNonce) String() string { return proto.CompactTextString(m) }
+func (*ValidatorEventNonce) ProtoMessage()    {}
+func (*ValidatorEventNonce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{4}
+}
+func (m *ValidatorEventNonce) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorEventNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorEventNonce.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.GravityId) > 0 {
-		i -= len(m.GravityId)
-		copy(dAtA[i:], m.GravityId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.GravityId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *ValidatorEventNonce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorEventNonce.Merge(m, src)
+}
+func (m *ValidatorEventNonce) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorEventNonce) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorEventNonce.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorEventNonce proto.InternalMessageInfo
+
+func (m *ValidatorEventNonce) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+func (m *ValidatorEventNonce) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "gravity.v1.Params")
+	proto.RegisterType((*EvmChain)(nil), "gravity.v1.EvmChain")
+	proto.RegisterType((*GenesisState)(nil), "gravity.v1.GenesisState")
+	proto.RegisterType((*EvmChainGenesisState)(nil), "gravity.v1.EvmChainGenesisState")
+	proto.RegisterType((*ValidatorEventNonce)(nil), "gravity.v1.ValidatorEventNonce")
+}
+
+func init() { proto.RegisterFile("gravity/v1/genesis.proto", fileDescriptor_387b0aba880adb60) }
+
+var fileDescriptor_387b0aba880adb60 = []byte{
+	// 2159 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x5f, 0x6f, 0x1b, 0xc7,
+	0x11, 0x37, 0x23, 0xc6, 0x96, 0x56, 0x7f, 0xbd, 0x24, 0xa5, 0xa5, 0xfe, 0x50, 0xb4, 0x52, 0x1b,
+	0x42, 0x6b, 0x93, 0xb2, 0xd2, 0xb4, 0x70, 0xda, 0x04, 0x31, 0x29, 0x29, 0x52, 0x63, 0x55, 0xee,
+	0x49, 0x51, 0x8b, 0xa2, 0xc0, 0x75, 0x79, 0xb7, 0x22, 0x37, 0x3a, 0xde, 0xca, 0xb7, 0x4b, 0x5a,
+	0x7c, 0x4a, 0x5f, 0xfa, 0xd2, 0xa7, 0x7e, 0x8e, 0x7e, 0x82, 0x7e, 0x84, 0x3c, 0xe6, 0xb1, 0x28,
+	0x8a, 0xb4, 0xb0, 0xbf, 0x48, 0xb1, 0xb3, 0x7b, 0xc7, 0x3b, 0x92, 0x90, 0x5b, 0xc1, 0x4f, 0xe2,
+	0xed, 0xcc, 0x6f, 0x66, 0x6e, 0x66, 0x76, 0xe6, 0x77, 0x42, 0xa4, 0x1d, 0xd1, 0x3e, 0x57, 0x83,
+	0x7a, 0xff, 0x69, 0xbd, 0xcd, 0x42, 0x26, 0xb9, 0xac, 0x5d, 0x45, 0x42, 0x09, 0x8c, 0xac, 0xa4,
+	0xd6, 0x7f, 0xba, 0x5a, 0x6c, 0x8b, 0xb6, 0x80, 0xe3, 0xba, 0xfe, 0x65, 0x34, 0x56, 0x97, 0x53,
+	0x58, 0x35, 0xb8, 0x62, 0x16, 0xb9, 0x5a, 0x4a, 0x9d, 0x77, 0x65, 0x5b, 0x4e, 0x50, 0x6f, 0x51,
+	0xe5, 0x75, 0xec, 0xf9, 0x7a, 0xea, 0x9c, 0x2a, 0xc5, 0xa4, 0xa2, 0x8a, 0x8b, 0x70, 0x82, 0xb1,
+	0x2b, 0x21, 0x02, 0x7b, 0x5c, 0xf1, 0x84, 0xec, 0x0a, 0x59, 0x6f, 0x51, 0xc9, 0xea, 0xfd, 0xa7,
+	0x2d, 0xa6, 0xe8, 0xd3, 0xba, 0x27, 0xb8, 0x85, 0x6d, 0xfd, 0xbd, 0x80, 0xee, 0xbe, 0xa4, 0x11,
+	0xed, 0x4a, 0xbc, 0x81, 0xe2, 0x57, 0x71, 0xb9, 0x4f, 0x72, 0xd5, 0xdc, 0xf6, 0x8c, 0x33, 0x63,
+	0x4f, 0x8e, 0x7c, 0xcc, 0xd0, 0x4a, 0x97, 0x87, 0xbc, 0xdb, 0xeb, 0xba, 0x2a, 0xa2, 0xa1, 0xbc,
+	0x60, 0x91, 0xab, 0x84, 0xcb, 0x54, 0x87, 0x7c, 0xa0, 0x75, 0x1b, 0xb5, 0xef, 0x7e, 0xd8, 0xbc,
+	0xf3, 0xcf, 0x1f, 0x36, 0x1f, 0xb5, 0xb9, 0xea, 0xf4, 0x5a, 0x35, 0x4f, 0x74, 0xeb, 0xd6, 0xbb,
+	0xf9, 0xf3, 0x44, 0xfa, 0x97, 0x36, 0x01, 0x47, 0xa1, 0x72, 0x8a, 0xd6, 0xdc, 0x99, 0xb5, 0x76,
+	0x26, 0xf6, 0x55, 0x07, 0x07, 0x68, 0x2d, 0x76, 0x73, 0xc1, 0xd8, 0x98, 0xab, 0xa9, 0x5b, 0xb9,
+	0x8a, 0x23, 0x3f, 0x60, 0x2c, 0xeb, 0x6d, 0x07, 0x15, 0x3d, 0x11, 0xaa, 0x88, 0x7a, 0xca, 0x95,
+	0xa2, 0x17, 0x79, 0xcc, 0xed, 0x50, 0xd9, 0x21, 0x79, 0x78, 0x7b, 0x1c, 0xcb, 0x4e, 0x41, 0x74,
+	0x48, 0x65, 0x07, 0xff, 0x0c, 0xad, 0xb4, 0x22, 0xee, 0xb7, 0x99, 0x0e, 0x87, 0x45, 0xac, 0xd7,
+	0x75, 0xa9, 0xef, 0x47, 0x4c, 0x4a, 0xf2, 0x21, 0x80, 0x4a, 0x46, 0xbc, 0x6f, 0xa5, 0xcf, 0x8d,
+	0x10, 0x3f, 0x42, 0x8b, 0x16, 0xe7, 0x75, 0x28, 0x0f, 0x75, 0x8a, 0xef, 0x56, 0x73, 0xdb, 0x79,
+	0x67, 0xde, 0x1c, 0x37, 0xf5, 0xe9, 0x91, 0x8f, 0x77, 0x51, 0x49, 0xf2, 0x76, 0xc8, 0x7c, 0xb7,
+	0x4f, 0x03, 0xc9, 0x94, 0x74, 0x5f, 0xf3, 0xd0, 0x17, 0xaf, 0xc9, 0x3d, 0xd0, 0x2e, 0x18, 0xe1,
+	0xb9, 0x91, 0xfd, 0x16, 0x44, 0x29, 0x0c, 0xf4, 0x0b, 0x4b, 0x30, 0xd3, 0x69, 0x4c, 0xc3, 0xc8,
+	0x2c, 0xe6, 0x19, 0x2a, 0x5b, 0x4c, 0x20, 0xda, 0xdc, 0x73, 0x3d, 0x1a, 0x04, 0x09, 0x6e, 0x06,
+	0x70, 0xcb, 0x46, 0xe1, 0x85, 0x96, 0x37, 0xb5, 0xd8, 0x42, 0x77, 0x50, 0x51, 0xd1, 0xa8, 0xcd,
+	0x94, 0x71, 0xe7, 0x2a, 0xde, 0x65, 0xa2, 0xa7, 0x08, 0x02, 0x14, 0x36, 0x32, 0xf0, 0x76, 0x66,
+	0x24, 0xf8, 0x31, 0xc2, 0xb4, 0xcf, 0x22, 0xda, 0x66, 0x6e, 0x2b, 0x10, 0xde, 0x25, 0x40, 0xc8,
+	0x2c, 0xe8, 0x2f, 0x59, 0x49, 0x43, 0x0b, 0x34, 0x00, 0x7f, 0x86, 0xd6, 0x62, 0xed, 0x24, 0xc7,
+	0x29, 0xd8, 0x1c, 0xc0, 0x88, 0x55, 0x89, 0xf3, 0x3c, 0x84, 0xb7, 0x50, 0x49, 0x06, 0x54, 0x76,
+	0xdc, 0x0b, 0x5d, 0x3a, 0x2e, 0x42, 0x9b, 0x49, 0x32, 0x5f, 0xcd, 0x6d, 0xcf, 0xfd, 0x5f, 0xbd,
+	0xb3, 0xc7, 0x3c, 0xa7, 0x00, 0xc6, 0x0e, 0xac, 0x2d, 0x93, 0x78, 0xfc, 0x47, 0x54, 0x1c, 0xf1,
+	0x01, 0xa9, 0x20, 0x0b, 0xb7, 0x72, 0x81, 0x33, 0x2e, 0x20, 0x73, 0x98, 0xa3, 0xf2, 0x88, 0x87,
+	0x61, 0x9d, 0xc8, 0xe2, 0xad, 0xdc, 0x2c, 0x67, 0xdc, 0x24, 0x65, 0xc5, 0x4d, 0x54, 0xe9, 0x85,
+	0x2d, 0x11, 0xfa, 0x2e, 0x28, 0xf0, 0xb0, 0x3d, 0xda, 0x7b, 0x4b, 0x90, 0xf2, 0x35, 0xa3, 0x75,
+	0x6a, 0x95, 0xb2, 0x3d, 0xd8, 0x47, 0xd5, 0xb1, 0x8c, 0xf8, 0xba, 0x7e, 0xae, 0xee, 0x22, 0xaa,
+	0x7a, 0x11, 0x23, 0xf7, 0x6f, 0x15, 0xf6, 0xfa, 0x48, 0x76, 0xfc, 0x7d, 0xd5, 0x39, 0x8d, 0x6d,
+	0xe2, 0x3d, 0x34, 0x6f, 0x82, 0x75, 0x23, 0xf6, 0x9a, 0x46, 0x3e, 0xc1, 0xd5, 0xdc, 0xf6, 0xec,
+	0x6e, 0xb9, 0x66, 0x6c, 0xd5, 0xf4, 0xe0, 0xab, 0xd9, 0xc1, 0x57, 0x6b, 0x0a, 0x1e, 0x36, 0xf2,
+	0xda, 0xbf, 0x33, 0x67, 0x50, 0x0e, 0x80, 0xf0, 0xeb, 0xb1, 0xe8, 0x3d, 0x11, 0x5e, 0x04, 0xdc,
+	0x53, 0x3a, 0x1b, 0x5e, 0x40, 0x79, 0x97, 0x14, 0x6e, 0x15, 0xfd, 0x46, 0x26, 0xfa, 0xe6, 0xd0,
+	0x6a, 0x53, 0x1b, 0xd5, 0x77, 0xc9, 0x5e, 0x43, 0x70, 0x92, 0x64, 0xbc, 0x68, 0xee, 0x92, 0x91,
+	0x81, 0x6a, 0x9c, 0xe8, 0xf1, 0xd6, 0x33, 0xe1, 0x95, 0xde, 0x43, 0xeb, 0x99, 0x98, 0x1e, 0x23,
+	0xfc, 0x0d, 0xe5, 0x81, 0xdb, 0xe5, 0x52, 0x26, 0x81, 0x91, 0xe5, 0x6a, 0x6e, 0x7b, 0xda, 0x59,
+	0xd2, 0x92, 0x63, 0x10, 0x98, 0xa8, 0xf0, 0x35, 0x7a, 0x30, 0x56, 0x69, 0x5b, 0x8b, 0x24, 0x44,
+	0xb2, 0x72, 0xbb, 0xdc, 0xb5, 0xb2, 0xc5, 0x36, 0xc5, 0x8a, 0x83, 0xc5, 0xbf, 0x44, 0xab, 0xc9,
+	0x7a, 0xe8, 0x70, 0xa9, 0x44, 0x34, 0x70, 0x23, 0xa6, 0x58, 0x08, 0x2e, 0x89, 0x19, 0x13, 0xb1,
+	0xc6, 0xa1, 0x51, 0x70, 0x62, 0x39, 0xfe, 0x14, 0x95, 0x7d, 0x76, 0x25, 0x24, 0xd7, 0x9d, 0xe3,
+	0x31, 0x7e, 0xa5, 0x52, 0xe0, 0x32, 0x80, 0x57, 0xac, 0x82, 0x63, 0xe4, 0x43, 0xec, 0x4f, 0xd1,
+	0x72, 0xc4, 0x2e, 0x7a, 0xa1, 0xef, 0x5e, 0x50, 0x1e, 0x30, 0xdf, 0xb5, 0x8a, 0x92, 0xac, 0x42,
+	0x96, 0x8a, 0x46, 0x7a, 0x00, 0xc2, 0x3d, 0x2b, 0xc3, 0xe7, 0x68, 0xd9, 0x0c, 0x4c, 0xc9, 0x02,
+	0x66, 0x4a, 0x77, 0x25, 0x02, 0xee, 0x0d, 0xc8, 0x5a, 0x35, 0xb7, 0xbd, 0xb0, 0x5b, 0xad, 0x0d,
+	0xa9, 0x44, 0x0d, 0xa6, 0xc0, 0x69, 0xac, 0xf8, 0x12, 0xf4, 0x9c, 0x62, 0x6b, 0xc2, 0x29, 0x1e,
+	0x20, 0x6c, 0xec, 0xa6, 0x16, 0xa7, 0x24, 0xeb, 0xd5, 0xa9, 0x9b, 0xef, 0xc1, 0x8e, 0xae, 0xc6,
+	0xdf, 0xfe, 0xbd, 0xb9, 0xfd, 0x3f, 0x54, 0x43, 0x03, 0xa4, 0xb3, 0x04, 0x6e, 0x8e, 0x93, 0x5d,
+	0x2a, 0xf1, 0x11, 0x7a, 0x60, 0x5c, 0xb7, 0x7b, 0x34, 0xa2, 0xa1, 0x62, 0xcc, 0x77, 0x79, 0xe8,
+	0x05, 0x3d, 0x09, 0x13, 0x40, 0xcf, 0x64, 0x49, 0x36, 0x20, 0x99, 0x15, 0x50, 0xfc, 0x32, 0xd1,
+	0x3b, 0x8a, 0xd5, 0x60, 0x72, 0x4b, 0xfc, 0x0c, 0x21, 0xd6, 0xef, 0x9a, 0xed, 0x28, 0x49, 0x05,
+	0xa2, 0x2f, 0xa6, 0x33, 0xb2, 0xdf, 0xef, 0xc2, 0x92, 0xb4, 0x17, 0x78, 0x86, 0xd9, 0x67, 0x0d,
+	0x2d, 0x03, 0x9b, 0xf1, 0x44, 0x00, 0xa4, 0xa1, 0x45, 0x25, 0x97, 0xee, 0x95, 0xe0, 0xa1, 0x92,
+	0x64, 0xd3, 0xec, 0xb2, 0x58, 0xe1, 0x80, 0xb1, 0x86, 0x16, 0xbf, 0x04, 0x29, 0xfe, 0x16, 0x95,
+	0x32, 0x50, 0x9b, 0x42, 0x49, 0xaa, 0xef, 0x3f, 0x7d, 0x85, 0x54, 0x0c, 0x36, 0x89, 0x52, 0xef,
+	0xee, 0x4c, 0x00, 0x2a, 0x62, 0x54, 0xf6, 0xa2, 0x01, 0x79, 0x00, 0x6c, 0x22, 0x8d, 0x39, 0xb3,
+	0xa2, 0x4f, 0xf3, 0x7f, 0xfa, 0x57, 0xf5, 0xce, 0xd6, 0x9b, 0x1c, 0x9a, 0x8e, 0x73, 0x82, 0xcb,
+	0x68, 0x3a, 0xe1, 0x15, 0x39, 0x78, 0xe3, 0x7b, 0x9e, 0x65, 0x14, 0x37, 0x30, 0x96, 0x0f, 0x6e,
+	0x62, 0x2c, 0x59, 0x3e, 0x38, 0x35, 0xca, 0x07, 0xdf, 0xb1, 0xa5, 0xf3, 0xef, 0xd8, 0xd2, 0x0f,
+	0xd1, 0x02, 0x0c, 0x96, 0x64, 0xe7, 0x00, 0x7d, 0x9a, 0x76, 0xe6, 0xe1, 0x34, 0xde, 0x31, 0x5b,
+	0x7f, 0x29, 0xa1, 0xb9, 0x2f, 0x0d, 0xdf, 0x3e, 0x55, 0x54, 0x31, 0xfc, 0x63, 0x74, 0xf7, 0x0a,
+	0xf8, 0x2a, 0xbc, 0xe6, 0xec, 0x2e, 0x4e, 0xb7, 0x88, 0x61, 0xb2, 0x8e, 0xd5, 0xc0, 0x35, 0x54,
+	0x08, 0xa8, 0x54, 0xae, 0x68, 0x49, 0x16, 0xf5, 0x99, 0xef, 0x86, 0x22, 0xf4, 0x18, 0xbc, 0x75,
+	0xde, 0xb9, 0xaf, 0x45, 0x27, 0x56, 0xf2, 0x6b, 0x2d, 0xc0, 0x8f, 0xd1, 0x3d, 0xbb, 0xf8, 0xc8,
+	0x54, 0x75, 0x6a, 0xd4, 0xb8, 0xd9, 0x77, 0x4e, 0xac, 0x82, 0xf7, 0xd1, 0xa2, 0xf9, 0x09, 0xbb,
+	0x82, 0x47, 0x5d, 0x49, 0xf2, 0x80, 0x5a, 0x4f, 0xa3, 0x8e, 0xa5, 0x5d, 0x94, 0x4d, 0xa3, 0xe4,
+	0x2c, 0xf4, 0xd3, 0x8f, 0x12, 0x7f, 0x82, 0xee, 0x59, 0xd6, 0x46, 0x3e, 0x04, 0xf8, 0x5a, 0x1a,
+	0x7e, 0xd2, 0x53, 0x6d, 0xc1, 0xc3, 0xf6, 0xd9, 0x35, 0x0c, 0x04, 0x27, 0xd6, 0xc5, 0x87, 0x68,
+	0x01, 0x7e, 0x0e, 0x9d, 0xdf, 0x1d, 0x47, 0x1f, 0xcb, 0xb6, 0xf5, 0x03, 0x68, 0x7b, 0x73, 0xe6,
+	0x01, 0x98, 0x04, 0xf0, 0x39, 0x9a, 0x4d, 0x51, 0x40, 0x72, 0x0f, 0xcc, 0x6c, 0x4c, 0x0a, 0x22,
+	0xa1, 0x0c, 0x0e, 0x0a, 0xe2, 0x9f, 0x12, 0x7f, 0x8d, 0x0a, 0x43, 0xfc, 0x30, 0x9c, 0x69, 0xb0,
+	0xb3, 0x39, 0x39, 0x9c, 0xc4, 0x92, 0x0d, 0xe9, 0x7e, 0x62, 0x2f, 0x09, 0xeb, 0x39, 0x9a, 0x4b,
+	0x7d, 0xe5, 0x48, 0x32, 0x03, 0xf6, 0x56, 0xd2, 0xf6, 0x9e, 0x0f, 0xe5, 0xf1, 0x56, 0x4f, 0x43,
+	0xf0, 0xaf, 0xd0, 0xbc, 0xcf, 0x02, 0xd6, 0xa6, 0x8a, 0xb9, 0x97, 0x6c, 0x20, 0x09, 0x02, 0x1b,
+	0x0f, 0x47, 0x62, 0x3a, 0x65, 0xea, 0x24, 0xd2, 0x49, 0x55, 0x11, 0x55, 0x22, 0xb2, 0xfd, 0xef,
+	0xcc, 0xc5, 0xd8, 0xaf, 0xd8, 0x40, 0xe2, 0x2f, 0xd0, 0x22, 0x8b, 0xbc, 0xdd, 0x1d, 0xfd, 0x21,
+	0xe2, 0xb3, 0x50, 0x74, 0x25, 0x99, 0x05, 0x6b, 0x24, 0x33, 0xa3, 0x9c, 0xe6, 0xee, 0xce, 0x99,
+	0xd8, 0xd3, 0x0a, 0xce, 0x3c, 0x00, 0xec, 0x93, 0xc4, 0x27, 0xa8, 0xd0, 0x0b, 0x4d, 0xf9, 0xfc,
+	0xe4, 0xbb, 0x46, 0x92, 0x39, 0xb0, 0x52, 0x99, 0x58, 0xf4, 0xf8, 0x5b, 0xe5, 0xda, 0xc1, 0x09,
+	0x34, 0x3e, 0x94, 0xf8, 0x21, 0x5a, 0x84, 0xf6, 0x56, 0xd7, 0xae, 0xfe, 0xe2, 0xd3, 0xb7, 0x74,
+	0x1e, 0x5a, 0x7b, 0x4e, 0x1f, 0x9f, 0x5d, 0xbf, 0x14, 0x22, 0x38, 0xf2, 0xf1, 0xc7, 0x68, 0x19,
+	0xd4, 0x84, 0xb5, 0x6a, 0x59, 0x3b, 0xf7, 0x81, 0xad, 0xe6, 0x1d, 0xb8, 0x23, 0xb1, 0x4b, 0xe8,
+	0x93, 0x23, 0x1f, 0x7f, 0x81, 0x36, 0x00, 0x04, 0xb7, 0x33, 0xf3, 0x91, 0x60, 0x2e, 0x39, 0x50,
+	0xd0, 0xbc, 0x53, 0xd6, 0x4a, 0xa7, 0x46, 0x67, 0x58, 0x53, 0xad, 0x80, 0x7f, 0x81, 0x56, 0x33,
+	0x16, 0xe2, 0x37, 0x37, 0x70, 0xc3, 0x28, 0x57, 0x52, 0xf0, 0x86, 0x91, 0x1b, 0xf0, 0x33, 0x54,
+	0xce, 0x80, 0xed, 0x45, 0x33, 0xf7, 0xf7, 0xbe, 0x99, 0xe8, 0x29, 0xac, 0xb9, 0x61, 0xe6, 0x12,
+	0x7f, 0x8e, 0xd6, 0x01, 0xda, 0x0b, 0x5d, 0xcd, 0x56, 0xe1, 0x85, 0x61, 0x2c, 0x75, 0x18, 0x6f,
+	0x77, 0x14, 0xf0, 0xc3, 0xbc, 0x43, 0xb4, 0xce, 0xd7, 0x61, 0xc3, 0x68, 0x80, 0xd3, 0x43, 0x90,
+	0xe3, 0x9f, 0x23, 0x90, 0xb9, 0x01, 0xd5, 0x9d, 0x94, 0xf5, 0x5c, 0x00, 0x6c, 0x49, 0xcb, 0x5f,
+	0x80, 0x38, 0xed, 0xf8, 0x13, 0xb4, 0x02, 0x9d, 0xe7, 0x69, 0x8c, 0x6b, 0x76, 0x00, 0x4c, 0x5a,
+	0x49, 0x8a, 0xd5, 0xa9, 0xed, 0x19, 0xa7, 0x68, 0xc4, 0xe7, 0x34, 0x68, 0x82, 0x50, 0x37, 0x9a,
+	0xc4, 0xbf, 0x4b, 0xc6, 0x73, 0x87, 0x7f, 0x43, 0xbd, 0x4b, 0xbd, 0x3f, 0xb9, 0xcf, 0xf4, 0xea,
+	0x2a, 0x41, 0x6b, 0x64, 0x69, 0x01, 0xa8, 0x1e, 0x82, 0xe6, 0x91, 0x55, 0x8c, 0x07, 0x78, 0xf6,
+	0x54, 0xe2, 0xaf, 0x10, 0x1e, 0x63, 0xb1, 0x9a, 0xc7, 0x8d, 0xcd, 0xa8, 0x51, 0x56, 0xea, 0xdc,
+	0xf7, 0x46, 0x4e, 0x64, 0x92, 0x96, 0xb8, 0x22, 0x66, 0x78, 0x9b, 0xb4, 0xac, 0x0c, 0xd3, 0x62,
+	0x0b, 0x02, 0x20, 0x93, 0x16, 0x60, 0x3d, 0x7e, 0x8a, 0x1b, 0xb2, 0xbe, 0x8e, 0xcf, 0x63, 0x84,
+	0x4c, 0x78, 0x3d, 0xea, 0x27, 0x6c, 0x6f, 0xdf, 0xea, 0x69, 0xd6, 0x33, 0x7e, 0xaa, 0xbf, 0x5a,
+	0xae, 0x74, 0x40, 0x59, 0xe2, 0xe9, 0x75, 0x98, 0x77, 0x69, 0x37, 0x7f, 0xb9, 0x3a, 0xb5, 0x3d,
+	0xe7, 0xac, 0x69, 0xad, 0x34, 0x8b, 0x6c, 0x0e, 0x55, 0xf0, 0xb7, 0xe8, 0xa3, 0xec, 0x86, 0x18,
+	0x59, 0x65, 0xb6, 0x67, 0x56, 0x61, 0xd5, 0xfc, 0x24, 0x1d, 0xe9, 0x8b, 0xd4, 0xf6, 0xc8, 0x6c,
+	0x37, 0xd3, 0x46, 0x76, 0x1e, 0x6d, 0x06, 0x37, 0xab, 0xe1, 0x3d, 0x54, 0xcc, 0x06, 0x60, 0xbf,
+	0x55, 0xd7, 0xc6, 0x97, 0x9b, 0xdd, 0x3f, 0x38, 0x6d, 0xd2, 0x9c, 0x61, 0x0f, 0x55, 0xc0, 0x0a,
+	0xeb, 0xb3, 0xd0, 0xf6, 0xaa, 0x74, 0x5b, 0x03, 0x6d, 0x8c, 0xfb, 0x7a, 0xa6, 0x91, 0xf5, 0xf1,
+	0x69, 0x7c, 0x1e, 0x0b, 0xf7, 0x35, 0x0a, 0x8a, 0xe5, 0xc0, 0x95, 0x1d, 0x3e, 0xcb, 0xc6, 0x20,
+	0xd1, 0xc2, 0x07, 0x68, 0x69, 0x94, 0x6e, 0x93, 0x8d, 0xf1, 0x9d, 0x73, 0x36, 0x42, 0xb8, 0x17,
+	0x47, 0x18, 0x38, 0xde, 0x47, 0x4b, 0x23, 0xc4, 0x3b, 0xa6, 0x7b, 0xab, 0x69, 0x3b, 0x7b, 0x59,
+	0xee, 0xbd, 0x98, 0xe5, 0xe2, 0x12, 0x37, 0xd1, 0xe2, 0x28, 0xf9, 0xde, 0x7c, 0xa7, 0x95, 0x85,
+	0x8b, 0x2c, 0x25, 0x77, 0x50, 0x29, 0xa9, 0xb8, 0xa9, 0xb5, 0xdb, 0x17, 0x8a, 0xc5, 0xf4, 0x2f,
+	0x33, 0x95, 0xe3, 0xf2, 0x99, 0xca, 0x9d, 0x0b, 0xc5, 0x9c, 0x02, 0x1b, 0x3b, 0x83, 0x0b, 0xfd,
+	0xaa, 0xc7, 0x7a, 0xa9, 0x21, 0x6f, 0x4d, 0x4b, 0xf2, 0x60, 0xbc, 0xe3, 0x7f, 0x03, 0xaa, 0x49,
+	0xd2, 0x40, 0xd1, 0x29, 0xbd, 0x9a, 0x70, 0xaa, 0xf7, 0xd9, 0x52, 0x42, 0x91, 0x5d, 0xa9, 0xa8,
+	0x0e, 0x74, 0x6b, 0xdc, 0x64, 0x4c, 0x0a, 0xd3, 0xbc, 0xc9, 0x59, 0x88, 0xe9, 0x32, 0x3c, 0x4a,
+	0xbc, 0x8e, 0x66, 0xa0, 0xc5, 0x03, 0x2e, 0x15, 0xf9, 0x08, 0xe6, 0xd3, 0xf0, 0x00, 0x1f, 0xa3,
+	0xe2, 0x2b, 0xc3, 0xd4, 0x79, 0x98, 0xce, 0xf0, 0x8f, 0xde, 0x99, 0xe1, 0x42, 0x0a, 0x97, 0xa4,
+	0xf9, 0x33, 0xb4, 0x06, 0xfd, 0x19, 0x8f, 0x15, 0x73, 0x4d, 0x13, 0x42, 0xf6, 0x70, 0x38, 0x92,
+	0xe3, 0x51, 0xd4, 0x34, 0x0a, 0x66, 0x84, 0xfc, 0x39, 0x87, 0x88, 0x9d, 0xa7, 0x22, 0xe2, 0x6d,
+	0x1e, 0x52, 0x05, 0x2b, 0x49, 0x6b, 0x90, 0x47, 0xef, 0x9f, 0xa8, 0x2f, 0x9b, 0xf3, 0x93, 0xc4,
+	0xd7, 0x0b, 0x70, 0xb5, 0x45, 0x51, 0x71, 0x52, 0x6e, 0x6f, 0x22, 0xdf, 0x35, 0xf4, 0x21, 0x14,
+	0x0a, 0x48, 0xe7, 0x08, 0x59, 0xc8, 0xd4, 0xc7, 0xa8, 0x6d, 0x9d, 0xa1, 0xc2, 0x84, 0x7b, 0xa9,
+	0xab, 0x35, 0xbc, 0xcb, 0xf6, 0x5f, 0xb3, 0xc9, 0x01, 0xde, 0x44, 0xb3, 0xa9, 0x9b, 0x6f, 0xf9,
+	0x2d, 0x62, 0x09, 0xbc, 0xf1, 0x87, 0xef, 0xde, 0x54, 0x72, 0xdf, 0xbf, 0xa9, 0xe4, 0xfe, 0xf3,
+	0xa6, 0x92, 0xfb, 0xeb, 0xdb, 0xca, 0x9d, 0xef, 0xdf, 0x56, 0xee, 0xfc, 0xe3, 0x6d, 0xe5, 0xce,
+	0xef, 0x1b, 0xa9, 0xa4, 0xd0, 0x40, 0x75, 0x18, 0x7d, 0x12, 0x32, 0x15, 0x27, 0xc6, 0x06, 0xfb,
+	0xc4, 0xec, 0x97, 0x7a, 0x57, 0xf8, 0xbd, 0x80, 0xd5, 0xaf, 0xeb, 0xf6, 0xdc, 0x24, 0xad, 0x75,
+	0x17, 0xbe, 0x51, 0x3e, 0xfe, 0xef, 0x00, 0x04, 0x21, 0xf4, 0x4b, 0x24, 0x17, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -639,74 +1029,29 @@ func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.StaticValCosmosAddrs) > 0 {
-		for iNdEx := len(m.StaticValCosmosAddrs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.StaticValCosmosAddrs[iNdEx])
-			copy(dAtA[i:], m.StaticValCosmosAddrs[iNdEx])
-			i = encodeVarintGenesis(dAtA, i, uint64(len(m.StaticValCosmosAddrs[iNdEx])))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xa2
-		}
-	}
-	if m.LastLatestValsetNonce != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.LastLatestValsetNonce))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x98
-	}
-	if m.LastUnBondingBlockHeight != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.LastUnBondingBlockHeight))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x90
-	}
-	if m.LastSlashedValsetNonce != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSlashedValsetNonce))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x88
-	}
-	if m.LastSlashedBatchedBlock != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSlashedBatchedBlock))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x80
-	}
-	if m.LastSlashedLogicCallBlock != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSlashedLogicCallBlock))
-		i--
-		dAtA[i] = 0x78
-	}
-	if m.LastOutgoingBatchId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.LastOutgoingBatchId))
+	if len(m.ProtocolFeeTreasury) > 0 {
+		i -= len(m.ProtocolFeeTreasury)
+		copy(dAtA[i:], m.ProtocolFeeTreasury)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ProtocolFeeTreasury)))
 		i--
-		dAtA[i] = 0x70
-	}
-	if m.LastTxPoolId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.LastTxPoolId))
+		dAtA[i] = 0x2
 		i--
-		dAtA[i] = 0x68
+		dAtA[i] = 0x8a
 	}
-	if len(m.UnbatchedTransfers) > 0 {
-		for iNdEx := len(m.UnbatchedTransfers) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ProtocolFeeMinimums) > 0 {
+		for iNdEx := len(m.ProtocolFeeMinimums) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.UnbatchedTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ProtocolFeeMinimums[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -714,27 +1059,22 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x62
-		}
-	}
-	if len(m.Erc20ToDenoms) > 0 {
-		for iNdEx := len(m.Erc20ToDenoms) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Erc20ToDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
+			dAtA[i] = 0x2
 			i--
-			dAtA[i] = 0x5a
+			dAtA[i] = 0x82
 		}
 	}
-	if len(m.DelegateKeys) > 0 {
-		for iNdEx := len(m.DelegateKeys) - 1; iNdEx >= 0; iNdEx-- {
+	if m.ProtocolFeeBasisPoints != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ProtocolFeeBasisPoints))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
+	}
+	if len(m.EvmChains) > 0 {
+		for iNdEx := len(m.EvmChains) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.DelegateKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.EvmChains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -742,13 +1082,22 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x52
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
 		}
 	}
-	if len(m.Attestations) > 0 {
-		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+	if m.BatchGuaranteedInclusionBlocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BatchGuaranteedInclusionBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe8
+	}
+	if len(m.BatchMinimumFees) > 0 {
+		for iNdEx := len(m.BatchMinimumFees) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.BatchMinimumFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -756,293 +1105,2341 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x4a
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
 		}
 	}
-	if len(m.LogicCallConfirms) > 0 {
-		for iNdEx := len(m.LogicCallConfirms) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LogicCallConfirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x42
+	if m.BatchSelectionPolicy != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BatchSelectionPolicy))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.RefundFailedDeposits {
+		i--
+		if m.RefundFailedDeposits {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
 	}
-	if len(m.LogicCalls) > 0 {
-		for iNdEx := len(m.LogicCalls) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LogicCalls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
+	if m.DepositReceiptRetention != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DepositReceiptRetention))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
 	}
-	if len(m.BatchConfirms) > 0 {
-		for iNdEx := len(m.BatchConfirms) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BatchConfirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
+	if m.TransferHistoryRetention != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TransferHistoryRetention))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
 	}
-	if len(m.Batches) > 0 {
-		for iNdEx := len(m.Batches) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Batches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
+	{
+		size := m.BadEthSignatureRewardFraction.Size()
+		i -= size
+		if _, err := m.BadEthSignatureRewardFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if len(m.ValsetConfirms) > 0 {
-		for iNdEx := len(m.ValsetConfirms) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ValsetConfirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xba
+	if m.JailMissedClaims {
+		i--
+		if m.JailMissedClaims {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
 	}
-	if len(m.Valsets) > 0 {
-		for iNdEx := len(m.Valsets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Valsets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+	{
+		size := m.SlashFractionClaim.Size()
+		i -= size
+		if _, err := m.SlashFractionClaim.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if m.LastObservedNonce != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.LastObservedNonce))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	if m.SignedClaimsWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedClaimsWindow))
 		i--
-		dAtA[i] = 0x10
-	}
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0xa0
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	{
+		size := m.SlashFractionConflictingClaim.Size()
+		i -= size
+		if _, err := m.SlashFractionConflictingClaim.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	{
+		size, err := m.ValsetReward.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = len(m.GravityId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	{
+		size := m.SlashFractionBadEthSignature.Size()
+		i -= size
+		if _, err := m.SlashFractionBadEthSignature.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	l = m.MinimumTransferToEth.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.MinimumFeeTransferToEth.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = len(m.ContractSourceHash)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if m.UnbondSlashingValsetsWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.UnbondSlashingValsetsWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
 	}
-	l = len(m.BridgeEthereumAddress)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	{
+		size := m.SlashFractionLogicCall.Size()
+		i -= size
+		if _, err := m.SlashFractionLogicCall.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if m.BridgeChainId != 0 {
-		n += 1 + sovGenesis(uint64(m.BridgeChainId))
+	i--
+	dAtA[i] = 0x7a
+	{
+		size := m.SlashFractionBatch.Size()
+		i -= size
+		if _, err := m.SlashFractionBatch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if m.SignedValsetsWindow != 0 {
-		n += 1 + sovGenesis(uint64(m.SignedValsetsWindow))
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.SlashFractionValset.Size()
+		i -= size
+		if _, err := m.SlashFractionValset.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if m.SignedBatchesWindow != 0 {
-		n += 1 + sovGenesis(uint64(m.SignedBatchesWindow))
+	i--
+	dAtA[i] = 0x6a
+	if m.AverageEthereumBlockTime != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AverageEthereumBlockTime))
+		i--
+		dAtA[i] = 0x60
 	}
-	if m.SignedLogicCallsWindow != 0 {
-		n += 1 + sovGenesis(uint64(m.SignedLogicCallsWindow))
+	if m.AverageBlockTime != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AverageBlockTime))
+		i--
+		dAtA[i] = 0x58
 	}
 	if m.TargetBatchTimeout != 0 {
-		n += 1 + sovGenesis(uint64(m.TargetBatchTimeout))
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TargetBatchTimeout))
+		i--
+		dAtA[i] = 0x50
 	}
-	if m.AverageBlockTime != 0 {
-		n += 1 + sovGenesis(uint64(m.AverageBlockTime))
+	if m.SignedLogicCallsWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedLogicCallsWindow))
+		i--
+		dAtA[i] = 0x48
 	}
-	if m.AverageEthereumBlockTime != 0 {
-		n += 1 + sovGenesis(uint64(m.AverageEthereumBlockTime))
+	if m.SignedBatchesWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedBatchesWindow))
+		i--
+		dAtA[i] = 0x40
 	}
-	l = m.SlashFractionValset.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.SlashFractionBatch.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.SlashFractionLogicCall.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if m.UnbondSlashingValsetsWindow != 0 {
-		n += 2 + sovGenesis(uint64(m.UnbondSlashingValsetsWindow))
+	if m.SignedValsetsWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedValsetsWindow))
+		i--
+		dAtA[i] = 0x38
 	}
-	l = m.SlashFractionBadEthSignature.Size()
-	n += 2 + l + sovGenesis(uint64(l))
-	l = m.ValsetReward.Size()
-	n += 2 + l + sovGenesis(uint64(l))
-	return n
-}
-
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.BridgeChainId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BridgeChainId))
+		i--
+		dAtA[i] = 0x30
 	}
-	var l int
-	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.BridgeEthereumAddress) > 0 {
+		i -= len(m.BridgeEthereumAddress)
+		copy(dAtA[i:], m.BridgeEthereumAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BridgeEthereumAddress)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.LastObservedNonce != 0 {
-		n += 1 + sovGenesis(uint64(m.LastObservedNonce))
+	if len(m.ContractSourceHash) > 0 {
+		i -= len(m.ContractSourceHash)
+		copy(dAtA[i:], m.ContractSourceHash)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractSourceHash)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.Valsets) > 0 {
-		for _, e := range m.Valsets {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	{
+		size := m.MinimumFeeTransferToEth.Size()
+		i -= size
+		if _, err := m.MinimumFeeTransferToEth.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if len(m.ValsetConfirms) > 0 {
-		for _, e := range m.ValsetConfirms {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MinimumTransferToEth.Size()
+		i -= size
+		if _, err := m.MinimumTransferToEth.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if len(m.Batches) > 0 {
-		for _, e := range m.Batches {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.GravityId) > 0 {
+		i -= len(m.GravityId)
+		copy(dAtA[i:], m.GravityId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.GravityId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EvmChain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvmChain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EvmChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClaimSlashing {
+		i--
+		if m.ClaimSlashing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x28
 	}
-	if len(m.BatchConfirms) > 0 {
-		for _, e := range m.BatchConfirms {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if m.AverageEthereumBlockTime != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AverageEthereumBlockTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.GravityId) > 0 {
+		i -= len(m.GravityId)
+		copy(dAtA[i:], m.GravityId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.GravityId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BridgeEthereumAddress) > 0 {
+		i -= len(m.BridgeEthereumAddress)
+		copy(dAtA[i:], m.BridgeEthereumAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BridgeEthereumAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CosmosOriginatedLocked) > 0 {
+		for iNdEx := len(m.CosmosOriginatedLocked) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CosmosOriginatedLocked[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xb2
 		}
 	}
-	if len(m.LogicCalls) > 0 {
-		for _, e := range m.LogicCalls {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if m.LastConflictCheckedNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastConflictCheckedNonce))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa8
+	}
+	if len(m.QuarantinedDeposits) > 0 {
+		for iNdEx := len(m.QuarantinedDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QuarantinedDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xa2
 		}
 	}
-	if len(m.LogicCallConfirms) > 0 {
-		for _, e := range m.LogicCallConfirms {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Blocklist) > 0 {
+		for iNdEx := len(m.Blocklist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Blocklist[iNdEx])
+			copy(dAtA[i:], m.Blocklist[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Blocklist[iNdEx])))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x9a
 		}
 	}
-	if len(m.Attestations) > 0 {
-		for _, e := range m.Attestations {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.EvmChainStates) > 0 {
+		for iNdEx := len(m.EvmChainStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EvmChainStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x92
 		}
 	}
-	if len(m.DelegateKeys) > 0 {
-		for _, e := range m.DelegateKeys {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.QueuedTransferHeights) > 0 {
+		for iNdEx := len(m.QueuedTransferHeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedTransferHeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x8a
 		}
 	}
-	if len(m.Erc20ToDenoms) > 0 {
-		for _, e := range m.Erc20ToDenoms {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.EthereumHeightVotes) > 0 {
+		for iNdEx := len(m.EthereumHeightVotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EthereumHeightVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x82
 		}
 	}
-	if len(m.UnbatchedTransfers) > 0 {
-		for _, e := range m.UnbatchedTransfers {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.FailedDeposits) > 0 {
+		for iNdEx := len(m.FailedDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xfa
 		}
 	}
-	if m.LastTxPoolId != 0 {
-		n += 1 + sovGenesis(uint64(m.LastTxPoolId))
+	if len(m.DepositReceipts) > 0 {
+		for iNdEx := len(m.DepositReceipts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DepositReceipts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
+		}
 	}
-	if m.LastOutgoingBatchId != 0 {
-		n += 1 + sovGenesis(uint64(m.LastOutgoingBatchId))
+	if len(m.TransferHistory) > 0 {
+		for iNdEx := len(m.TransferHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
+		}
 	}
-	if m.LastSlashedLogicCallBlock != 0 {
-		n += 1 + sovGenesis(uint64(m.LastSlashedLogicCallBlock))
+	if len(m.LastEventNoncesByValidator) > 0 {
+		for iNdEx := len(m.LastEventNoncesByValidator) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LastEventNoncesByValidator[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
+		}
 	}
-	if m.LastSlashedBatchedBlock != 0 {
-		n += 2 + sovGenesis(uint64(m.LastSlashedBatchedBlock))
+	if m.LastObservedValset != nil {
+		{
+			size, err := m.LastObservedValset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
 	}
-	if m.LastSlashedValsetNonce != 0 {
-		n += 2 + sovGenesis(uint64(m.LastSlashedValsetNonce))
+	{
+		size, err := m.LastObservedEthereumBlockHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xd2
+	if len(m.PastEthSignatureCheckpoints) > 0 {
+		for iNdEx := len(m.PastEthSignatureCheckpoints) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PastEthSignatureCheckpoints[iNdEx])
+			copy(dAtA[i:], m.PastEthSignatureCheckpoints[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.PastEthSignatureCheckpoints[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.BadSignatureEvidence) > 0 {
+		for iNdEx := len(m.BadSignatureEvidence) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BadSignatureEvidence[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if m.LastSlashedClaimNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSlashedClaimNonce))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if len(m.ConflictingClaims) > 0 {
+		for iNdEx := len(m.ConflictingClaims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConflictingClaims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.BridgeHijackIncidents) > 0 {
+		for iNdEx := len(m.BridgeHijackIncidents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BridgeHijackIncidents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.StaticValCosmosAddrs) > 0 {
+		for iNdEx := len(m.StaticValCosmosAddrs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StaticValCosmosAddrs[iNdEx])
+			copy(dAtA[i:], m.StaticValCosmosAddrs[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.StaticValCosmosAddrs[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if m.LastLatestValsetNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastLatestValsetNonce))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
 	}
 	if m.LastUnBondingBlockHeight != 0 {
-		n += 2 + sovGenesis(uint64(m.LastUnBondingBlockHeight))
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastUnBondingBlockHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.LastSlashedValsetNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSlashedValsetNonce))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.LastSlashedBatchedBlock != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSlashedBatchedBlock))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.LastSlashedLogicCallBlock != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSlashedLogicCallBlock))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.LastOutgoingBatchId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastOutgoingBatchId))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.LastTxPoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastTxPoolId))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.UnbatchedTransfers) > 0 {
+		for iNdEx := len(m.UnbatchedTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbatchedTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Erc20ToDenoms) > 0 {
+		for iNdEx := len(m.Erc20ToDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Erc20ToDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.DelegateKeys) > 0 {
+		for iNdEx := len(m.DelegateKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegateKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.LogicCallConfirms) > 0 {
+		for iNdEx := len(m.LogicCallConfirms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LogicCallConfirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.LogicCalls) > 0 {
+		for iNdEx := len(m.LogicCalls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LogicCalls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.BatchConfirms) > 0 {
+		for iNdEx := len(m.BatchConfirms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BatchConfirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Batches) > 0 {
+		for iNdEx := len(m.Batches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Batches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ValsetConfirms) > 0 {
+		for iNdEx := len(m.ValsetConfirms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValsetConfirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Valsets) > 0 {
+		for iNdEx := len(m.Valsets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Valsets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.LastObservedNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastObservedNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EvmChainGenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvmChainGenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EvmChainGenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.State != nil {
+		{
+			size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorEventNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorEventNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorEventNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GravityId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.MinimumTransferToEth.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MinimumFeeTransferToEth.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.ContractSourceHash)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.BridgeEthereumAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.BridgeChainId != 0 {
+		n += 1 + sovGenesis(uint64(m.BridgeChainId))
+	}
+	if m.SignedValsetsWindow != 0 {
+		n += 1 + sovGenesis(uint64(m.SignedValsetsWindow))
+	}
+	if m.SignedBatchesWindow != 0 {
+		n += 1 + sovGenesis(uint64(m.SignedBatchesWindow))
+	}
+	if m.SignedLogicCallsWindow != 0 {
+		n += 1 + sovGenesis(uint64(m.SignedLogicCallsWindow))
+	}
+	if m.TargetBatchTimeout != 0 {
+		n += 1 + sovGenesis(uint64(m.TargetBatchTimeout))
+	}
+	if m.AverageBlockTime != 0 {
+		n += 1 + sovGenesis(uint64(m.AverageBlockTime))
+	}
+	if m.AverageEthereumBlockTime != 0 {
+		n += 1 + sovGenesis(uint64(m.AverageEthereumBlockTime))
+	}
+	l = m.SlashFractionValset.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.SlashFractionBatch.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.SlashFractionLogicCall.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.UnbondSlashingValsetsWindow != 0 {
+		n += 2 + sovGenesis(uint64(m.UnbondSlashingValsetsWindow))
+	}
+	l = m.SlashFractionBadEthSignature.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	l = m.ValsetReward.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	l = m.SlashFractionConflictingClaim.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.SignedClaimsWindow != 0 {
+		n += 2 + sovGenesis(uint64(m.SignedClaimsWindow))
+	}
+	l = m.SlashFractionClaim.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.JailMissedClaims {
+		n += 3
+	}
+	l = m.BadEthSignatureRewardFraction.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.TransferHistoryRetention != 0 {
+		n += 2 + sovGenesis(uint64(m.TransferHistoryRetention))
+	}
+	if m.DepositReceiptRetention != 0 {
+		n += 2 + sovGenesis(uint64(m.DepositReceiptRetention))
+	}
+	if m.RefundFailedDeposits {
+		n += 3
+	}
+	if m.BatchSelectionPolicy != 0 {
+		n += 2 + sovGenesis(uint64(m.BatchSelectionPolicy))
+	}
+	if len(m.BatchMinimumFees) > 0 {
+		for _, e := range m.BatchMinimumFees {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.BatchGuaranteedInclusionBlocks != 0 {
+		n += 2 + sovGenesis(uint64(m.BatchGuaranteedInclusionBlocks))
+	}
+	if len(m.EvmChains) > 0 {
+		for _, e := range m.EvmChains {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ProtocolFeeBasisPoints != 0 {
+		n += 2 + sovGenesis(uint64(m.ProtocolFeeBasisPoints))
+	}
+	if len(m.ProtocolFeeMinimums) > 0 {
+		for _, e := range m.ProtocolFeeMinimums {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.ProtocolFeeTreasury)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *EvmChain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovGenesis(uint64(m.ChainId))
+	}
+	l = len(m.BridgeEthereumAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.GravityId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.AverageEthereumBlockTime != 0 {
+		n += 1 + sovGenesis(uint64(m.AverageEthereumBlockTime))
+	}
+	if m.ClaimSlashing {
+		n += 2
+	}
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.LastObservedNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.LastObservedNonce))
+	}
+	if len(m.Valsets) > 0 {
+		for _, e := range m.Valsets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValsetConfirms) > 0 {
+		for _, e := range m.ValsetConfirms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Batches) > 0 {
+		for _, e := range m.Batches {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BatchConfirms) > 0 {
+		for _, e := range m.BatchConfirms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LogicCalls) > 0 {
+		for _, e := range m.LogicCalls {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LogicCallConfirms) > 0 {
+		for _, e := range m.LogicCallConfirms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelegateKeys) > 0 {
+		for _, e := range m.DelegateKeys {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Erc20ToDenoms) > 0 {
+		for _, e := range m.Erc20ToDenoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UnbatchedTransfers) > 0 {
+		for _, e := range m.UnbatchedTransfers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastTxPoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastTxPoolId))
+	}
+	if m.LastOutgoingBatchId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastOutgoingBatchId))
+	}
+	if m.LastSlashedLogicCallBlock != 0 {
+		n += 1 + sovGenesis(uint64(m.LastSlashedLogicCallBlock))
+	}
+	if m.LastSlashedBatchedBlock != 0 {
+		n += 2 + sovGenesis(uint64(m.LastSlashedBatchedBlock))
+	}
+	if m.LastSlashedValsetNonce != 0 {
+		n += 2 + sovGenesis(uint64(m.LastSlashedValsetNonce))
+	}
+	if m.LastUnBondingBlockHeight != 0 {
+		n += 2 + sovGenesis(uint64(m.LastUnBondingBlockHeight))
+	}
+	if m.LastLatestValsetNonce != 0 {
+		n += 2 + sovGenesis(uint64(m.LastLatestValsetNonce))
+	}
+	if len(m.StaticValCosmosAddrs) > 0 {
+		for _, s := range m.StaticValCosmosAddrs {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BridgeHijackIncidents) > 0 {
+		for _, e := range m.BridgeHijackIncidents {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ConflictingClaims) > 0 {
+		for _, e := range m.ConflictingClaims {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastSlashedClaimNonce != 0 {
+		n += 2 + sovGenesis(uint64(m.LastSlashedClaimNonce))
+	}
+	if len(m.BadSignatureEvidence) > 0 {
+		for _, e := range m.BadSignatureEvidence {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PastEthSignatureCheckpoints) > 0 {
+		for _, b := range m.PastEthSignatureCheckpoints {
+			l = len(b)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.LastObservedEthereumBlockHeight.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.LastObservedValset != nil {
+		l = m.LastObservedValset.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.LastEventNoncesByValidator) > 0 {
+		for _, e := range m.LastEventNoncesByValidator {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TransferHistory) > 0 {
+		for _, e := range m.TransferHistory {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DepositReceipts) > 0 {
+		for _, e := range m.DepositReceipts {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FailedDeposits) > 0 {
+		for _, e := range m.FailedDeposits {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EthereumHeightVotes) > 0 {
+		for _, e := range m.EthereumHeightVotes {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.QueuedTransferHeights) > 0 {
+		for _, e := range m.QueuedTransferHeights {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EvmChainStates) > 0 {
+		for _, e := range m.EvmChainStates {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Blocklist) > 0 {
+		for _, s := range m.Blocklist {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.QuarantinedDeposits) > 0 {
+		for _, e := range m.QuarantinedDeposits {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastConflictCheckedNonce != 0 {
+		n += 2 + sovGenesis(uint64(m.LastConflictCheckedNonce))
+	}
+	if len(m.CosmosOriginatedLocked) > 0 {
+		for _, e := range m.CosmosOriginatedLocked {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EvmChainGenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovGenesis(uint64(m.ChainId))
+	}
+	if m.State != nil {
+		l = m.State.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorEventNonce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.EventNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.EventNonce))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GravityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GravityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumTransferToEth", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinimumTransferToEth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumFeeTransferToEth", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinimumFeeTransferToEth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSourceHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractSourceHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeEthereumAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeEthereumAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeChainId", wireType)
+			}
+			m.BridgeChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BridgeChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedValsetsWindow", wireType)
+			}
+			m.SignedValsetsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedValsetsWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBatchesWindow", wireType)
+			}
+			m.SignedBatchesWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBatchesWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedLogicCallsWindow", wireType)
+			}
+			m.SignedLogicCallsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedLogicCallsWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBatchTimeout", wireType)
+			}
+			m.TargetBatchTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetBatchTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageBlockTime", wireType)
+			}
+			m.AverageBlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageBlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageEthereumBlockTime", wireType)
+			}
+			m.AverageEthereumBlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageEthereumBlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionValset", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionValset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBatch", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionBatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionLogicCall", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionLogicCall.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondSlashingValsetsWindow", wireType)
+			}
+			m.UnbondSlashingValsetsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondSlashingValsetsWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBadEthSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionBadEthSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValsetReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionConflictingClaim", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionConflictingClaim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedClaimsWindow", wireType)
+			}
+			m.SignedClaimsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedClaimsWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionClaim", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionClaim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailMissedClaims", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.JailMissedClaims = bool(v != 0)
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BadEthSignatureRewardFraction", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BadEthSignatureRewardFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferHistoryRetention", wireType)
+			}
+			m.TransferHistoryRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransferHistoryRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositReceiptRetention", wireType)
+			}
+			m.DepositReceiptRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositReceiptRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundFailedDeposits", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RefundFailedDeposits = bool(v != 0)
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSelectionPolicy", wireType)
+			}
+			m.BatchSelectionPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchSelectionPolicy |= BatchSelectionPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchMinimumFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchMinimumFees = append(m.BatchMinimumFees, types.Coin{})
+			if err := m.BatchMinimumFees[len(m.BatchMinimumFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchGuaranteedInclusionBlocks", wireType)
+			}
+			m.BatchGuaranteedInclusionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchGuaranteedInclusionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChains", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChains = append(m.EvmChains, EvmChain{})
+			if err := m.EvmChains[len(m.EvmChains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeeBasisPoints", wireType)
+			}
+			m.ProtocolFeeBasisPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProtocolFeeBasisPoints |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeeMinimums", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolFeeMinimums = append(m.ProtocolFeeMinimums, types.Coin{})
+			if err := m.ProtocolFeeMinimums[len(m.ProtocolFeeMinimums)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeeTreasury", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolFeeTreasury = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	if m.LastLatestValsetNonce != 0 {
-		n += 2 + sovGenesis(uint64(m.LastLatestValsetNonce))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	if len(m.StaticValCosmosAddrs) > 0 {
-		for _, s := range m.StaticValCosmosAddrs {
-			l = len(s)
-			n += 2 + l + sovGenesis(uint64(l))
+	return nil
+}
+func (m *EvmChain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EvmChain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EvmChain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeEthereumAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeEthereumAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GravityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GravityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageEthereumBlockTime", wireType)
+			}
+			m.AverageEthereumBlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageEthereumBlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimSlashing", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClaimSlashing = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1052,30 +3449,119 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowGenesis
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedNonce", wireType)
+			}
+			m.LastObservedNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastObservedNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valsets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Valsets = append(m.Valsets, &Valset{})
+			if err := m.Valsets[len(m.Valsets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GravityId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetConfirms", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1085,29 +3571,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GravityId = string(dAtA[iNdEx:postIndex])
+			m.ValsetConfirms = append(m.ValsetConfirms, &MsgValsetConfirm{})
+			if err := m.ValsetConfirms[len(m.ValsetConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinimumTransferToEth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1117,31 +3605,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinimumTransferToEth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Batches = append(m.Batches, &OutgoingTxBatch{})
+			if err := m.Batches[len(m.Batches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinimumFeeTransferToEth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchConfirms", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1151,31 +3639,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinimumFeeTransferToEth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BatchConfirms = append(m.BatchConfirms, MsgConfirmBatch{})
+			if err := m.BatchConfirms[len(m.BatchConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSourceHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicCalls", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1185,29 +3673,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractSourceHash = string(dAtA[iNdEx:postIndex])
+			m.LogicCalls = append(m.LogicCalls, &OutgoingLogicCall{})
+			if err := m.LogicCalls[len(m.LogicCalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeEthereumAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicCallConfirms", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1217,29 +3707,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BridgeEthereumAddress = string(dAtA[iNdEx:postIndex])
+			m.LogicCallConfirms = append(m.LogicCallConfirms, MsgConfirmLogicCall{})
+			if err := m.LogicCallConfirms[len(m.LogicCallConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeChainId", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
 			}
-			m.BridgeChainId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1249,16 +3741,133 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BridgeChainId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestations = append(m.Attestations, Attestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegateKeys = append(m.DelegateKeys, &MsgSetOrchestratorAddress{})
+			if err := m.DelegateKeys[len(m.DelegateKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ToDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20ToDenoms = append(m.Erc20ToDenoms, &ERC20ToDenom{})
+			if err := m.Erc20ToDenoms[len(m.Erc20ToDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbatchedTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbatchedTransfers = append(m.UnbatchedTransfers, &OutgoingTransferTx{})
+			if err := m.UnbatchedTransfers[len(m.UnbatchedTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedValsetsWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTxPoolId", wireType)
 			}
-			m.SignedValsetsWindow = 0
+			m.LastTxPoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1268,16 +3877,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignedValsetsWindow |= uint64(b&0x7F) << shift
+				m.LastTxPoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 14:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedBatchesWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastOutgoingBatchId", wireType)
 			}
-			m.SignedBatchesWindow = 0
+			m.LastOutgoingBatchId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1287,16 +3896,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignedBatchesWindow |= uint64(b&0x7F) << shift
+				m.LastOutgoingBatchId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+		case 15:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedLogicCallsWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSlashedLogicCallBlock", wireType)
 			}
-			m.SignedLogicCallsWindow = 0
+			m.LastSlashedLogicCallBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1306,16 +3915,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignedLogicCallsWindow |= uint64(b&0x7F) << shift
+				m.LastSlashedLogicCallBlock |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+		case 16:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetBatchTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSlashedBatchedBlock", wireType)
 			}
-			m.TargetBatchTimeout = 0
+			m.LastSlashedBatchedBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1325,16 +3934,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TargetBatchTimeout |= uint64(b&0x7F) << shift
+				m.LastSlashedBatchedBlock |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+		case 17:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AverageBlockTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSlashedValsetNonce", wireType)
 			}
-			m.AverageBlockTime = 0
+			m.LastSlashedValsetNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1344,16 +3953,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AverageBlockTime |= uint64(b&0x7F) << shift
+				m.LastSlashedValsetNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+		case 18:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AverageEthereumBlockTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUnBondingBlockHeight", wireType)
 			}
-			m.AverageEthereumBlockTime = 0
+			m.LastUnBondingBlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1363,16 +3972,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AverageEthereumBlockTime |= uint64(b&0x7F) << shift
+				m.LastUnBondingBlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionValset", wireType)
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastLatestValsetNonce", wireType)
 			}
-			var byteLen int
+			m.LastLatestValsetNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1382,30 +3991,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.LastLatestValsetNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.SlashFractionValset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 14:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StaticValCosmosAddrs", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1415,30 +4010,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionBatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StaticValCosmosAddrs = append(m.StaticValCosmosAddrs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 15:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionLogicCall", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeHijackIncidents", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1448,49 +4042,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionLogicCall.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BridgeHijackIncidents = append(m.BridgeHijackIncidents, &BridgeHijackIncident{})
+			if err := m.BridgeHijackIncidents[len(m.BridgeHijackIncidents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondSlashingValsetsWindow", wireType)
-			}
-			m.UnbondSlashingValsetsWindow = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnbondSlashingValsetsWindow |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 17:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBadEthSignature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictingClaims", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1500,30 +4076,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionBadEthSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ConflictingClaims = append(m.ConflictingClaims, &ConflictingClaim{})
+			if err := m.ConflictingClaims[len(m.ConflictingClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 18:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValsetReward", wireType)
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSlashedClaimNonce", wireType)
 			}
-			var msglen int
+			m.LastSlashedClaimNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1533,81 +4110,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LastSlashedClaimNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ValsetReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BadSignatureEvidence", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1634,37 +4144,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &Params{}
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BadSignatureEvidence = append(m.BadSignatureEvidence, &BadSignatureEvidence{})
+			if err := m.BadSignatureEvidence[len(m.BadSignatureEvidence)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedNonce", wireType)
-			}
-			m.LastObservedNonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastObservedNonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Valsets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PastEthSignatureCheckpoints", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1674,29 +4163,27 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Valsets = append(m.Valsets, &Valset{})
-			if err := m.Valsets[len(m.Valsets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PastEthSignatureCheckpoints = append(m.PastEthSignatureCheckpoints, make([]byte, postIndex-iNdEx))
+			copy(m.PastEthSignatureCheckpoints[len(m.PastEthSignatureCheckpoints)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValsetConfirms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedEthereumBlockHeight", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1723,14 +4210,13 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValsetConfirms = append(m.ValsetConfirms, &MsgValsetConfirm{})
-			if err := m.ValsetConfirms[len(m.ValsetConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastObservedEthereumBlockHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedValset", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1757,14 +4243,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Batches = append(m.Batches, &OutgoingTxBatch{})
-			if err := m.Batches[len(m.Batches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LastObservedValset == nil {
+				m.LastObservedValset = &Valset{}
+			}
+			if err := m.LastObservedValset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 28:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchConfirms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEventNoncesByValidator", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1791,14 +4279,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BatchConfirms = append(m.BatchConfirms, MsgConfirmBatch{})
-			if err := m.BatchConfirms[len(m.BatchConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LastEventNoncesByValidator = append(m.LastEventNoncesByValidator, &ValidatorEventNonce{})
+			if err := m.LastEventNoncesByValidator[len(m.LastEventNoncesByValidator)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 29:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogicCalls", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferHistory", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1825,14 +4313,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LogicCalls = append(m.LogicCalls, &OutgoingLogicCall{})
-			if err := m.LogicCalls[len(m.LogicCalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TransferHistory = append(m.TransferHistory, &TransferHistory{})
+			if err := m.TransferHistory[len(m.TransferHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 30:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogicCallConfirms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositReceipts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1859,14 +4347,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LogicCallConfirms = append(m.LogicCallConfirms, MsgConfirmLogicCall{})
-			if err := m.LogicCallConfirms[len(m.LogicCallConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DepositReceipts = append(m.DepositReceipts, &DepositReceipt{})
+			if err := m.DepositReceipts[len(m.DepositReceipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 31:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedDeposits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1893,14 +4381,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attestations = append(m.Attestations, Attestation{})
-			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FailedDeposits = append(m.FailedDeposits, &DepositReceipt{})
+			if err := m.FailedDeposits[len(m.FailedDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 32:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegateKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeightVotes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1927,14 +4415,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegateKeys = append(m.DelegateKeys, &MsgSetOrchestratorAddress{})
-			if err := m.DelegateKeys[len(m.DelegateKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.EthereumHeightVotes = append(m.EthereumHeightVotes, &EthereumHeightVote{})
+			if err := m.EthereumHeightVotes[len(m.EthereumHeightVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 33:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ToDenoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedTransferHeights", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1961,14 +4449,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20ToDenoms = append(m.Erc20ToDenoms, &ERC20ToDenom{})
-			if err := m.Erc20ToDenoms[len(m.Erc20ToDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.QueuedTransferHeights = append(m.QueuedTransferHeights, &QueuedTransferHeight{})
+			if err := m.QueuedTransferHeights[len(m.QueuedTransferHeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 34:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbatchedTransfers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainStates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1995,16 +4483,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnbatchedTransfers = append(m.UnbatchedTransfers, &OutgoingTransferTx{})
-			if err := m.UnbatchedTransfers[len(m.UnbatchedTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.EvmChainStates = append(m.EvmChainStates, &EvmChainGenesisState{})
+			if err := m.EvmChainStates[len(m.EvmChainStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastTxPoolId", wireType)
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocklist", wireType)
 			}
-			m.LastTxPoolId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -2014,35 +4502,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastTxPoolId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastOutgoingBatchId", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.LastOutgoingBatchId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastOutgoingBatchId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
 			}
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastSlashedLogicCallBlock", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.LastSlashedLogicCallBlock = 0
+			m.Blocklist = append(m.Blocklist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 36:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuarantinedDeposits", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -2052,16 +4534,31 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastSlashedLogicCallBlock |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 16:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuarantinedDeposits = append(m.QuarantinedDeposits, &DepositReceipt{})
+			if err := m.QuarantinedDeposits[len(m.QuarantinedDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 37:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastSlashedBatchedBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastConflictCheckedNonce", wireType)
 			}
-			m.LastSlashedBatchedBlock = 0
+			m.LastConflictCheckedNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -2071,16 +4568,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastSlashedBatchedBlock |= uint64(b&0x7F) << shift
+				m.LastConflictCheckedNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 17:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastSlashedValsetNonce", wireType)
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosOriginatedLocked", wireType)
 			}
-			m.LastSlashedValsetNonce = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -2090,16 +4587,84 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastSlashedValsetNonce |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 18:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmosOriginatedLocked = append(m.CosmosOriginatedLocked, types.Coin{})
+			if err := m.CosmosOriginatedLocked[len(m.CosmosOriginatedLocked)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EvmChainGenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EvmChainGenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EvmChainGenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUnBondingBlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			m.LastUnBondingBlockHeight = 0
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -2109,16 +4674,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastUnBondingBlockHeight |= uint64(b&0x7F) << shift
+				m.ChainId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 19:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastLatestValsetNonce", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			m.LastLatestValsetNonce = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -2128,14 +4693,84 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastLatestValsetNonce |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 20:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.State == nil {
+				m.State = &GenesisState{}
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorEventNonce) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorEventNonce: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorEventNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StaticValCosmosAddrs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2163,8 +4798,27 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StaticValCosmosAddrs = append(m.StaticValCosmosAddrs, string(dAtA[iNdEx:postIndex]))
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			}
+			m.EventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
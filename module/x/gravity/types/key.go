@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -127,6 +128,68 @@ var (
 	PastEthSignatureCheckpointKey = []byte{0x1b}
 
 	StaticValCosmosAddrKey = []byte{0x40}
+
+	// BridgeHijackIncidentKey indexes recorded bridge hijack incidents by valset nonce
+	BridgeHijackIncidentKey = []byte{0x41}
+
+	// ConflictingClaimKey indexes the validators that voted for a claim conflicting with the observed one
+	ConflictingClaimKey = []byte{0x42}
+
+	// LastSlashedClaimNonce indexes the latest event nonce validators were slashed for not submitting a claim on
+	LastSlashedClaimNonce = []byte{0x43}
+
+	// BadSignatureEvidenceKey indexes the bad signature evidence submitted so far by checkpoint and signature
+	BadSignatureEvidenceKey = []byte{0x44}
+
+	// TransferHistoryKey indexes the history of transfers to Ethereum by tx id
+	TransferHistoryKey = []byte{0x45}
+
+	// TransferHistoryBySenderKey indexes the tx ids of the transfer histories by sender
+	TransferHistoryBySenderKey = []byte{0x46}
+
+	// TransferHistoryByCompletedHeightKey indexes the tx ids of completed transfer histories by the
+	// height they were completed at, so that they can be pruned in order
+	TransferHistoryByCompletedHeightKey = []byte{0x47}
+
+	// DepositReceiptKey indexes the receipts of deposits from Ethereum by event nonce
+	DepositReceiptKey = []byte{0x48}
+
+	// DepositReceiptByReceiverKey indexes the event nonces of the deposit receipts by Cosmos receiver
+	DepositReceiptByReceiverKey = []byte{0x49}
+
+	// DepositReceiptByEthereumSenderKey indexes the event nonces of the deposit receipts by Ethereum sender
+	DepositReceiptByEthereumSenderKey = []byte{0x4a}
+
+	// DepositReceiptByHeightKey indexes the event nonces of the deposit receipts by the height they were
+	// observed at, so that they can be pruned in order
+	DepositReceiptByHeightKey = []byte{0x4b}
+
+	// FailedDepositKey indexes the deposits from Ethereum held in escrow by event nonce
+	FailedDepositKey = []byte{0x4c}
+
+	// EthereumHeightVoteKey indexes the latest Ethereum height reported by each validator
+	EthereumHeightVoteKey = []byte{0x4d}
+
+	// QueuedTransferHeightKey indexes the height each transfer to Ethereum was queued at by token contract
+	// and tx id, that is in the order the transfers of a token were queued
+	QueuedTransferHeightKey = []byte{0x4e}
+
+	// EvmChainStoreKey prefixes the state of each of the chains in Params.EvmChains by chain id, under which
+	// the chain's state is kept with the same keys as the state of the default chain
+	EvmChainStoreKey = []byte{0x4f}
+
+	// BlocklistKey indexes the blocked Ethereum and Cosmos addresses, it is shared by all chains
+	BlocklistKey = []byte{0x50}
+
+	// QuarantinedDepositKey indexes the deposits from Ethereum held in quarantine by event nonce
+	QuarantinedDepositKey = []byte{0x51}
+
+	// LastConflictCheckedNonceKey indexes the latest observed event nonce checked for conflicting claims
+	LastConflictCheckedNonceKey = []byte{0x52}
+
+	// CosmosOriginatedLockedKey indexes the Cosmos originated coins locked for an EVM chain by denom, it is
+	// not kept for the default chain
+	CosmosOriginatedLockedKey = []byte{0x53}
 )
 
 // GetOrchestratorAddressKey returns the following key format
@@ -294,3 +357,167 @@ func GetLogicConfirmKey(invalidationId []byte, invalidationNonce uint64, validat
 func GetPastEthSignatureCheckpointKey(checkpoint []byte) []byte {
 	return append(PastEthSignatureCheckpointKey, checkpoint...)
 }
+
+// GetBridgeHijackIncidentKey returns the following key format
+// prefix    nonce
+// [0x41][0 0 0 0 0 0 0 1]
+func GetBridgeHijackIncidentKey(valsetNonce uint64) []byte {
+	return append(BridgeHijackIncidentKey, UInt64Bytes(valsetNonce)...)
+}
+
+// GetConflictingClaimKey returns the following key format
+// prefix    nonce                    validator-address
+// [0x42][0 0 0 0 0 0 0 1][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func GetConflictingClaimKey(eventNonce uint64, validator sdk.ValAddress) []byte {
+	return append(GetConflictingClaimNonceKey(eventNonce), validator.Bytes()...)
+}
+
+// GetConflictingClaimNonceKey returns the prefix of all conflicting claims at an event nonce
+// prefix    nonce
+// [0x42][0 0 0 0 0 0 0 1]
+func GetConflictingClaimNonceKey(eventNonce uint64) []byte {
+	return append(ConflictingClaimKey, UInt64Bytes(eventNonce)...)
+}
+
+// GetBadSignatureEvidenceKey returns the following key format
+// prefix    checkpoint           signature
+// [0x44][ checkpoint bytes ][ signature bytes ]
+func GetBadSignatureEvidenceKey(checkpoint []byte, signature []byte) []byte {
+	return append(GetBadSignatureEvidenceCheckpointKey(checkpoint), signature...)
+}
+
+// GetBadSignatureEvidenceCheckpointKey returns the prefix of all bad signature evidence over a checkpoint
+// prefix    checkpoint
+// [0x44][ checkpoint bytes ]
+func GetBadSignatureEvidenceCheckpointKey(checkpoint []byte) []byte {
+	return append(BadSignatureEvidenceKey, checkpoint...)
+}
+
+// GetTransferHistoryKey returns the following key format
+// prefix    id
+// [0x45][0 0 0 0 0 0 0 1]
+func GetTransferHistoryKey(txID uint64) []byte {
+	return append(TransferHistoryKey, UInt64Bytes(txID)...)
+}
+
+// GetTransferHistoryBySenderKey returns the following key format
+// prefix    address-length   sender-address                          id
+// [0x46][20][cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn][0 0 0 0 0 0 0 1]
+func GetTransferHistoryBySenderKey(sender sdk.AccAddress, txID uint64) []byte {
+	return append(GetTransferHistoryBySenderPrefix(sender), UInt64Bytes(txID)...)
+}
+
+// GetTransferHistoryBySenderPrefix returns the prefix of all transfer history ids of a sender
+// prefix    address-length   sender-address
+// [0x46][20][cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func GetTransferHistoryBySenderPrefix(sender sdk.AccAddress) []byte {
+	return append(TransferHistoryBySenderKey, address.MustLengthPrefix(sender)...)
+}
+
+// GetTransferHistoryByCompletedHeightKey returns the following key format
+// prefix    height                   id
+// [0x47][0 0 0 0 0 0 0 9][0 0 0 0 0 0 0 1]
+func GetTransferHistoryByCompletedHeightKey(height int64, txID uint64) []byte {
+	return append(append(TransferHistoryByCompletedHeightKey, UInt64Bytes(uint64(height))...), UInt64Bytes(txID)...)
+}
+
+// GetDepositReceiptKey returns the following key format
+// prefix    nonce
+// [0x48][0 0 0 0 0 0 0 1]
+func GetDepositReceiptKey(eventNonce uint64) []byte {
+	return append(DepositReceiptKey, UInt64Bytes(eventNonce)...)
+}
+
+// GetDepositReceiptByReceiverKey returns the following key format
+// prefix    receiver-length   receiver                                      nonce
+// [0x49][45][cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn][0 0 0 0 0 0 0 1]
+func GetDepositReceiptByReceiverKey(receiver string, eventNonce uint64) []byte {
+	return append(GetDepositReceiptByReceiverPrefix(receiver), UInt64Bytes(eventNonce)...)
+}
+
+// GetDepositReceiptByReceiverPrefix returns the prefix of all deposit receipt nonces of a receiver,
+// the receiver is indexed as it was claimed since failed deposits may not carry a valid address
+// prefix    receiver-length   receiver
+// [0x49][45][cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func GetDepositReceiptByReceiverPrefix(receiver string) []byte {
+	return append(DepositReceiptByReceiverKey, address.MustLengthPrefix([]byte(receiver))...)
+}
+
+// GetDepositReceiptByEthereumSenderKey returns the following key format
+// prefix    sender                                        nonce
+// [0x4a][0xc783df8a850f42e7f7e57013759c285caa701eb6][0 0 0 0 0 0 0 1]
+func GetDepositReceiptByEthereumSenderKey(sender EthAddress, eventNonce uint64) []byte {
+	return append(GetDepositReceiptByEthereumSenderPrefix(sender), UInt64Bytes(eventNonce)...)
+}
+
+// GetDepositReceiptByEthereumSenderPrefix returns the prefix of all deposit receipt nonces of an
+// Ethereum sender
+// prefix    sender
+// [0x4a][0xc783df8a850f42e7f7e57013759c285caa701eb6]
+func GetDepositReceiptByEthereumSenderPrefix(sender EthAddress) []byte {
+	return append(DepositReceiptByEthereumSenderKey, []byte(sender.GetAddress())...)
+}
+
+// GetDepositReceiptByHeightKey returns the following key format
+// prefix    height                   nonce
+// [0x4b][0 0 0 0 0 0 0 9][0 0 0 0 0 0 0 1]
+func GetDepositReceiptByHeightKey(height int64, eventNonce uint64) []byte {
+	return append(append(DepositReceiptByHeightKey, UInt64Bytes(uint64(height))...), UInt64Bytes(eventNonce)...)
+}
+
+// GetFailedDepositKey returns the following key format
+// prefix    nonce
+// [0x4c][0 0 0 0 0 0 0 1]
+func GetFailedDepositKey(eventNonce uint64) []byte {
+	return append(FailedDepositKey, UInt64Bytes(eventNonce)...)
+}
+
+// GetEthereumHeightVoteKey returns the following key format
+// prefix    validator
+// [0x4d][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func GetEthereumHeightVoteKey(validator sdk.ValAddress) []byte {
+	return append(EthereumHeightVoteKey, validator.Bytes()...)
+}
+
+// GetQueuedTransferHeightContractPrefix returns the following key format
+// prefix	tokenContract
+// [0x4e][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+// This prefix is used for iterating over the transfers of a token in the order they were queued
+func GetQueuedTransferHeightContractPrefix(tokenContract EthAddress) []byte {
+	return append(QueuedTransferHeightKey, []byte(tokenContract.GetAddress())...)
+}
+
+// GetQueuedTransferHeightKey returns the following key format
+// prefix	tokenContract                                  id
+// [0x4e][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
+func GetQueuedTransferHeightKey(tokenContract EthAddress, txID uint64) []byte {
+	return append(GetQueuedTransferHeightContractPrefix(tokenContract), UInt64Bytes(txID)...)
+}
+
+// GetEvmChainStoreKey returns the following key format
+// prefix     chain-id
+// [0x4f][0 0 0 0 0 0 0 1]
+func GetEvmChainStoreKey(chainID uint64) []byte {
+	return append(EvmChainStoreKey, UInt64Bytes(chainID)...)
+}
+
+// GetBlocklistKey returns the following key format
+// prefix    address
+// [0x50][0xc783df8a850f42e7f7e57013759c285caa701eb6]
+func GetBlocklistKey(address string) []byte {
+	return append(BlocklistKey, []byte(address)...)
+}
+
+// GetQuarantinedDepositKey returns the following key format
+// prefix    nonce
+// [0x51][0 0 0 0 0 0 0 1]
+func GetQuarantinedDepositKey(eventNonce uint64) []byte {
+	return append(QuarantinedDepositKey, UInt64Bytes(eventNonce)...)
+}
+
+// GetCosmosOriginatedLockedKey returns the following key format
+// prefix    denom
+// [0x53][stake]
+func GetCosmosOriginatedLockedKey(denom string) []byte {
+	return append(CosmosOriginatedLockedKey, []byte(denom)...)
+}
@@ -4,7 +4,9 @@ import (
 	"encoding/hex"
 	"fmt"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 	"github.com/tendermint/tendermint/crypto/tmhash"
@@ -26,6 +28,11 @@ var (
 	_ sdk.Msg = &MsgBatchSendToEthClaim{}
 	_ sdk.Msg = &MsgValsetUpdatedClaim{}
 	_ sdk.Msg = &MsgSubmitBadSignatureEvidence{}
+	_ sdk.Msg = &MsgEthereumHeightClaim{}
+	_ sdk.Msg = &MsgSubmitConfirmations{}
+	_ sdk.Msg = &MsgSubmitClaims{}
+
+	_ codectypes.UnpackInterfacesMessage = &MsgSubmitClaims{}
 )
 
 // NewMsgSetOrchestratorAddress returns a new msgSetOrchestratorAddress
@@ -357,6 +364,9 @@ type EthereumClaim interface {
 	GetClaimer() sdk.AccAddress
 	// Which type of claim this is
 	GetType() ClaimType
+	// The EVM chain the event occurred on, zero for the default chain. The claims of each chain are
+	// attested apart so the chain is not part of the claim hash
+	GetChainId() uint64
 	ValidateBasic() error
 	// The claim hash of this claim. This is used to store these claims and also used to check if two different
 	// validators claims agree. Therefore it's extremely important that this include all elements of the claim
@@ -379,8 +389,11 @@ func (msg *MsgSendToCosmosClaim) GetType() ClaimType {
 
 // ValidateBasic performs stateless checks
 func (msg *MsgSendToCosmosClaim) ValidateBasic() error {
-	if _, err := sdk.AccAddressFromBech32(msg.CosmosReceiver); err != nil {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.CosmosReceiver)
+	// The receiver is whatever the sender wrote on Ethereum, a deposit to an invalid address still has to be
+	// observed so that its tokens can be held in escrow or refunded. The receiver is only bounded by the
+	// length of the receipt index keyed by it.
+	if len(msg.CosmosReceiver) == 0 || len(msg.CosmosReceiver) > address.MaxAddrLen {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "cosmos receiver length %d", len(msg.CosmosReceiver))
 	}
 	if err := ValidateEthAddress(msg.EthereumSender); err != nil {
 		return sdkerrors.Wrap(err, "eth sender")
@@ -778,3 +791,232 @@ func (msg MsgSubmitBadSignatureEvidence) Type() string { return "Submit_Bad_Sign
 
 // Route should return the name of the module
 func (msg MsgSubmitBadSignatureEvidence) Route() string { return RouterKey }
+
+// MsgEthereumHeightClaim
+// ======================================================
+
+// NewMsgEthereumHeightClaim returns a new MsgEthereumHeightClaim
+func NewMsgEthereumHeightClaim(orchestrator sdk.AccAddress, ethereumHeight uint64) *MsgEthereumHeightClaim {
+	return &MsgEthereumHeightClaim{
+		EthereumHeight: ethereumHeight,
+		Orchestrator:   orchestrator.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgEthereumHeightClaim) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgEthereumHeightClaim) Type() string { return "ethereum_height_claim" }
+
+// ValidateBasic performs stateless checks
+func (msg *MsgEthereumHeightClaim) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Orchestrator); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Orchestrator)
+	}
+	if msg.EthereumHeight == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "ethereum height == 0")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgEthereumHeightClaim) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgEthereumHeightClaim) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Orchestrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
+
+// MsgSubmitConfirmations
+// ======================================================
+
+// MaxSubmissionEntries is the most confirms a MsgSubmitConfirmations, or claims a MsgSubmitClaims, may hold
+const MaxSubmissionEntries = 100
+
+// NewMsgSubmitConfirmations returns a new MsgSubmitConfirmations
+func NewMsgSubmitConfirmations(
+	orchestrator sdk.AccAddress,
+	valsetConfirms []MsgValsetConfirm,
+	batchConfirms []MsgConfirmBatch,
+	logicCallConfirms []MsgConfirmLogicCall,
+) *MsgSubmitConfirmations {
+	return &MsgSubmitConfirmations{
+		Orchestrator:      orchestrator.String(),
+		ValsetConfirms:    valsetConfirms,
+		BatchConfirms:     batchConfirms,
+		LogicCallConfirms: logicCallConfirms,
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgSubmitConfirmations) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgSubmitConfirmations) Type() string { return "submit_confirmations" }
+
+// ValidateBasic performs stateless checks, every confirm must be valid, signed by the orchestrator and for
+// the chain of the message
+func (msg *MsgSubmitConfirmations) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Orchestrator); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Orchestrator)
+	}
+	entries := len(msg.ValsetConfirms) + len(msg.BatchConfirms) + len(msg.LogicCallConfirms)
+	if entries == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "confirms")
+	}
+	if entries > MaxSubmissionEntries {
+		return sdkerrors.Wrapf(ErrInvalid, "%d confirms, at most %d", entries, MaxSubmissionEntries)
+	}
+	for i := range msg.ValsetConfirms {
+		if err := msg.checkConfirm(&msg.ValsetConfirms[i], msg.ValsetConfirms[i].Orchestrator, msg.ValsetConfirms[i].ChainId); err != nil {
+			return sdkerrors.Wrapf(err, "valset confirm %d", i)
+		}
+	}
+	for i := range msg.BatchConfirms {
+		if err := msg.checkConfirm(&msg.BatchConfirms[i], msg.BatchConfirms[i].Orchestrator, msg.BatchConfirms[i].ChainId); err != nil {
+			return sdkerrors.Wrapf(err, "batch confirm %d", i)
+		}
+	}
+	for i := range msg.LogicCallConfirms {
+		if err := msg.checkConfirm(&msg.LogicCallConfirms[i], msg.LogicCallConfirms[i].Orchestrator, msg.LogicCallConfirms[i].ChainId); err != nil {
+			return sdkerrors.Wrapf(err, "logic call confirm %d", i)
+		}
+	}
+	return nil
+}
+
+func (msg *MsgSubmitConfirmations) checkConfirm(confirm sdk.Msg, orchestrator string, chainID uint64) error {
+	if err := confirm.ValidateBasic(); err != nil {
+		return err
+	}
+	if orchestrator != msg.Orchestrator {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "confirm by %s", orchestrator)
+	}
+	if chainID != msg.ChainId {
+		return sdkerrors.Wrapf(ErrMismatched, "confirm for chain %d", chainID)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgSubmitConfirmations) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgSubmitConfirmations) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Orchestrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
+
+// MsgSubmitClaims
+// ======================================================
+
+// NewMsgSubmitClaims returns a new MsgSubmitClaims
+func NewMsgSubmitClaims(orchestrator sdk.AccAddress, claims []EthereumClaim) (*MsgSubmitClaims, error) {
+	anys := make([]*codectypes.Any, len(claims))
+	for i, claim := range claims {
+		msg, ok := claim.(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "claim %d is not a message", i)
+		}
+		any, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, err
+		}
+		anys[i] = any
+	}
+	return &MsgSubmitClaims{
+		Orchestrator: orchestrator.String(),
+		Claims:       anys,
+	}, nil
+}
+
+// Route should return the name of the module
+func (msg *MsgSubmitClaims) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgSubmitClaims) Type() string { return "submit_claims" }
+
+// ValidateBasic performs stateless checks, every claim must be valid, made by the orchestrator and the claims
+// must be sorted by event nonce
+func (msg *MsgSubmitClaims) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Orchestrator); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Orchestrator)
+	}
+	claims, err := msg.EthereumClaims()
+	if err != nil {
+		return err
+	}
+	if len(claims) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "claims")
+	}
+	if len(claims) > MaxSubmissionEntries {
+		return sdkerrors.Wrapf(ErrInvalid, "%d claims, at most %d", len(claims), MaxSubmissionEntries)
+	}
+	var lastNonce uint64
+	for i, claim := range claims {
+		if err := claim.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "claim %d", i)
+		}
+		if claimer := claim.GetClaimer().String(); claimer != msg.Orchestrator {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "claim %d by %s", i, claimer)
+		}
+		if claim.GetChainId() != msg.ChainId {
+			return sdkerrors.Wrapf(ErrMismatched, "claim %d for chain %d", i, claim.GetChainId())
+		}
+		if claim.GetEventNonce() <= lastNonce {
+			return sdkerrors.Wrapf(ErrInvalid, "claim %d is not sorted by event nonce", i)
+		}
+		lastNonce = claim.GetEventNonce()
+	}
+	return nil
+}
+
+// EthereumClaims returns the claims of the message in order
+func (msg *MsgSubmitClaims) EthereumClaims() ([]EthereumClaim, error) {
+	claims := make([]EthereumClaim, len(msg.Claims))
+	for i, any := range msg.Claims {
+		claim, ok := any.GetCachedValue().(EthereumClaim)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "claim %d is not an ethereum claim", i)
+		}
+		claims[i] = claim
+	}
+	return claims, nil
+}
+
+// UnpackInterfaces implements codectypes.UnpackInterfacesMessage
+func (msg *MsgSubmitClaims) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, any := range msg.Claims {
+		var claim EthereumClaim
+		if err := unpacker.UnpackAny(any, &claim); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgSubmitClaims) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgSubmitClaims) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Orchestrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
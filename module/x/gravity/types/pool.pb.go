@@ -24,6 +24,83 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// TransferStatus is a stage in the life of a transfer to Ethereum
+type TransferStatus int32
+
+const (
+	TRANSFER_STATUS_UNSPECIFIED     TransferStatus = 0
+	TRANSFER_STATUS_QUEUED          TransferStatus = 1
+	TRANSFER_STATUS_BATCHED         TransferStatus = 2
+	TRANSFER_STATUS_BATCH_TIMED_OUT TransferStatus = 3
+	TRANSFER_STATUS_BATCH_CANCELED  TransferStatus = 4
+	TRANSFER_STATUS_EXECUTED        TransferStatus = 5
+	TRANSFER_STATUS_CANCELED        TransferStatus = 6
+)
+
+var TransferStatus_name = map[int32]string{
+	0: "TRANSFER_STATUS_UNSPECIFIED",
+	1: "TRANSFER_STATUS_QUEUED",
+	2: "TRANSFER_STATUS_BATCHED",
+	3: "TRANSFER_STATUS_BATCH_TIMED_OUT",
+	4: "TRANSFER_STATUS_BATCH_CANCELED",
+	5: "TRANSFER_STATUS_EXECUTED",
+	6: "TRANSFER_STATUS_CANCELED",
+}
+
+var TransferStatus_value = map[string]int32{
+	"TRANSFER_STATUS_UNSPECIFIED":     0,
+	"TRANSFER_STATUS_QUEUED":          1,
+	"TRANSFER_STATUS_BATCHED":         2,
+	"TRANSFER_STATUS_BATCH_TIMED_OUT": 3,
+	"TRANSFER_STATUS_BATCH_CANCELED":  4,
+	"TRANSFER_STATUS_EXECUTED":        5,
+	"TRANSFER_STATUS_CANCELED":        6,
+}
+
+func (x TransferStatus) String() string {
+	return proto.EnumName(TransferStatus_name, int32(x))
+}
+
+func (TransferStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_18d107f7cfc31f22, []int{0}
+}
+
+// BatchSelectionPolicy decides which unbatched transfers go into the next batch of a token
+// BATCH_SELECTION_POLICY_FEE_PRIORITY:
+// The transfers paying the highest fees first
+// BATCH_SELECTION_POLICY_FEE_PER_AGE:
+// The transfers with the highest fee weighted by the blocks they have waited first, that is
+// fee * (blocks waited + 1)
+// BATCH_SELECTION_POLICY_FIFO_MIN_FEE:
+// The transfers paying at least the batch_minimum_fees entry of their token in the order they were queued
+type BatchSelectionPolicy int32
+
+const (
+	BATCH_SELECTION_POLICY_FEE_PRIORITY BatchSelectionPolicy = 0
+	BATCH_SELECTION_POLICY_FEE_PER_AGE  BatchSelectionPolicy = 1
+	BATCH_SELECTION_POLICY_FIFO_MIN_FEE BatchSelectionPolicy = 2
+)
+
+var BatchSelectionPolicy_name = map[int32]string{
+	0: "BATCH_SELECTION_POLICY_FEE_PRIORITY",
+	1: "BATCH_SELECTION_POLICY_FEE_PER_AGE",
+	2: "BATCH_SELECTION_POLICY_FIFO_MIN_FEE",
+}
+
+var BatchSelectionPolicy_value = map[string]int32{
+	"BATCH_SELECTION_POLICY_FEE_PRIORITY": 0,
+	"BATCH_SELECTION_POLICY_FEE_PER_AGE":  1,
+	"BATCH_SELECTION_POLICY_FIFO_MIN_FEE": 2,
+}
+
+func (x BatchSelectionPolicy) String() string {
+	return proto.EnumName(BatchSelectionPolicy_name, int32(x))
+}
+
+func (BatchSelectionPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_18d107f7cfc31f22, []int{1}
+}
+
 // IDSet represents a set of IDs
 type IDSet struct {
 	Ids []uint64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
@@ -114,32 +191,270 @@ func (m *BatchFees) GetToken() string {
 	return ""
 }
 
+// QueuedTransferHeight records the Cosmos block height a transfer to Ethereum was queued at,
+// it is kept until the transfer is executed or canceled, across batches that time out. The
+// token contract and fee locate the transfer in the pool.
+type QueuedTransferHeight struct {
+	TxId          uint64                                 `protobuf:"varint,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Height        int64                                  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	TokenContract string                                 `protobuf:"bytes,3,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Fee           github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=fee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"fee"`
+}
+
+func (m *QueuedTransferHeight) Reset()         { *m = QueuedTransferHeight{} }
+func (m *QueuedTransferHeight) String() string { return proto.CompactTextString(m) }
+func (*QueuedTransferHeight) ProtoMessage()    {}
+func (*QueuedTransferHeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_18d107f7cfc31f22, []int{2}
+}
+func (m *QueuedTransferHeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueuedTransferHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueuedTransferHeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueuedTransferHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueuedTransferHeight.Merge(m, src)
+}
+func (m *QueuedTransferHeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueuedTransferHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueuedTransferHeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueuedTransferHeight proto.InternalMessageInfo
+
+func (m *QueuedTransferHeight) GetTxId() uint64 {
+	if m != nil {
+		return m.TxId
+	}
+	return 0
+}
+
+func (m *QueuedTransferHeight) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueuedTransferHeight) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+// TransferStatusUpdate is a step in the life of a transfer to Ethereum
+// BATCH_NONCE:
+// The batch the transfer entered or left, zero for steps that do not involve a batch
+// ETHEREUM_HEIGHT:
+// The Ethereum block height the batch was executed at, only set for TRANSFER_STATUS_EXECUTED
+// HEIGHT:
+// The Cosmos block height of the step
+type TransferStatusUpdate struct {
+	Status         TransferStatus `protobuf:"varint,1,opt,name=status,proto3,enum=gravity.v1.TransferStatus" json:"status,omitempty"`
+	BatchNonce     uint64         `protobuf:"varint,2,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
+	EthereumHeight uint64         `protobuf:"varint,3,opt,name=ethereum_height,json=ethereumHeight,proto3" json:"ethereum_height,omitempty"`
+	Height         int64          `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *TransferStatusUpdate) Reset()         { *m = TransferStatusUpdate{} }
+func (m *TransferStatusUpdate) String() string { return proto.CompactTextString(m) }
+func (*TransferStatusUpdate) ProtoMessage()    {}
+func (*TransferStatusUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_18d107f7cfc31f22, []int{3}
+}
+func (m *TransferStatusUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferStatusUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferStatusUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferStatusUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferStatusUpdate.Merge(m, src)
+}
+func (m *TransferStatusUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferStatusUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferStatusUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferStatusUpdate proto.InternalMessageInfo
+
+func (m *TransferStatusUpdate) GetStatus() TransferStatus {
+	if m != nil {
+		return m.Status
+	}
+	return TRANSFER_STATUS_UNSPECIFIED
+}
+
+func (m *TransferStatusUpdate) GetBatchNonce() uint64 {
+	if m != nil {
+		return m.BatchNonce
+	}
+	return 0
+}
+
+func (m *TransferStatusUpdate) GetEthereumHeight() uint64 {
+	if m != nil {
+		return m.EthereumHeight
+	}
+	return 0
+}
+
+func (m *TransferStatusUpdate) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// TransferHistory records every step in the life of a transfer to Ethereum, the
+// record is pruned transfer_history_retention blocks after the transfer was
+// executed or canceled
+// COMPLETED_HEIGHT:
+// The Cosmos block height the transfer was executed or canceled at, zero while it
+// is in flight
+type TransferHistory struct {
+	Transfer        OutgoingTransferTx     `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer"`
+	Updates         []TransferStatusUpdate `protobuf:"bytes,2,rep,name=updates,proto3" json:"updates"`
+	CompletedHeight int64                  `protobuf:"varint,3,opt,name=completed_height,json=completedHeight,proto3" json:"completed_height,omitempty"`
+}
+
+func (m *TransferHistory) Reset()         { *m = TransferHistory{} }
+func (m *TransferHistory) String() string { return proto.CompactTextString(m) }
+func (*TransferHistory) ProtoMessage()    {}
+func (*TransferHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_18d107f7cfc31f22, []int{4}
+}
+func (m *TransferHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferHistory.Merge(m, src)
+}
+func (m *TransferHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferHistory proto.InternalMessageInfo
+
+func (m *TransferHistory) GetTransfer() OutgoingTransferTx {
+	if m != nil {
+		return m.Transfer
+	}
+	return OutgoingTransferTx{}
+}
+
+func (m *TransferHistory) GetUpdates() []TransferStatusUpdate {
+	if m != nil {
+		return m.Updates
+	}
+	return nil
+}
+
+func (m *TransferHistory) GetCompletedHeight() int64 {
+	if m != nil {
+		return m.CompletedHeight
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("gravity.v1.TransferStatus", TransferStatus_name, TransferStatus_value)
+	proto.RegisterEnum("gravity.v1.BatchSelectionPolicy", BatchSelectionPolicy_name, BatchSelectionPolicy_value)
 	proto.RegisterType((*IDSet)(nil), "gravity.v1.IDSet")
 	proto.RegisterType((*BatchFees)(nil), "gravity.v1.BatchFees")
+	proto.RegisterType((*QueuedTransferHeight)(nil), "gravity.v1.QueuedTransferHeight")
+	proto.RegisterType((*TransferStatusUpdate)(nil), "gravity.v1.TransferStatusUpdate")
+	proto.RegisterType((*TransferHistory)(nil), "gravity.v1.TransferHistory")
 }
 
 func init() { proto.RegisterFile("gravity/v1/pool.proto", fileDescriptor_18d107f7cfc31f22) }
 
 var fileDescriptor_18d107f7cfc31f22 = []byte{
-	// 262 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4d, 0x2f, 0x4a, 0x2c,
-	0xcb, 0x2c, 0xa9, 0xd4, 0x2f, 0x33, 0xd4, 0x2f, 0xc8, 0xcf, 0xcf, 0xd1, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0xe2, 0x82, 0x0a, 0xeb, 0x95, 0x19, 0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x85,
-	0xf5, 0x41, 0x2c, 0x88, 0x0a, 0x25, 0x49, 0x2e, 0x56, 0x4f, 0x97, 0xe0, 0xd4, 0x12, 0x21, 0x01,
-	0x2e, 0xe6, 0xcc, 0x94, 0x62, 0x09, 0x46, 0x05, 0x66, 0x0d, 0x96, 0x20, 0x10, 0x53, 0xa9, 0x80,
-	0x8b, 0xd3, 0x29, 0xb1, 0x24, 0x39, 0xc3, 0x2d, 0x35, 0xb5, 0x58, 0x48, 0x84, 0x8b, 0xb5, 0x24,
-	0x3f, 0x3b, 0x35, 0x4f, 0x82, 0x51, 0x81, 0x51, 0x83, 0x33, 0x08, 0xc2, 0x11, 0xf2, 0xe5, 0xe2,
-	0x2a, 0xc9, 0x2f, 0x49, 0xcc, 0x89, 0x4f, 0x4b, 0x4d, 0x2d, 0x96, 0x60, 0x02, 0x49, 0x39, 0xe9,
-	0x9d, 0xb8, 0x27, 0xcf, 0x70, 0xeb, 0x9e, 0xbc, 0x5a, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e,
-	0x72, 0x7e, 0xae, 0x7e, 0x72, 0x7e, 0x71, 0x6e, 0x7e, 0x31, 0x94, 0xd2, 0x2d, 0x4e, 0xc9, 0xd6,
-	0x2f, 0xa9, 0x2c, 0x48, 0x2d, 0xd6, 0xf3, 0xcc, 0x2b, 0x09, 0xe2, 0x04, 0x9b, 0x00, 0xb2, 0xc4,
-	0x29, 0xe6, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0,
-	0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x9c, 0x90, 0x0c, 0x4b,
-	0xcc, 0x29, 0xc9, 0x48, 0x4d, 0xd4, 0xcd, 0x4b, 0x2d, 0x81, 0x19, 0x08, 0xf5, 0xa5, 0x6e, 0x52,
-	0x51, 0x66, 0x4a, 0x7a, 0xaa, 0x7e, 0x6e, 0x7e, 0x4a, 0x69, 0x4e, 0xaa, 0x7e, 0x85, 0x3e, 0x2c,
-	0x50, 0xc0, 0x96, 0x25, 0xb1, 0x81, 0x7d, 0x6c, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x44, 0x58,
-	0x7f, 0x3a, 0x2c, 0x01, 0x00, 0x00,
+	// 713 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xc1, 0x4e, 0xdb, 0x4a,
+	0x14, 0x8d, 0xb1, 0x93, 0xf7, 0x18, 0xf4, 0x82, 0x35, 0x2f, 0xa5, 0x69, 0xa8, 0x1c, 0x14, 0x54,
+	0xa0, 0x48, 0xc4, 0x22, 0xfd, 0x01, 0x12, 0x67, 0x52, 0x2c, 0x41, 0x12, 0x6c, 0x47, 0x2a, 0x55,
+	0x25, 0xcb, 0xb1, 0x07, 0xc7, 0x22, 0xf1, 0x44, 0xf6, 0x18, 0x25, 0x7f, 0xd0, 0x65, 0x57, 0xfd,
+	0x81, 0x6e, 0xfb, 0x11, 0x5d, 0xb2, 0x64, 0x59, 0x75, 0x81, 0x2a, 0xf8, 0x86, 0xee, 0x2b, 0x8f,
+	0x9d, 0x10, 0x10, 0x65, 0xd1, 0x55, 0x66, 0xce, 0x3d, 0xe7, 0xde, 0x73, 0xef, 0xe4, 0x1a, 0x3c,
+	0x73, 0x03, 0xeb, 0xc2, 0xa3, 0x53, 0xf9, 0x62, 0x5f, 0x1e, 0x13, 0x32, 0xac, 0x8e, 0x03, 0x42,
+	0x09, 0x04, 0x29, 0x5c, 0xbd, 0xd8, 0x2f, 0x15, 0x5c, 0xe2, 0x12, 0x06, 0xcb, 0xf1, 0x29, 0x61,
+	0x94, 0xd6, 0x16, 0x84, 0x7d, 0x8b, 0xda, 0x83, 0x04, 0xaf, 0xbc, 0x00, 0x59, 0xb5, 0xa9, 0x63,
+	0x0a, 0x45, 0xc0, 0x7b, 0x4e, 0x58, 0xe4, 0x36, 0xf8, 0x1d, 0x41, 0x8b, 0x8f, 0x95, 0x31, 0x58,
+	0x6e, 0xc4, 0xcc, 0x16, 0xc6, 0x21, 0x2c, 0x80, 0x2c, 0x25, 0xe7, 0xd8, 0x2f, 0x72, 0x1b, 0xdc,
+	0xce, 0xb2, 0x96, 0x5c, 0xe0, 0x31, 0x00, 0x94, 0x50, 0x6b, 0x68, 0x9e, 0x61, 0x1c, 0x16, 0x97,
+	0xe2, 0x50, 0xa3, 0x7a, 0x79, 0x5d, 0xce, 0xfc, 0xb8, 0x2e, 0x6f, 0xb9, 0x1e, 0x1d, 0x44, 0xfd,
+	0xaa, 0x4d, 0x46, 0xb2, 0x4d, 0xc2, 0x11, 0x09, 0xd3, 0x9f, 0xbd, 0xd0, 0x39, 0x97, 0xe9, 0x74,
+	0x8c, 0xc3, 0xaa, 0xea, 0x53, 0x6d, 0x99, 0x65, 0x88, 0x8b, 0x54, 0xbe, 0x72, 0xa0, 0x70, 0x12,
+	0xe1, 0x08, 0x3b, 0x46, 0x60, 0xf9, 0xe1, 0x19, 0x0e, 0x0e, 0xb1, 0xe7, 0x0e, 0x28, 0xfc, 0x1f,
+	0x64, 0xe9, 0xc4, 0xf4, 0x1c, 0x56, 0x5d, 0xd0, 0x04, 0x3a, 0x51, 0x1d, 0xb8, 0x06, 0x72, 0x03,
+	0x16, 0x66, 0x85, 0x79, 0x2d, 0xbd, 0xc1, 0x57, 0x20, 0xcf, 0xdc, 0x99, 0x36, 0xf1, 0x69, 0x60,
+	0xd9, 0xb4, 0xc8, 0x33, 0xcf, 0xff, 0x31, 0x54, 0x49, 0x41, 0x78, 0x00, 0xf8, 0x33, 0x8c, 0x8b,
+	0xc2, 0x5f, 0x99, 0x8e, 0xa5, 0xcc, 0xee, 0xcc, 0xa8, 0x4e, 0x2d, 0x1a, 0x85, 0xbd, 0xb1, 0x63,
+	0x51, 0x0c, 0x6b, 0x20, 0x17, 0xb2, 0x3b, 0xf3, 0x9b, 0xaf, 0x95, 0xaa, 0x77, 0xef, 0x53, 0xbd,
+	0xaf, 0xd0, 0x52, 0x26, 0x2c, 0x83, 0x15, 0xf6, 0x2e, 0xa6, 0x4f, 0x7c, 0x1b, 0xb3, 0x96, 0x04,
+	0x0d, 0x30, 0xa8, 0x1d, 0x23, 0x70, 0x1b, 0xac, 0x62, 0x3a, 0xc0, 0x01, 0x8e, 0x46, 0x66, 0xda,
+	0x37, 0xcf, 0x48, 0xf9, 0x19, 0x9c, 0x0e, 0xeb, 0x6e, 0x2e, 0xc2, 0xe2, 0x5c, 0x2a, 0xdf, 0x38,
+	0xb0, 0x3a, 0x9f, 0xab, 0x17, 0x52, 0x12, 0x4c, 0xe1, 0x01, 0xf8, 0x97, 0xa6, 0x10, 0xf3, 0xba,
+	0x52, 0x93, 0x16, 0xbd, 0x76, 0x22, 0xea, 0x12, 0xcf, 0x77, 0x67, 0x32, 0x63, 0xd2, 0x10, 0xe2,
+	0x49, 0x69, 0x73, 0x15, 0x3c, 0x00, 0xff, 0x44, 0xac, 0xeb, 0xf8, 0xfd, 0xf9, 0x9d, 0x95, 0xda,
+	0xc6, 0x9f, 0x9b, 0x4d, 0xc6, 0x93, 0xa6, 0x98, 0xc9, 0xe0, 0x6b, 0x20, 0xda, 0x64, 0x34, 0x1e,
+	0x62, 0x8a, 0x9d, 0xc5, 0xce, 0x78, 0x6d, 0x75, 0x8e, 0x27, 0xad, 0xed, 0xfe, 0xe2, 0x40, 0xfe,
+	0x7e, 0x4a, 0x58, 0x06, 0xeb, 0x86, 0x56, 0x6f, 0xeb, 0x2d, 0xa4, 0x99, 0xba, 0x51, 0x37, 0x7a,
+	0xba, 0xd9, 0x6b, 0xeb, 0x5d, 0xa4, 0xa8, 0x2d, 0x15, 0x35, 0xc5, 0x0c, 0x2c, 0x81, 0xb5, 0x87,
+	0x84, 0x93, 0x1e, 0xea, 0xa1, 0xa6, 0xc8, 0xc1, 0x75, 0xf0, 0xfc, 0x61, 0xac, 0x51, 0x37, 0x94,
+	0x43, 0xd4, 0x14, 0x97, 0xe0, 0x26, 0x28, 0x3f, 0x1a, 0x34, 0x0d, 0xf5, 0x18, 0x35, 0xcd, 0x4e,
+	0xcf, 0x10, 0x79, 0x58, 0x01, 0xd2, 0xe3, 0x24, 0xa5, 0xde, 0x56, 0xd0, 0x11, 0x6a, 0x8a, 0x02,
+	0x7c, 0x09, 0x8a, 0x0f, 0x39, 0xe8, 0x1d, 0x52, 0x7a, 0x06, 0x6a, 0x8a, 0xd9, 0xc7, 0xa2, 0x73,
+	0x6d, 0xae, 0x24, 0x7c, 0xfc, 0x22, 0x65, 0x76, 0x3f, 0x73, 0xa0, 0xc0, 0x76, 0x51, 0xc7, 0x43,
+	0x6c, 0x53, 0x8f, 0xf8, 0x5d, 0x32, 0xf4, 0xec, 0x29, 0xdc, 0x06, 0x9b, 0x49, 0x39, 0x1d, 0x1d,
+	0x21, 0xc5, 0x50, 0x3b, 0x6d, 0xb3, 0xdb, 0x39, 0x52, 0x95, 0x53, 0xb3, 0x85, 0x90, 0xd9, 0xd5,
+	0xd4, 0x8e, 0xa6, 0x1a, 0xa7, 0x62, 0x06, 0x6e, 0x81, 0xca, 0x53, 0x44, 0xa4, 0x99, 0xf5, 0xb7,
+	0x48, 0xe4, 0x9e, 0x4a, 0xa8, 0xb6, 0x3a, 0xe6, 0xb1, 0xda, 0x8e, 0x05, 0xe2, 0x52, 0x62, 0xac,
+	0xf1, 0xe1, 0xf2, 0x46, 0xe2, 0xae, 0x6e, 0x24, 0xee, 0xe7, 0x8d, 0xc4, 0x7d, 0xba, 0x95, 0x32,
+	0x57, 0xb7, 0x52, 0xe6, 0xfb, 0xad, 0x94, 0x79, 0xdf, 0x58, 0xd8, 0x24, 0x6b, 0x48, 0x07, 0xd8,
+	0xda, 0xf3, 0x31, 0x9d, 0x6d, 0x53, 0xfa, 0x17, 0xd9, 0xeb, 0x07, 0x9e, 0xe3, 0x62, 0x79, 0x44,
+	0x9c, 0x68, 0x88, 0xe5, 0x89, 0x9c, 0xe2, 0xc9, 0xa6, 0xf5, 0x73, 0xec, 0x1b, 0xf5, 0xe6, 0xf7,
+	0x00, 0x47, 0xfa, 0xb8, 0x0f, 0xf6, 0x04, 0x00, 0x00,
 }
 
 func (m *IDSet) Marshal() (dAtA []byte, err error) {
@@ -223,6 +538,151 @@ func (m *BatchFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueuedTransferHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuedTransferHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedTransferHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Fee.Size()
+		i -= size
+		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TxId != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.TxId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferStatusUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferStatusUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferStatusUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EthereumHeight != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.EthereumHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BatchNonce != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Status != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompletedHeight != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.CompletedHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Updates) > 0 {
+		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Transfer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPool(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPool(v)
 	base := offset
@@ -265,17 +725,79 @@ func (m *BatchFees) Size() (n int) {
 	return n
 }
 
-func sovPool(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueuedTransferHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxId != 0 {
+		n += 1 + sovPool(uint64(m.TxId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovPool(uint64(m.Height))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovPool(uint64(l))
+	return n
 }
-func sozPool(x uint64) (n int) {
-	return sovPool(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *TransferStatusUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovPool(uint64(m.Status))
+	}
+	if m.BatchNonce != 0 {
+		n += 1 + sovPool(uint64(m.BatchNonce))
+	}
+	if m.EthereumHeight != 0 {
+		n += 1 + sovPool(uint64(m.EthereumHeight))
+	}
+	if m.Height != 0 {
+		n += 1 + sovPool(uint64(m.Height))
+	}
+	return n
 }
-func (m *IDSet) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
+
+func (m *TransferHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Transfer.Size()
+	n += 1 + l + sovPool(uint64(l))
+	if len(m.Updates) > 0 {
+		for _, e := range m.Updates {
+			l = e.Size()
+			n += 1 + l + sovPool(uint64(l))
+		}
+	}
+	if m.CompletedHeight != 0 {
+		n += 1 + sovPool(uint64(m.CompletedHeight))
+	}
+	return n
+}
+
+func sovPool(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPool(x uint64) (n int) {
+	return sovPool(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *IDSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -519,6 +1041,431 @@ func (m *BatchFees) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueuedTransferHeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedTransferHeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedTransferHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			m.TxId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferStatusUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferStatusUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferStatusUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TransferStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			}
+			m.BatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeight", wireType)
+			}
+			m.EthereumHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Transfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Updates = append(m.Updates, TransferStatusUpdate{})
+			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedHeight", wireType)
+			}
+			m.CompletedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipPool(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,238 @@
+package types
+
+import (
+	"fmt"
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeClearBridgeHijack defines the type for a ClearBridgeHijackProposal
+	ProposalTypeClearBridgeHijack = "ClearBridgeHijack"
+	// ProposalTypeReleaseFailedDeposit defines the type for a ReleaseFailedDepositProposal
+	ProposalTypeReleaseFailedDeposit = "ReleaseFailedDeposit"
+	// ProposalTypeUpdateBlocklist defines the type for a UpdateBlocklistProposal
+	ProposalTypeUpdateBlocklist = "UpdateBlocklist"
+	// ProposalTypeReleaseQuarantinedDeposit defines the type for a ReleaseQuarantinedDepositProposal
+	ProposalTypeReleaseQuarantinedDeposit = "ReleaseQuarantinedDeposit"
+	// ProposalTypeSetERC20Metadata defines the type for a SetERC20MetadataProposal
+	ProposalTypeSetERC20Metadata = "SetERC20Metadata"
+)
+
+var (
+	_ govtypes.Content = &ClearBridgeHijackProposal{}
+	_ govtypes.Content = &ReleaseFailedDepositProposal{}
+	_ govtypes.Content = &UpdateBlocklistProposal{}
+	_ govtypes.Content = &ReleaseQuarantinedDepositProposal{}
+	_ govtypes.Content = &SetERC20MetadataProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeClearBridgeHijack)
+	govtypes.RegisterProposalTypeCodec(&ClearBridgeHijackProposal{}, "gravity/ClearBridgeHijackProposal")
+	govtypes.RegisterProposalType(ProposalTypeReleaseFailedDeposit)
+	govtypes.RegisterProposalTypeCodec(&ReleaseFailedDepositProposal{}, "gravity/ReleaseFailedDepositProposal")
+	govtypes.RegisterProposalType(ProposalTypeUpdateBlocklist)
+	govtypes.RegisterProposalTypeCodec(&UpdateBlocklistProposal{}, "gravity/UpdateBlocklistProposal")
+	govtypes.RegisterProposalType(ProposalTypeReleaseQuarantinedDeposit)
+	govtypes.RegisterProposalTypeCodec(&ReleaseQuarantinedDepositProposal{}, "gravity/ReleaseQuarantinedDepositProposal")
+	govtypes.RegisterProposalType(ProposalTypeSetERC20Metadata)
+	govtypes.RegisterProposalTypeCodec(&SetERC20MetadataProposal{}, "gravity/SetERC20MetadataProposal")
+}
+
+// NewClearBridgeHijackProposal creates a new ClearBridgeHijackProposal
+func NewClearBridgeHijackProposal(title, description string) *ClearBridgeHijackProposal {
+	return &ClearBridgeHijackProposal{Title: title, Description: description}
+}
+
+// ProposalRoute returns the routing key of the proposal
+func (p *ClearBridgeHijackProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *ClearBridgeHijackProposal) ProposalType() string { return ProposalTypeClearBridgeHijack }
+
+// ValidateBasic performs stateless checks
+func (p *ClearBridgeHijackProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return sdkerrors.Wrap(err, "invalid proposal")
+	}
+	return nil
+}
+
+// NewReleaseFailedDepositProposal creates a new ReleaseFailedDepositProposal, an empty recipient refunds
+// the deposit to its Ethereum sender
+func NewReleaseFailedDepositProposal(title, description string, eventNonce uint64, recipient string) *ReleaseFailedDepositProposal {
+	return &ReleaseFailedDepositProposal{Title: title, Description: description, EventNonce: eventNonce, Recipient: recipient}
+}
+
+// ProposalRoute returns the routing key of the proposal
+func (p *ReleaseFailedDepositProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *ReleaseFailedDepositProposal) ProposalType() string { return ProposalTypeReleaseFailedDeposit }
+
+// ValidateBasic performs stateless checks
+func (p *ReleaseFailedDepositProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return sdkerrors.Wrap(err, "invalid proposal")
+	}
+	if p.EventNonce == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "event nonce")
+	}
+	if p.Recipient != "" {
+		if _, err := sdk.AccAddressFromBech32(p.Recipient); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, p.Recipient)
+		}
+	}
+	return nil
+}
+
+// NewUpdateBlocklistProposal creates a new UpdateBlocklistProposal
+func NewUpdateBlocklistProposal(title, description string, blocked, unblocked []string) *UpdateBlocklistProposal {
+	return &UpdateBlocklistProposal{Title: title, Description: description, Blocked: blocked, Unblocked: unblocked}
+}
+
+// ProposalRoute returns the routing key of the proposal
+func (p *UpdateBlocklistProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *UpdateBlocklistProposal) ProposalType() string { return ProposalTypeUpdateBlocklist }
+
+// ValidateBasic performs stateless checks
+func (p *UpdateBlocklistProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return sdkerrors.Wrap(err, "invalid proposal")
+	}
+	if len(p.Blocked) == 0 && len(p.Unblocked) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "blocklist update")
+	}
+	seen := make(map[string]bool, len(p.Blocked)+len(p.Unblocked))
+	for _, address := range append(append([]string{}, p.Blocked...), p.Unblocked...) {
+		normalized, err := NormalizeBlocklistAddress(address)
+		if err != nil {
+			return err
+		}
+		if seen[normalized] {
+			return sdkerrors.Wrapf(ErrDuplicate, "address %s", address)
+		}
+		seen[normalized] = true
+	}
+	return nil
+}
+
+// NormalizeBlocklistAddress returns the form an Ethereum or Cosmos account address is kept in on the
+// blocklist, lower case hex for Ethereum addresses and bech32 for Cosmos ones
+func NormalizeBlocklistAddress(address string) (string, error) {
+	if ethAddress, err := NewEthAddress(address); err == nil {
+		return ethAddress.GetAddress(), nil
+	}
+	accAddress, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return "", sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("%s is neither an Ethereum nor a Cosmos address", address))
+	}
+	return accAddress.String(), nil
+}
+
+// NewReleaseQuarantinedDepositProposal creates a new ReleaseQuarantinedDepositProposal, an empty recipient
+// pays the deposit to its Cosmos receiver
+func NewReleaseQuarantinedDepositProposal(title, description string, eventNonce uint64, recipient string) *ReleaseQuarantinedDepositProposal {
+	return &ReleaseQuarantinedDepositProposal{Title: title, Description: description, EventNonce: eventNonce, Recipient: recipient}
+}
+
+// ProposalRoute returns the routing key of the proposal
+func (p *ReleaseQuarantinedDepositProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *ReleaseQuarantinedDepositProposal) ProposalType() string {
+	return ProposalTypeReleaseQuarantinedDeposit
+}
+
+// ValidateBasic performs stateless checks
+func (p *ReleaseQuarantinedDepositProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return sdkerrors.Wrap(err, "invalid proposal")
+	}
+	if p.EventNonce == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "event nonce")
+	}
+	if p.Recipient != "" {
+		if _, err := sdk.AccAddressFromBech32(p.Recipient); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, p.Recipient)
+		}
+	}
+	return nil
+}
+
+// NewSetERC20MetadataProposal creates a new SetERC20MetadataProposal, an empty display names the display unit
+// after the symbol
+func NewSetERC20MetadataProposal(title, description string, erc20 string, name, symbol string, decimals uint32, display string, aliases []string) *SetERC20MetadataProposal {
+	return &SetERC20MetadataProposal{
+		Title:       title,
+		Description: description,
+		Erc20:       erc20,
+		Name:        name,
+		Symbol:      symbol,
+		Decimals:    decimals,
+		Display:     display,
+		Aliases:     aliases,
+	}
+}
+
+// ProposalRoute returns the routing key of the proposal
+func (p *SetERC20MetadataProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *SetERC20MetadataProposal) ProposalType() string { return ProposalTypeSetERC20Metadata }
+
+// ValidateBasic performs stateless checks
+func (p *SetERC20MetadataProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return sdkerrors.Wrap(err, "invalid proposal")
+	}
+	tokenContract, err := NewEthAddress(p.Erc20)
+	if err != nil {
+		return sdkerrors.Wrap(err, "erc20")
+	}
+	// the metadata is checked against the denom of the default chain, the denoms of every chain are alike
+	_, err = NewERC20DenomMetadata(GravityDenom(*tokenContract), p.Name, p.Symbol, p.Decimals, p.Display, p.Aliases)
+	return err
+}
+
+// NewERC20DenomMetadata returns the bank metadata of denom, the vouchers of an ERC20 with the given name, symbol
+// and decimals. The display unit, named after the symbol when display is empty and known by the aliases as well,
+// is worth 10^decimals vouchers. Tokens without decimals have no unit other than the base one and the display
+// name is an alias of it.
+func NewERC20DenomMetadata(denom string, name, symbol string, decimals uint32, display string, aliases []string) (banktypes.Metadata, error) {
+	if decimals > math.MaxUint8 {
+		return banktypes.Metadata{}, sdkerrors.Wrapf(ErrInvalid, "%d decimals", decimals)
+	}
+	if display == "" {
+		display = symbol
+	}
+	metadata := banktypes.Metadata{
+		Description: fmt.Sprintf("%s bridged from Ethereum", name),
+		Base:        denom,
+		Display:     display,
+		Name:        name,
+		Symbol:      symbol,
+	}
+	if decimals == 0 {
+		metadata.Display = denom
+		metadata.DenomUnits = []*banktypes.DenomUnit{
+			{Denom: denom, Exponent: 0, Aliases: append([]string{display}, aliases...)},
+		}
+	} else {
+		metadata.DenomUnits = []*banktypes.DenomUnit{
+			{Denom: denom, Exponent: 0, Aliases: nil},
+			{Denom: display, Exponent: decimals, Aliases: aliases},
+		}
+	}
+	if err := metadata.Validate(); err != nil {
+		return banktypes.Metadata{}, sdkerrors.Wrap(ErrInvalid, err.Error())
+	}
+	return metadata, nil
+}
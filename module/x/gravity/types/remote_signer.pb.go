@@ -0,0 +1,1629 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gravity/v1/remote_signer.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type RemoteSignerEthAddressRequest struct {
+}
+
+func (m *RemoteSignerEthAddressRequest) Reset()         { *m = RemoteSignerEthAddressRequest{} }
+func (m *RemoteSignerEthAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoteSignerEthAddressRequest) ProtoMessage()    {}
+func (*RemoteSignerEthAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_907c6f9ab58125a9, []int{0}
+}
+func (m *RemoteSignerEthAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoteSignerEthAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoteSignerEthAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoteSignerEthAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoteSignerEthAddressRequest.Merge(m, src)
+}
+func (m *RemoteSignerEthAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoteSignerEthAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoteSignerEthAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoteSignerEthAddressRequest proto.InternalMessageInfo
+
+type RemoteSignerEthAddressResponse struct {
+	EthAddress string `protobuf:"bytes,1,opt,name=eth_address,json=ethAddress,proto3" json:"eth_address,omitempty"`
+}
+
+func (m *RemoteSignerEthAddressResponse) Reset()         { *m = RemoteSignerEthAddressResponse{} }
+func (m *RemoteSignerEthAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoteSignerEthAddressResponse) ProtoMessage()    {}
+func (*RemoteSignerEthAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_907c6f9ab58125a9, []int{1}
+}
+func (m *RemoteSignerEthAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoteSignerEthAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoteSignerEthAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoteSignerEthAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoteSignerEthAddressResponse.Merge(m, src)
+}
+func (m *RemoteSignerEthAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoteSignerEthAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoteSignerEthAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoteSignerEthAddressResponse proto.InternalMessageInfo
+
+func (m *RemoteSignerEthAddressResponse) GetEthAddress() string {
+	if m != nil {
+		return m.EthAddress
+	}
+	return ""
+}
+
+// RemoteSignValsetRequest asks for the signature of a valset of the EVM chain
+// chain_id, zero being the default chain. gravity_id is the salt the requester
+// expects, the signer refuses to sign if the chain uses another one.
+type RemoteSignValsetRequest struct {
+	Valset    Valset `protobuf:"bytes,1,opt,name=valset,proto3" json:"valset"`
+	ChainId   uint64 `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	GravityId string `protobuf:"bytes,3,opt,name=gravity_id,json=gravityId,proto3" json:"gravity_id,omitempty"`
+}
+
+func (m *RemoteSignValsetRequest) Reset()         { *m = RemoteSignValsetRequest{} }
+func (m *RemoteSignValsetRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoteSignValsetRequest) ProtoMessage()    {}
+func (*RemoteSignValsetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_907c6f9ab58125a9, []int{2}
+}
+func (m *RemoteSignValsetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoteSignValsetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoteSignValsetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoteSignValsetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoteSignValsetRequest.Merge(m, src)
+}
+func (m *RemoteSignValsetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoteSignValsetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoteSignValsetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoteSignValsetRequest proto.InternalMessageInfo
+
+func (m *RemoteSignValsetRequest) GetValset() Valset {
+	if m != nil {
+		return m.Valset
+	}
+	return Valset{}
+}
+
+func (m *RemoteSignValsetRequest) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *RemoteSignValsetRequest) GetGravityId() string {
+	if m != nil {
+		return m.GravityId
+	}
+	return ""
+}
+
+// RemoteSignBatchRequest asks for the signature of a batch, see
+// RemoteSignValsetRequest
+type RemoteSignBatchRequest struct {
+	Batch     OutgoingTxBatch `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch"`
+	ChainId   uint64          `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	GravityId string          `protobuf:"bytes,3,opt,name=gravity_id,json=gravityId,proto3" json:"gravity_id,omitempty"`
+}
+
+func (m *RemoteSignBatchRequest) Reset()         { *m = RemoteSignBatchRequest{} }
+func (m *RemoteSignBatchRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoteSignBatchRequest) ProtoMessage()    {}
+func (*RemoteSignBatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_907c6f9ab58125a9, []int{3}
+}
+func (m *RemoteSignBatchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoteSignBatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoteSignBatchRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoteSignBatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoteSignBatchRequest.Merge(m, src)
+}
+func (m *RemoteSignBatchRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoteSignBatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoteSignBatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoteSignBatchRequest proto.InternalMessageInfo
+
+func (m *RemoteSignBatchRequest) GetBatch() OutgoingTxBatch {
+	if m != nil {
+		return m.Batch
+	}
+	return OutgoingTxBatch{}
+}
+
+func (m *RemoteSignBatchRequest) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *RemoteSignBatchRequest) GetGravityId() string {
+	if m != nil {
+		return m.GravityId
+	}
+	return ""
+}
+
+// RemoteSignLogicCallRequest asks for the signature of a logic call, see
+// RemoteSignValsetRequest
+type RemoteSignLogicCallRequest struct {
+	Call      OutgoingLogicCall `protobuf:"bytes,1,opt,name=call,proto3" json:"call"`
+	ChainId   uint64            `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	GravityId string            `protobuf:"bytes,3,opt,name=gravity_id,json=gravityId,proto3" json:"gravity_id,omitempty"`
+}
+
+func (m *RemoteSignLogicCallRequest) Reset()         { *m = RemoteSignLogicCallRequest{} }
+func (m *RemoteSignLogicCallRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoteSignLogicCallRequest) ProtoMessage()    {}
+func (*RemoteSignLogicCallRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_907c6f9ab58125a9, []int{4}
+}
+func (m *RemoteSignLogicCallRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoteSignLogicCallRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoteSignLogicCallRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoteSignLogicCallRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoteSignLogicCallRequest.Merge(m, src)
+}
+func (m *RemoteSignLogicCallRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoteSignLogicCallRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoteSignLogicCallRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoteSignLogicCallRequest proto.InternalMessageInfo
+
+func (m *RemoteSignLogicCallRequest) GetCall() OutgoingLogicCall {
+	if m != nil {
+		return m.Call
+	}
+	return OutgoingLogicCall{}
+}
+
+func (m *RemoteSignLogicCallRequest) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *RemoteSignLogicCallRequest) GetGravityId() string {
+	if m != nil {
+		return m.GravityId
+	}
+	return ""
+}
+
+// RemoteSignResponse is a 65 bytes signature in the format of the
+// signatures of confirms
+type RemoteSignResponse struct {
+	Signature []byte `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *RemoteSignResponse) Reset()         { *m = RemoteSignResponse{} }
+func (m *RemoteSignResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoteSignResponse) ProtoMessage()    {}
+func (*RemoteSignResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_907c6f9ab58125a9, []int{5}
+}
+func (m *RemoteSignResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoteSignResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoteSignResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoteSignResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoteSignResponse.Merge(m, src)
+}
+func (m *RemoteSignResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoteSignResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoteSignResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoteSignResponse proto.InternalMessageInfo
+
+func (m *RemoteSignResponse) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*RemoteSignerEthAddressRequest)(nil), "gravity.v1.RemoteSignerEthAddressRequest")
+	proto.RegisterType((*RemoteSignerEthAddressResponse)(nil), "gravity.v1.RemoteSignerEthAddressResponse")
+	proto.RegisterType((*RemoteSignValsetRequest)(nil), "gravity.v1.RemoteSignValsetRequest")
+	proto.RegisterType((*RemoteSignBatchRequest)(nil), "gravity.v1.RemoteSignBatchRequest")
+	proto.RegisterType((*RemoteSignLogicCallRequest)(nil), "gravity.v1.RemoteSignLogicCallRequest")
+	proto.RegisterType((*RemoteSignResponse)(nil), "gravity.v1.RemoteSignResponse")
+}
+
+func init() { proto.RegisterFile("gravity/v1/remote_signer.proto", fileDescriptor_907c6f9ab58125a9) }
+
+var fileDescriptor_907c6f9ab58125a9 = []byte{
+	// 484 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0xeb, 0x51, 0x06, 0xfd, 0x36, 0x2e, 0x16, 0x1a, 0xa3, 0x50, 0x77, 0x0a, 0x12, 0x1a,
+	0x48, 0x4d, 0x58, 0x39, 0x70, 0x5e, 0x11, 0x87, 0x49, 0x48, 0x13, 0x01, 0x81, 0x84, 0x90, 0x2a,
+	0x37, 0xb1, 0x1c, 0x4b, 0x69, 0x5c, 0x62, 0xa7, 0xda, 0x1e, 0x80, 0x13, 0x07, 0x78, 0xac, 0x1d,
+	0x77, 0xe4, 0x84, 0x50, 0xfb, 0x02, 0x3c, 0x02, 0xaa, 0xe3, 0xc5, 0x29, 0x0a, 0x6c, 0xd2, 0x6e,
+	0xce, 0xdf, 0xff, 0xef, 0xf3, 0x2f, 0xfe, 0xfe, 0x32, 0x10, 0x9e, 0xd3, 0xb9, 0xd0, 0xa7, 0xc1,
+	0xfc, 0x20, 0xc8, 0xd9, 0x54, 0x6a, 0x36, 0x56, 0x82, 0x67, 0x2c, 0xf7, 0x67, 0xb9, 0xd4, 0x12,
+	0x83, 0xdd, 0xf7, 0xe7, 0x07, 0xdd, 0x9d, 0x9a, 0x57, 0x9f, 0xce, 0x98, 0x2a, 0x3d, 0x6b, 0xfa,
+	0x84, 0xea, 0x28, 0xb1, 0xfa, 0x5d, 0x2e, 0xb9, 0x34, 0xcb, 0x60, 0xb5, 0x2a, 0x55, 0xaf, 0x0f,
+	0xbd, 0xd0, 0x1c, 0xf4, 0xd6, 0x9c, 0xf3, 0x4a, 0x27, 0x87, 0x71, 0x9c, 0x33, 0xa5, 0x42, 0xf6,
+	0xb9, 0x60, 0x4a, 0x7b, 0x87, 0x40, 0xfe, 0x65, 0x50, 0x33, 0x99, 0x29, 0x86, 0xfb, 0xb0, 0xc5,
+	0x74, 0x32, 0xa6, 0xa5, 0xbc, 0x8b, 0xf6, 0xd0, 0x7e, 0x27, 0x04, 0x56, 0x19, 0xbd, 0x2f, 0x08,
+	0xee, 0xb9, 0x1e, 0xef, 0x69, 0xaa, 0x98, 0xb6, 0xed, 0xf1, 0x33, 0xd8, 0x9c, 0x1b, 0xc1, 0xd4,
+	0x6d, 0x0d, 0xb1, 0xef, 0x7e, 0xd1, 0x2f, 0xad, 0xa3, 0xf6, 0xd9, 0xcf, 0x7e, 0x2b, 0xb4, 0x3e,
+	0x7c, 0x1f, 0x6e, 0x47, 0x09, 0x15, 0xd9, 0x58, 0xc4, 0xbb, 0x1b, 0x7b, 0x68, 0xbf, 0x1d, 0xde,
+	0x32, 0xdf, 0x47, 0x31, 0xee, 0xc1, 0xc5, 0x05, 0xad, 0x36, 0x6f, 0x18, 0x90, 0x8e, 0x55, 0x8e,
+	0x62, 0xef, 0x2b, 0x82, 0x1d, 0xc7, 0x31, 0x5a, 0xdd, 0xcd, 0x05, 0xc6, 0x0b, 0xb8, 0x69, 0xee,
+	0xca, 0x52, 0x3c, 0xa8, 0x53, 0x1c, 0x17, 0x9a, 0x4b, 0x91, 0xf1, 0x77, 0x27, 0xa6, 0xc4, 0xe2,
+	0x94, 0xfe, 0x6b, 0xd0, 0x7c, 0x43, 0xd0, 0x75, 0x34, 0xaf, 0x25, 0x17, 0xd1, 0x4b, 0x9a, 0xa6,
+	0x8e, 0xa8, 0x1d, 0xd1, 0x34, 0xb5, 0x40, 0xbd, 0x26, 0xa0, 0xaa, 0xc6, 0x22, 0x99, 0x82, 0x6b,
+	0x10, 0x0d, 0x01, 0x3b, 0xa0, 0x6a, 0xbc, 0x0f, 0xa1, 0xb3, 0xca, 0x20, 0xd5, 0x45, 0xce, 0x0c,
+	0xcd, 0x76, 0xe8, 0x84, 0xe1, 0xef, 0x0d, 0xd8, 0xae, 0xe7, 0x03, 0x47, 0x00, 0x2e, 0x23, 0xf8,
+	0x49, 0x9d, 0xfb, 0xbf, 0x41, 0xeb, 0x3e, 0xbd, 0x8a, 0xd5, 0x32, 0xbd, 0x01, 0x70, 0x51, 0xc2,
+	0x8f, 0x9a, 0x2b, 0xd7, 0x82, 0xd6, 0x25, 0xcd, 0xa6, 0xaa, 0xe5, 0x31, 0x74, 0xaa, 0x54, 0x60,
+	0xaf, 0xd9, 0x5c, 0x8f, 0xcc, 0xa5, 0x0d, 0x3f, 0xc0, 0x9d, 0xb5, 0xc1, 0xe2, 0xc7, 0xcd, 0x05,
+	0x7f, 0x4f, 0xfe, 0xb2, 0xc6, 0xa3, 0x4f, 0x67, 0x0b, 0x82, 0xce, 0x17, 0x04, 0xfd, 0x5a, 0x10,
+	0xf4, 0x7d, 0x49, 0x5a, 0xe7, 0x4b, 0xd2, 0xfa, 0xb1, 0x24, 0xad, 0x8f, 0x23, 0x2e, 0x74, 0x52,
+	0x4c, 0xfc, 0x48, 0x4e, 0x03, 0x9a, 0xea, 0x84, 0xd1, 0x41, 0xc6, 0x74, 0x10, 0x49, 0x35, 0x95,
+	0x6a, 0x60, 0xbb, 0x0e, 0x26, 0xb9, 0x88, 0x39, 0x0b, 0xa6, 0x32, 0x2e, 0x52, 0x16, 0x9c, 0x04,
+	0x56, 0x2f, 0x1f, 0x91, 0xc9, 0xa6, 0x79, 0x17, 0x9e, 0xff, 0x19, 0x00, 0xfa, 0x25, 0x64, 0x75,
+	0x8b, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RemoteSignerClient is the client API for RemoteSigner service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RemoteSignerClient interface {
+	// EthAddress returns the Ethereum address of the key of the signer
+	EthAddress(ctx context.Context, in *RemoteSignerEthAddressRequest, opts ...grpc.CallOption) (*RemoteSignerEthAddressResponse, error)
+	// SignValset signs the checkpoint of a valset
+	SignValset(ctx context.Context, in *RemoteSignValsetRequest, opts ...grpc.CallOption) (*RemoteSignResponse, error)
+	// SignBatch signs the checkpoint of a batch
+	SignBatch(ctx context.Context, in *RemoteSignBatchRequest, opts ...grpc.CallOption) (*RemoteSignResponse, error)
+	// SignLogicCall signs the checkpoint of a logic call
+	SignLogicCall(ctx context.Context, in *RemoteSignLogicCallRequest, opts ...grpc.CallOption) (*RemoteSignResponse, error)
+}
+
+type remoteSignerClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewRemoteSignerClient(cc grpc1.ClientConn) RemoteSignerClient {
+	return &remoteSignerClient{cc}
+}
+
+func (c *remoteSignerClient) EthAddress(ctx context.Context, in *RemoteSignerEthAddressRequest, opts ...grpc.CallOption) (*RemoteSignerEthAddressResponse, error) {
+	out := new(RemoteSignerEthAddressResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.RemoteSigner/EthAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *remoteSignerClient) SignValset(ctx context.Context, in *RemoteSignValsetRequest, opts ...grpc.CallOption) (*RemoteSignResponse, error) {
+	out := new(RemoteSignResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.RemoteSigner/SignValset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *remoteSignerClient) SignBatch(ctx context.Context, in *RemoteSignBatchRequest, opts ...grpc.CallOption) (*RemoteSignResponse, error) {
+	out := new(RemoteSignResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.RemoteSigner/SignBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *remoteSignerClient) SignLogicCall(ctx context.Context, in *RemoteSignLogicCallRequest, opts ...grpc.CallOption) (*RemoteSignResponse, error) {
+	out := new(RemoteSignResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.RemoteSigner/SignLogicCall", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RemoteSignerServer is the server API for RemoteSigner service.
+type RemoteSignerServer interface {
+	// EthAddress returns the Ethereum address of the key of the signer
+	EthAddress(context.Context, *RemoteSignerEthAddressRequest) (*RemoteSignerEthAddressResponse, error)
+	// SignValset signs the checkpoint of a valset
+	SignValset(context.Context, *RemoteSignValsetRequest) (*RemoteSignResponse, error)
+	// SignBatch signs the checkpoint of a batch
+	SignBatch(context.Context, *RemoteSignBatchRequest) (*RemoteSignResponse, error)
+	// SignLogicCall signs the checkpoint of a logic call
+	SignLogicCall(context.Context, *RemoteSignLogicCallRequest) (*RemoteSignResponse, error)
+}
+
+// UnimplementedRemoteSignerServer can be embedded to have forward compatible implementations.
+type UnimplementedRemoteSignerServer struct {
+}
+
+func (*UnimplementedRemoteSignerServer) EthAddress(ctx context.Context, req *RemoteSignerEthAddressRequest) (*RemoteSignerEthAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EthAddress not implemented")
+}
+func (*UnimplementedRemoteSignerServer) SignValset(ctx context.Context, req *RemoteSignValsetRequest) (*RemoteSignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignValset not implemented")
+}
+func (*UnimplementedRemoteSignerServer) SignBatch(ctx context.Context, req *RemoteSignBatchRequest) (*RemoteSignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignBatch not implemented")
+}
+func (*UnimplementedRemoteSignerServer) SignLogicCall(ctx context.Context, req *RemoteSignLogicCallRequest) (*RemoteSignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignLogicCall not implemented")
+}
+
+func RegisterRemoteSignerServer(s grpc1.Server, srv RemoteSignerServer) {
+	s.RegisterService(&_RemoteSigner_serviceDesc, srv)
+}
+
+func _RemoteSigner_EthAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoteSignerEthAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteSignerServer).EthAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.RemoteSigner/EthAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteSignerServer).EthAddress(ctx, req.(*RemoteSignerEthAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RemoteSigner_SignValset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoteSignValsetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteSignerServer).SignValset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.RemoteSigner/SignValset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteSignerServer).SignValset(ctx, req.(*RemoteSignValsetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RemoteSigner_SignBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoteSignBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteSignerServer).SignBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.RemoteSigner/SignBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteSignerServer).SignBatch(ctx, req.(*RemoteSignBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RemoteSigner_SignLogicCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoteSignLogicCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteSignerServer).SignLogicCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.RemoteSigner/SignLogicCall",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteSignerServer).SignLogicCall(ctx, req.(*RemoteSignLogicCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RemoteSigner_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gravity.v1.RemoteSigner",
+	HandlerType: (*RemoteSignerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "EthAddress",
+			Handler:    _RemoteSigner_EthAddress_Handler,
+		},
+		{
+			MethodName: "SignValset",
+			Handler:    _RemoteSigner_SignValset_Handler,
+		},
+		{
+			MethodName: "SignBatch",
+			Handler:    _RemoteSigner_SignBatch_Handler,
+		},
+		{
+			MethodName: "SignLogicCall",
+			Handler:    _RemoteSigner_SignLogicCall_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gravity/v1/remote_signer.proto",
+}
+
+func (m *RemoteSignerEthAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoteSignerEthAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoteSignerEthAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoteSignerEthAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoteSignerEthAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoteSignerEthAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EthAddress) > 0 {
+		i -= len(m.EthAddress)
+		copy(dAtA[i:], m.EthAddress)
+		i = encodeVarintRemoteSigner(dAtA, i, uint64(len(m.EthAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoteSignValsetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoteSignValsetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoteSignValsetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GravityId) > 0 {
+		i -= len(m.GravityId)
+		copy(dAtA[i:], m.GravityId)
+		i = encodeVarintRemoteSigner(dAtA, i, uint64(len(m.GravityId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintRemoteSigner(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Valset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRemoteSigner(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoteSignBatchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoteSignBatchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoteSignBatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GravityId) > 0 {
+		i -= len(m.GravityId)
+		copy(dAtA[i:], m.GravityId)
+		i = encodeVarintRemoteSigner(dAtA, i, uint64(len(m.GravityId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintRemoteSigner(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Batch.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRemoteSigner(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoteSignLogicCallRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoteSignLogicCallRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoteSignLogicCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GravityId) > 0 {
+		i -= len(m.GravityId)
+		copy(dAtA[i:], m.GravityId)
+		i = encodeVarintRemoteSigner(dAtA, i, uint64(len(m.GravityId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintRemoteSigner(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Call.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRemoteSigner(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoteSignResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoteSignResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoteSignResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintRemoteSigner(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRemoteSigner(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRemoteSigner(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RemoteSignerEthAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RemoteSignerEthAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EthAddress)
+	if l > 0 {
+		n += 1 + l + sovRemoteSigner(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoteSignValsetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Valset.Size()
+	n += 1 + l + sovRemoteSigner(uint64(l))
+	if m.ChainId != 0 {
+		n += 1 + sovRemoteSigner(uint64(m.ChainId))
+	}
+	l = len(m.GravityId)
+	if l > 0 {
+		n += 1 + l + sovRemoteSigner(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoteSignBatchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Batch.Size()
+	n += 1 + l + sovRemoteSigner(uint64(l))
+	if m.ChainId != 0 {
+		n += 1 + sovRemoteSigner(uint64(m.ChainId))
+	}
+	l = len(m.GravityId)
+	if l > 0 {
+		n += 1 + l + sovRemoteSigner(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoteSignLogicCallRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Call.Size()
+	n += 1 + l + sovRemoteSigner(uint64(l))
+	if m.ChainId != 0 {
+		n += 1 + sovRemoteSigner(uint64(m.ChainId))
+	}
+	l = len(m.GravityId)
+	if l > 0 {
+		n += 1 + l + sovRemoteSigner(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoteSignResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovRemoteSigner(uint64(l))
+	}
+	return n
+}
+
+func sovRemoteSigner(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRemoteSigner(x uint64) (n int) {
+	return sovRemoteSigner(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RemoteSignerEthAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRemoteSigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoteSignerEthAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoteSignerEthAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRemoteSigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoteSignerEthAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRemoteSigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoteSignerEthAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoteSignerEthAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemoteSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRemoteSigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoteSignValsetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRemoteSigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoteSignValsetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoteSignValsetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemoteSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Valset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemoteSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GravityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemoteSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GravityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRemoteSigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoteSignBatchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRemoteSigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoteSignBatchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoteSignBatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemoteSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Batch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemoteSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GravityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemoteSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GravityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRemoteSigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoteSignLogicCallRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRemoteSigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoteSignLogicCallRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoteSignLogicCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Call", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemoteSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Call.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemoteSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GravityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemoteSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GravityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRemoteSigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoteSignResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRemoteSigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoteSignResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoteSignResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemoteSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRemoteSigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRemoteSigner(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRemoteSigner
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRemoteSigner
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRemoteSigner
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRemoteSigner
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRemoteSigner
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRemoteSigner
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRemoteSigner        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRemoteSigner          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRemoteSigner = fmt.Errorf("proto: unexpected end of group")
+)
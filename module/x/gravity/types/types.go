@@ -55,6 +55,23 @@ func (b BridgeValidators) ToInternal() (*InternalBridgeValidators, error) {
 	return &ret, nil
 }
 
+// Equal returns true if both sets contain the same members with the same powers in the same order,
+// which is what it takes for them to produce the same checkpoint on Ethereum
+func (b BridgeValidators) Equal(c BridgeValidators) bool {
+	if len(b) != len(c) {
+		return false
+	}
+	for i := range b {
+		if b[i] == nil || c[i] == nil {
+			return false
+		}
+		if b[i].Power != c[i].Power || !strings.EqualFold(b[i].EthereumAddress, c[i].EthereumAddress) {
+			return false
+		}
+	}
+	return true
+}
+
 // Bridge Validator but with validated EthereumAddress
 type InternalBridgeValidator struct {
 	Power           uint64